@@ -0,0 +1,7 @@
+//go:build headless
+// +build headless
+
+package animate
+
+func (w *wipeElement) paint() {
+}
@@ -1,4 +1,5 @@
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package animate
 
@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package base
@@ -0,0 +1,28 @@
+//go:build headless
+// +build headless
+
+package base
+
+import (
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+const (
+	// PLATFORM specifies the GUI toolkit being used.
+	PLATFORM = "headless"
+)
+
+// Control is an opaque type used as a platform-specific handle to a control
+// created using the platform GUI.  As an example, this will refer to a HWND
+// when targeting Windows, but a *GtkContainer when targeting GTK.
+//
+// Unless developing new widgets, users should not need to use this type.
+//
+// Any methods on this type will be platform specific.
+type Control struct {
+	Node *headless.Node
+}
+
+// NativeElement contains platform-specific methods that all widgets
+// must support when running headless.
+type NativeElement interface{}
@@ -1,5 +1,5 @@
-//go:build go1.12
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package base
 
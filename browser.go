@@ -1,3 +1,6 @@
+//go:build windows && !headless
+// +build windows,!headless
+
 package goey
 
 import (
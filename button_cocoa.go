@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package goey
@@ -35,6 +36,10 @@ func (w *buttonElement) Close() {
 	}
 }
 
+func (w *buttonElement) isClosed() bool {
+	return w.control == nil
+}
+
 func (w *buttonElement) Layout(bc base.Constraints) base.Size {
 	px, h := w.control.IntrinsicContentSize()
 	return bc.Constrain(base.Size{
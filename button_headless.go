@@ -0,0 +1,63 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type buttonElement struct {
+	Control
+	props Button
+}
+
+func (w *Button) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &buttonElement{
+		Control: newControl(parent, 0, 0),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.node.OnKey = func(r rune) {
+		if r == '\n' || r == ' ' {
+			retval.Click()
+		}
+	}
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// Click simulates a user clicking on the button.
+func (w *buttonElement) Click() {
+	if !w.props.Disabled && w.props.OnClick != nil {
+		w.props.OnClick()
+	}
+}
+
+func (w *buttonElement) paint(dst draw.Image, bounds image.Rectangle) {
+	paintFrame(dst, bounds, headless.Face, w.node)
+	if w.props.Default {
+		headless.Stroke(dst, bounds.Inset(1), headless.Accent)
+	}
+	headless.DrawText(dst, centerText(bounds, w.props.Text), w.props.Text, textColor(w.props.Disabled))
+}
+
+func (w *buttonElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *buttonElement) updateProps(data *Button) error {
+	size := headless.TextSize(data.Text)
+	w.node.MinSize = image.Point{size.X + 24, size.Y + 10}
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
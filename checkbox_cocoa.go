@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package goey
@@ -34,6 +35,10 @@ func (w *checkboxElement) Close() {
 	}
 }
 
+func (w *checkboxElement) isClosed() bool {
+	return w.control == nil
+}
+
 func (w *checkboxElement) Layout(bc base.Constraints) base.Size {
 	px, h := w.control.IntrinsicContentSize()
 	return bc.Constrain(base.Size{
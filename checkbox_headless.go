@@ -0,0 +1,70 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type checkboxElement struct {
+	Control
+	props Checkbox
+}
+
+func (w *Checkbox) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &checkboxElement{
+		Control: newControl(parent, 0, 0),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.node.OnKey = func(r rune) {
+		if r == ' ' {
+			retval.Click()
+		}
+	}
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// Click simulates a user clicking on the checkbox, which toggles its value.
+func (w *checkboxElement) Click() {
+	if w.props.Disabled {
+		return
+	}
+
+	w.props.Value = !w.props.Value
+	if w.props.OnChange != nil {
+		w.props.OnChange(w.props.Value)
+	}
+}
+
+func (w *checkboxElement) paint(dst draw.Image, bounds image.Rectangle) {
+	y := (bounds.Min.Y + bounds.Max.Y - 13) / 2
+	box := image.Rect(bounds.Min.X, y, bounds.Min.X+13, y+13)
+	paintFrame(dst, box, headless.Background, w.node)
+	if w.props.Value {
+		headless.Fill(dst, box.Inset(3), headless.Accent)
+	}
+	headless.DrawText(dst, image.Point{box.Max.X + 6, y}, w.props.Text, textColor(w.props.Disabled))
+}
+
+func (w *checkboxElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *checkboxElement) updateProps(data *Checkbox) error {
+	size := headless.TextSize(data.Text)
+	w.node.MinSize = image.Point{size.X + 19, size.Y + 4}
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
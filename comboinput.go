@@ -0,0 +1,89 @@
+package goey
+
+import (
+	"strings"
+
+	"github.com/chaolihf/goey/base"
+)
+
+var (
+	comboInputKind = base.NewKind("github.com/chaolihf/goey.ComboInput")
+)
+
+// ComboInput describes a widget that users input or update a single line of
+// text, with a drop-down list of suggestions.  Unlike SelectInput, the value
+// does not need to match any of the suggestions.
+//
+// The suggestions are filtered as the user types.  If OnQuery is nil, the
+// suggestions are the strings in Items that start with the current text,
+// ignoring case.  Otherwise, the suggestions are the strings returned by
+// OnQuery, and Items is ignored.
+type ComboInput struct {
+	Value       string                       // Value is the current string for the field
+	Items       []string                     // Items is an array of strings that will be suggested to the user
+	Placeholder string                       // Placeholder is a descriptive text that can be displayed when the field is empty
+	Disabled    bool                         // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus   bool                         // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
+	OnQuery     func(prefix string) []string // OnQuery, if not nil, will be called to get the suggestions for the current text
+	OnChange    func(value string)           // OnChange will be called whenever the user changes the value for this field
+	OnSelect    func(value string)           // OnSelect will be called whenever the user selects one of the suggestions
+	OnFocus     func()                       // OnFocus will be called whenever the field receives the keyboard focus
+	OnBlur      func()                       // OnBlur will be called whenever the field loses the keyboard focus
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*ComboInput) Kind() *base.Kind {
+	return &comboInputKind
+}
+
+// Mount creates an editable combobox in the GUI.  The newly created widget
+// will be a child of the widget specified by parent.
+func (w *ComboInput) Mount(parent base.Control) (base.Element, error) {
+	// Forward to the platform-dependant code
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
+}
+
+func (*comboinputElement) Kind() *base.Kind {
+	return &comboInputKind
+}
+
+func (w *comboinputElement) UpdateProps(data base.Widget) error {
+	return w.updateProps(data.(*ComboInput))
+}
+
+// comboQuery contains the fields of ComboInput used to create suggestions.
+// It is embedded in the platform-specific elements.
+type comboQuery struct {
+	items   []string
+	onQuery func(prefix string) []string
+}
+
+// suggestions returns the list of suggestions for the text.
+func (q *comboQuery) suggestions(prefix string) []string {
+	if q.onQuery != nil {
+		return q.onQuery(prefix)
+	}
+	return filterItems(q.items, prefix)
+}
+
+// filterItems returns the items that start with prefix, ignoring case.  If
+// prefix is empty, all of the items are returned.
+func filterItems(items []string, prefix string) []string {
+	if prefix == "" {
+		return items
+	}
+
+	prefix = strings.ToLower(prefix)
+	retval := []string(nil)
+	for _, v := range items {
+		if strings.HasPrefix(strings.ToLower(v), prefix) {
+			retval = append(retval, v)
+		}
+	}
+	return retval
+}
@@ -0,0 +1,53 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+type comboinputElement struct {
+	control *cocoa.Text
+}
+
+func (w *ComboInput) mount(parent base.Control) (base.Element, error) {
+	control := cocoa.NewText(parent.Handle, "combo input")
+
+	retval := &comboinputElement{
+		control: control,
+	}
+	return retval, nil
+}
+
+func (w *comboinputElement) Close() {
+	if w.control != nil {
+		w.control.Close()
+		w.control = nil
+	}
+}
+
+func (w *comboinputElement) Layout(bc base.Constraints) base.Size {
+	px := w.MinIntrinsicWidth(base.Inf)
+	h := w.MinIntrinsicHeight(base.Inf)
+	return bc.Constrain(base.Size{px, h})
+}
+
+func (w *comboinputElement) MinIntrinsicHeight(width base.Length) base.Length {
+	return 20 * base.DIP
+}
+
+func (w *comboinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	return 200 * base.DIP
+}
+
+func (w *comboinputElement) SetBounds(bounds base.Rectangle) {
+	px := bounds.Pixels()
+	w.control.SetFrame(px.Min.X, px.Min.Y, px.Dx(), px.Dy())
+}
+
+func (w *comboinputElement) updateProps(data *ComboInput) error {
+	return nil
+}
@@ -0,0 +1,118 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package goey
+
+import (
+	"bytes"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type comboinputElement struct {
+	Control
+	comboQuery
+
+	onChange func(string)
+	onSelect func(string)
+	onFocus  func()
+	onBlur   func()
+}
+
+func serializeItems(items []string) string {
+	buffer := bytes.Buffer{}
+
+	for _, v := range items {
+		buffer.WriteString(v)
+		buffer.WriteByte(0)
+	}
+	buffer.WriteByte(0)
+
+	return buffer.String()
+}
+
+func (w *ComboInput) mount(parent base.Control) (base.Element, error) {
+	query := comboQuery{
+		items:   w.Items,
+		onQuery: w.OnQuery,
+	}
+	control := gtk.MountComboInput(parent.Handle, w.Value,
+		serializeItems(query.suggestions(w.Value)), w.Placeholder, w.Disabled,
+		w.OnFocus != nil, w.OnBlur != nil)
+
+	retval := &comboinputElement{
+		Control:    Control{control},
+		comboQuery: query,
+		onChange:   w.OnChange,
+		onSelect:   w.OnSelect,
+		onFocus:    w.OnFocus,
+		onBlur:     w.OnBlur,
+	}
+	gtk.RegisterWidget(control, retval)
+
+	return retval, nil
+}
+
+func (w *comboinputElement) OnChange(value string, selected bool) {
+	if w.onChange != nil {
+		w.onChange(value)
+	}
+	if !selected {
+		gtk.ComboInputSetSuggestions(w.handle, serializeItems(w.suggestions(value)))
+	}
+}
+
+func (w *comboinputElement) OnSelect(value string) {
+	if w.onSelect != nil {
+		w.onSelect(value)
+	}
+}
+
+func (w *comboinputElement) OnFocus() {
+	w.onFocus()
+}
+
+func (w *comboinputElement) OnBlur() {
+	w.onBlur()
+}
+
+func (w *comboinputElement) TakeFocus() bool {
+	control := Control{gtk.ComboboxChild(w.handle)}
+	return control.TakeFocus()
+}
+
+func (w *comboinputElement) TypeKeys(text string) chan error {
+	control := Control{gtk.ComboboxChild(w.handle)}
+	return control.TypeKeys(text)
+}
+
+func (w *comboinputElement) Props() base.Widget {
+	return &ComboInput{
+		Value:       gtk.ComboInputText(w.handle),
+		Items:       w.items,
+		Placeholder: gtk.ComboInputPlaceholder(w.handle),
+		Disabled:    !gtk.WidgetSensitive(w.handle),
+		OnQuery:     w.onQuery,
+		OnChange:    w.onChange,
+		OnSelect:    w.onSelect,
+		OnFocus:     w.onFocus,
+		OnBlur:      w.onBlur,
+	}
+}
+
+func (w *comboinputElement) updateProps(data *ComboInput) error {
+	w.items = data.Items
+	w.onQuery = data.OnQuery
+	gtk.ComboInputUpdate(w.handle, data.Value,
+		serializeItems(w.suggestions(data.Value)), data.Placeholder, data.Disabled,
+		data.OnFocus != nil, data.OnBlur != nil)
+
+	w.onChange = data.OnChange
+	w.onSelect = data.OnSelect
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+
+	return nil
+}
@@ -0,0 +1,86 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type comboinputElement struct {
+	Control
+	comboQuery
+	props ComboInput
+
+	suggested []string
+}
+
+func (w *ComboInput) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &comboinputElement{
+		Control: newControl(parent, 120, 23),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.node.OnKey = retval.onKey
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+func (w *comboinputElement) onKey(r rune) {
+	value, ok := editText(w.props.Value, r)
+	if !ok {
+		return
+	}
+
+	w.props.Value = value
+	if w.props.OnChange != nil {
+		w.props.OnChange(value)
+	}
+	w.suggested = w.suggestions(value)
+}
+
+// Suggestions returns the list of suggestions for the current text.
+func (w *comboinputElement) Suggestions() []string {
+	return w.suggested
+}
+
+// SelectSuggestion simulates a user choosing one of the suggestions for the
+// current text.
+func (w *comboinputElement) SelectSuggestion(index int) {
+	if w.props.Disabled || index < 0 || index >= len(w.suggested) {
+		return
+	}
+
+	value := w.suggested[index]
+	w.props.Value = value
+	if w.props.OnChange != nil {
+		w.props.OnChange(value)
+	}
+	if w.props.OnSelect != nil {
+		w.props.OnSelect(value)
+	}
+}
+
+func (w *comboinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	paintTextField(dst, bounds, w.node, w.props.Value, w.props.Placeholder, w.props.Disabled)
+}
+
+func (w *comboinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *comboinputElement) updateProps(data *ComboInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+	w.items = data.Items
+	w.onQuery = data.OnQuery
+	w.suggested = w.suggestions(data.Value)
+
+	return nil
+}
@@ -0,0 +1,156 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package goey
+
+import (
+	"strconv"
+	"syscall/js"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/js"
+)
+
+var (
+	// Counter used to create unique IDs for datalist elements.
+	datalistCount = 0
+)
+
+type comboinputElement struct {
+	Control
+	comboQuery
+	datalist js.Value
+
+	onChange func(string)
+	onSelect func(string)
+	onInput  goeyjs.ComboCB
+	onFocus  goeyjs.FocusCB
+	onBlur   goeyjs.BlurCB
+}
+
+func (w *ComboInput) mount(parent base.Control) (base.Element, error) {
+	// Create the list of suggestions
+	datalistCount++
+	id := "goey-datalist-" + strconv.Itoa(datalistCount)
+	datalist := goeyjs.CreateElement("datalist", "")
+	datalist.Set("id", id)
+	parent.Handle.Call("appendChild", datalist)
+
+	// Create the control
+	handle := goeyjs.CreateElement("input", "goey form-control")
+	handle.Set("type", "text")
+	handle.Call("setAttribute", "list", id)
+	parent.Handle.Call("appendChild", handle)
+
+	// Create the element
+	retval := &comboinputElement{
+		Control:  Control{handle},
+		datalist: datalist,
+	}
+	retval.updateProps(w)
+	// The input event is always required to update the suggestions.
+	retval.onInput.Set(handle, retval.thunkOnInput)
+
+	return retval, nil
+}
+
+func (w *comboinputElement) Close() {
+	w.onInput.Close()
+	w.onFocus.Close()
+	w.onBlur.Close()
+
+	w.datalist.Call("remove")
+	w.Control.Close()
+}
+
+func (w *comboinputElement) setSuggestions(items []string) {
+	w.datalist.Set("innerHTML", "")
+	for _, v := range items {
+		option := goeyjs.CreateElement("option", "")
+		option.Set("value", v)
+		w.datalist.Call("appendChild", option)
+	}
+}
+
+func (w *comboinputElement) thunkOnInput(value string, selected bool) {
+	if w.onChange != nil {
+		w.onChange(value)
+	}
+	if selected {
+		if w.onSelect != nil {
+			w.onSelect(value)
+		}
+		return
+	}
+	w.setSuggestions(w.suggestions(value))
+}
+
+func (w *comboinputElement) createMeasurementElement() js.Value {
+	handle := goeyjs.CreateElement("input", "form-control goey-measure")
+	handle.Set("type", "text")
+
+	goeyjs.AppendChildToBody(handle)
+
+	return handle
+}
+
+func (w *comboinputElement) Layout(bc base.Constraints) base.Size {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := base.FromPixelsX(handle.Get("offsetWidth").Int() + 1)
+	width = bc.ConstrainWidth(width)
+	height := base.FromPixelsY(handle.Get("offsetHeight").Int() + 1)
+	height = bc.ConstrainHeight(height)
+
+	return base.Size{width, height}
+}
+
+func (w *comboinputElement) MinIntrinsicHeight(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	height := handle.Get("offsetHeight").Int()
+
+	return base.FromPixelsY(height)
+}
+
+func (w *comboinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := handle.Get("offsetWidth").Int()
+
+	return base.FromPixelsX(width + 1)
+}
+
+func (w *comboinputElement) Props() base.Widget {
+	return &ComboInput{
+		Value:       w.handle.Get("value").String(),
+		Items:       w.items,
+		Placeholder: w.handle.Get("placeholder").String(),
+		Disabled:    w.handle.Get("disabled").Truthy(),
+		OnQuery:     w.onQuery,
+		OnChange:    w.onChange,
+		OnSelect:    w.onSelect,
+		OnFocus:     w.onFocus.Fn,
+		OnBlur:      w.onBlur.Fn,
+	}
+}
+
+func (w *comboinputElement) updateProps(data *ComboInput) error {
+	w.items = data.Items
+	w.onQuery = data.OnQuery
+	w.setSuggestions(w.suggestions(data.Value))
+
+	w.handle.Set("value", data.Value)
+	w.handle.Set("placeholder", data.Placeholder)
+	w.handle.Set("disabled", data.Disabled)
+
+	w.onChange = data.OnChange
+	w.onSelect = data.OnSelect
+	w.onFocus.Set(w.handle, data.OnFocus)
+	w.onBlur.Set(w.handle, data.OnBlur)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package goey
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chaolihf/goey/base"
+)
+
+func TestComboInputMount(t *testing.T) {
+	items := []string{"Apple", "Banana", "Cherry"}
+
+	testMountWidgets(t,
+		&ComboInput{Value: "A", Items: items},
+		&ComboInput{Value: "B", Placeholder: "..."},
+		&ComboInput{Value: "C", Disabled: true},
+		&ComboInput{Value: "Banana", Items: items},
+		&ComboInput{Value: "", Items: items},
+	)
+}
+
+func TestComboInputClose(t *testing.T) {
+	items := []string{"Apple", "Banana", "Cherry"}
+
+	testCloseWidgets(t,
+		&ComboInput{Value: "A", Items: items},
+		&ComboInput{Value: "B", Placeholder: "..."},
+		&ComboInput{Value: "C", Disabled: true},
+	)
+}
+
+func TestComboInputOnFocus(t *testing.T) {
+	testCheckFocusAndBlur(t,
+		&ComboInput{},
+		&ComboInput{},
+		&ComboInput{},
+	)
+}
+
+func TestComboInputOnChange(t *testing.T) {
+	log := bytes.NewBuffer(nil)
+	queries := []string(nil)
+
+	testTypeKeys(t, "Hello",
+		&ComboInput{
+			OnQuery: func(prefix string) []string {
+				queries = append(queries, prefix)
+				return []string{prefix + " world"}
+			},
+			OnChange: func(v string) {
+				log.WriteString(v)
+				log.WriteString("\x1E")
+			},
+		})
+
+	const want = "H\x1EHe\x1EHel\x1EHell\x1EHello\x1E"
+	if got := log.String(); got != want {
+		t.Errorf("Wanted %v, got %v", want, got)
+	}
+	if len(queries) == 0 || queries[len(queries)-1] != "Hello" {
+		t.Errorf("Wanted query for %s, got %v", "Hello", queries)
+	}
+}
+
+func TestComboInputUpdateProps(t *testing.T) {
+	items := []string{"Apple", "Banana", "Cherry"}
+
+	testUpdateWidgets(t, []base.Widget{
+		&ComboInput{Value: "A", Items: items},
+		&ComboInput{Value: "B", Placeholder: "..."},
+		&ComboInput{Value: "C", Disabled: true},
+	}, []base.Widget{
+		&ComboInput{Value: "AA"},
+		&ComboInput{Value: "BA", Items: items, Disabled: true},
+		&ComboInput{Value: "CA", Placeholder: "***", Disabled: false},
+	})
+}
+
+func TestComboInputSuggestions(t *testing.T) {
+	items := []string{"Apple", "apricot", "Banana", "Cherry"}
+	query := func(prefix string) []string {
+		return []string{strings.ToUpper(prefix)}
+	}
+
+	cases := []struct {
+		items   []string
+		onQuery func(string) []string
+		prefix  string
+		out     []string
+	}{
+		{items, nil, "", items},
+		{items, nil, "a", []string{"Apple", "apricot"}},
+		{items, nil, "AP", []string{"Apple", "apricot"}},
+		{items, nil, "Ban", []string{"Banana"}},
+		{items, nil, "x", nil},
+		{nil, nil, "a", nil},
+		{items, query, "ab", []string{"AB"}},
+	}
+
+	for i, v := range cases {
+		q := comboQuery{items: v.items, onQuery: v.onQuery}
+		if got := q.suggestions(v.prefix); !reflect.DeepEqual(got, v.out) {
+			t.Errorf("case %d: got %v, want %v", i, got, v.out)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+//go:build !headless
+// +build !headless
+
+package goey
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/goey/base"
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+const (
+	// Messages to set and get the placeholder (cue banner) for a combobox.
+	cbSetCueBanner = 0x1703
+	cbGetCueBanner = 0x1704
+)
+
+var (
+	oldComboinputWindowProc uintptr
+)
+
+func (w *ComboInput) mount(parent base.Control) (base.Element, error) {
+	const STYLE = win.WS_CHILD | win.WS_VISIBLE | win.WS_TABSTOP | win.CBS_DROPDOWN | win.CBS_AUTOHSCROLL
+	hwnd, _, err := createControlWindow(win.WS_EX_CLIENTEDGE, &comboboxClassName[0], "", STYLE, parent.HWnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the font for the window
+	if hFont := win2.MessageFont(); hFont != 0 {
+		win.SendMessage(hwnd, win.WM_SETFONT, uintptr(hFont), 0)
+	}
+
+	if w.Disabled {
+		win.EnableWindow(hwnd, false)
+	}
+
+	// Add the suggestions to the control
+	retval := &comboinputElement{
+		Control: Control{hwnd},
+		comboQuery: comboQuery{
+			items:   w.Items,
+			onQuery: w.OnQuery,
+		},
+		onChange: w.OnChange,
+		onSelect: w.OnSelect,
+		onFocus:  w.OnFocus,
+		onBlur:   w.OnBlur,
+	}
+	err = retval.setSuggestions(retval.suggestions(w.Value))
+	if err != nil {
+		win.DestroyWindow(hwnd)
+		return nil, err
+	}
+	_, err = win2.SetWindowText(hwnd, w.Value)
+	if err != nil {
+		win.DestroyWindow(hwnd)
+		return nil, err
+	}
+	err = updateComboPlaceholder(hwnd, w.Placeholder)
+	if err != nil {
+		win.DestroyWindow(hwnd)
+		return nil, err
+	}
+
+	// Subclass the window procedure
+	subclassWindowProcedure(hwnd, &oldComboinputWindowProc, comboinputWindowProc)
+	win.SetWindowLongPtr(hwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(retval)))
+
+	return retval, nil
+}
+
+func updateComboPlaceholder(hwnd win.HWND, text string) error {
+	textPlaceholder, err := syscall.UTF16PtrFromString(text)
+	if err != nil {
+		return err
+	}
+	win.SendMessage(hwnd, cbSetCueBanner, 0, uintptr(unsafe.Pointer(textPlaceholder)))
+	return nil
+}
+
+type comboinputElement struct {
+	Control
+	comboQuery
+	onChange func(value string)
+	onSelect func(value string)
+	onFocus  func()
+	onBlur   func()
+
+	// Set while the control is being updated, to suppress events.
+	updating bool
+}
+
+func (w *comboinputElement) setSuggestions(items []string) error {
+	// This is a brute force approach.  The list of items is probably unchanged
+	// most of the time.
+	win.SendMessage(w.Hwnd, win.CB_RESETCONTENT, 0, 0)
+	_, err := selectinputAddItems(w.Hwnd, items)
+	return err
+}
+
+// updateSuggestions refreshes the drop-down list after the user has edited
+// the text.
+func (w *comboinputElement) updateSuggestions(text string) {
+	items := w.suggestions(text)
+
+	w.updating = true
+	defer func() {
+		w.updating = false
+	}()
+
+	// Changing the items will also clear the text and caret, which need to
+	// be restored.
+	if err := w.setSuggestions(items); err != nil {
+		return
+	}
+	win.SendMessage(w.Hwnd, win.CB_SHOWDROPDOWN, boolToWPARAM(text != "" && len(items) > 0), 0)
+	win2.SetWindowText(w.Hwnd, text)
+	length := uint32(len(syscall.StringToUTF16(text)) - 1)
+	win.SendMessage(w.Hwnd, win.CB_SETEDITSEL, 0, uintptr(length|(length<<16)))
+	// Showing the drop-down list hides the mouse cursor.
+	win.SetCursor(win.LoadCursor(0, win.MAKEINTRESOURCE(win.IDC_ARROW)))
+}
+
+func boolToWPARAM(value bool) uintptr {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func (w *comboinputElement) Layout(bc base.Constraints) base.Size {
+	width := w.MinIntrinsicWidth(0)
+	height := w.MinIntrinsicHeight(0)
+	return bc.Constrain(base.Size{width, height})
+}
+
+func (w *comboinputElement) MinIntrinsicHeight(width base.Length) base.Length {
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/dn742486.aspx#sizingandspacing
+	return 23 * DIP
+}
+
+func (w *comboinputElement) MinIntrinsicWidth(height base.Length) base.Length {
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/dn742486.aspx#sizingandspacing
+	return 75 * DIP
+}
+
+func (w *comboinputElement) Props() base.Widget {
+	var buffer [80]uint16
+	win.SendMessage(w.Hwnd, cbGetCueBanner, uintptr(unsafe.Pointer(&buffer[0])), 80)
+
+	return &ComboInput{
+		Value:       w.Control.Text(),
+		Items:       w.items,
+		Placeholder: syscall.UTF16ToString(buffer[:]),
+		Disabled:    !win.IsWindowEnabled(w.Hwnd),
+		OnQuery:     w.onQuery,
+		OnChange:    w.onChange,
+		OnSelect:    w.onSelect,
+		OnFocus:     w.onFocus,
+		OnBlur:      w.onBlur,
+	}
+}
+
+func (w *comboinputElement) TakeFocus() bool {
+	// The keyboard focus will be held by the edit control, which is a child
+	// of the combobox.
+	if focus := win.GetFocus(); focus == w.Hwnd || win.GetParent(focus) == w.Hwnd {
+		return true
+	}
+	return win.SetFocus(w.Hwnd) != 0
+}
+
+func (w *comboinputElement) updateProps(data *ComboInput) error {
+	w.updating = true
+	defer func() {
+		w.updating = false
+	}()
+
+	w.items = data.Items
+	w.onQuery = data.OnQuery
+	err := w.setSuggestions(w.suggestions(data.Value))
+	if err != nil {
+		return err
+	}
+	_, err = win2.SetWindowText(w.Hwnd, data.Value)
+	if err != nil {
+		return err
+	}
+	err = updateComboPlaceholder(w.Hwnd, data.Placeholder)
+	if err != nil {
+		return err
+	}
+
+	w.SetDisabled(data.Disabled)
+	w.onChange = data.OnChange
+	w.onSelect = data.OnSelect
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+
+	return nil
+}
+
+func comboinputWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) (result uintptr) {
+	switch msg {
+	case win.WM_DESTROY:
+		// Make sure that the data structure on the Go-side does not point to a non-existent
+		// window.
+		comboinputGetPtr(hwnd).Hwnd = 0
+		// Defer to the old window proc
+
+	case win.WM_COMMAND:
+		// WM_COMMAND is sent to the parent, which will only forward certain
+		// message.  Focus changes are reported using notifications, since the
+		// keyboard focus is held by the child edit control.
+		w := comboinputGetPtr(hwnd)
+		switch notification := win.HIWORD(uint32(wParam)); notification {
+		case win.CBN_SETFOCUS:
+			if w.onFocus != nil {
+				w.onFocus()
+			}
+		case win.CBN_KILLFOCUS:
+			if w.onBlur != nil {
+				w.onBlur()
+			}
+		case win.CBN_EDITCHANGE:
+			if !w.updating {
+				text := w.Control.Text()
+				if w.onChange != nil {
+					w.onChange(text)
+				}
+				w.updateSuggestions(text)
+			}
+		case win.CBN_SELCHANGE:
+			if cursel := win.SendMessage(hwnd, win.CB_GETCURSEL, 0, 0); !w.updating && int32(cursel) != -1 {
+				text := comboboxItemText(hwnd, cursel)
+				if w.onChange != nil {
+					w.onChange(text)
+				}
+				if w.onSelect != nil {
+					w.onSelect(text)
+				}
+			}
+		}
+		// defer to old window proc
+	}
+
+	return win.CallWindowProc(oldComboinputWindowProc, hwnd, msg, wParam, lParam)
+}
+
+func comboboxItemText(hwnd win.HWND, index uintptr) string {
+	length := win.SendMessage(hwnd, win.CB_GETLBTEXTLEN, index, 0)
+	buffer := make([]uint16, length+1)
+	win.SendMessage(hwnd, win.CB_GETLBTEXT, index, uintptr(unsafe.Pointer(&buffer[0])))
+	return syscall.UTF16ToString(buffer)
+}
+
+func comboinputGetPtr(hwnd win.HWND) *comboinputElement {
+	gwl := win.GetWindowLongPtr(hwnd, win.GWLP_USERDATA)
+	if gwl == 0 {
+		panic("Internal error.")
+	}
+
+	ptr := (*comboinputElement)(unsafe.Pointer(gwl))
+	if ptr.Hwnd != hwnd && ptr.Hwnd != 0 {
+		panic("Internal error.")
+	}
+
+	return ptr
+}
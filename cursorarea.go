@@ -0,0 +1,115 @@
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/windows"
+)
+
+var (
+	cursorAreaKind = base.NewKind("github.com/chaolihf/goey.CursorArea")
+)
+
+// Cursor identifies the shape of the mouse cursor.
+type Cursor = windows.Cursor
+
+// Shapes for the mouse cursor.
+const (
+	CursorDefault          = windows.CursorDefault          // Platform's default cursor, normally an arrow
+	CursorText             = windows.CursorText             // I-beam, used over editable text
+	CursorPointer          = windows.CursorPointer          // Pointing hand, used over links
+	CursorBusy             = windows.CursorBusy             // Wait cursor, used while the application cannot accept input
+	CursorCrosshair        = windows.CursorCrosshair        // Crosshair, used for precise selection
+	CursorResizeHorizontal = windows.CursorResizeHorizontal // Double arrow for resizing left and right
+	CursorResizeVertical   = windows.CursorResizeVertical   // Double arrow for resizing up and down
+	CursorMove             = windows.CursorMove             // Four arrows, used when moving an object
+)
+
+// CursorArea wraps another widget to change the mouse cursor while the mouse
+// is over the child.  Layout and all other behavior are delegated to the
+// child widget.
+//
+// Controls that set their own cursor, such as text inputs, may keep their
+// cursor.  On Cocoa, the cursor is not currently changed.
+type CursorArea struct {
+	Cursor Cursor      // Cursor shown while the mouse is over the child
+	Child  base.Widget // Child widget
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*CursorArea) Kind() *base.Kind {
+	return &cursorAreaKind
+}
+
+// Mount creates the child widget in the GUI.  The newly created widget will
+// be a child of the widget specified by parent.
+func (w *CursorArea) Mount(parent base.Control) (base.Element, error) {
+	// Mount the child
+	child, err := base.Mount(parent, w.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CursorAreaElement{
+		parent: parent,
+		child:  child,
+		cursor: w.Cursor,
+	}, nil
+}
+
+// CursorAreaElement is the element created by mounting a CursorArea.
+type CursorAreaElement struct {
+	parent base.Control
+	child  base.Element
+	cursor Cursor
+	bounds base.Rectangle
+}
+
+func (w *CursorAreaElement) Close() {
+	windows.RemoveCursorRegion(w)
+	if w.child != nil {
+		w.child.Close()
+		w.child = nil
+	}
+}
+
+func (*CursorAreaElement) Kind() *base.Kind {
+	return &cursorAreaKind
+}
+
+func (w *CursorAreaElement) Layout(bc base.Constraints) base.Size {
+	return w.child.Layout(bc)
+}
+
+func (w *CursorAreaElement) MinIntrinsicHeight(width base.Length) base.Length {
+	return w.child.MinIntrinsicHeight(width)
+}
+
+func (w *CursorAreaElement) MinIntrinsicWidth(height base.Length) base.Length {
+	return w.child.MinIntrinsicWidth(height)
+}
+
+// SetBounds updates the position of the child, and of the region where the
+// cursor is shown.
+func (w *CursorAreaElement) SetBounds(bounds base.Rectangle) {
+	w.bounds = bounds
+	windows.SetCursorRegion(w, w.parent, w.bounds, w.cursor)
+	w.child.SetBounds(bounds)
+}
+
+func (w *CursorAreaElement) updateProps(data *CursorArea) (err error) {
+	w.child, err = base.DiffChild(w.parent, w.child, data.Child)
+	w.cursor = data.Cursor
+	if w.bounds != (base.Rectangle{}) {
+		windows.SetCursorRegion(w, w.parent, w.bounds, w.cursor)
+	}
+	return err
+}
+
+func (w *CursorAreaElement) UpdateProps(data base.Widget) error {
+	return w.updateProps(data.(*CursorArea))
+}
+
+func (w *CursorAreaElement) Children() base.Element {
+	return w.child
+}
@@ -0,0 +1,106 @@
+package goey
+
+import (
+	"errors"
+	"image"
+	"testing"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/goeytest"
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/goey/mock"
+)
+
+func (w *CursorAreaElement) Props() base.Widget {
+	child := base.Widget(nil)
+	if w.child != nil {
+		child = w.child.(Proper).Props()
+	}
+
+	return &CursorArea{
+		Cursor: w.cursor,
+		Child:  child,
+	}
+}
+
+func TestCursorAreaMount(t *testing.T) {
+	testMountWidgets(t,
+		&CursorArea{},
+		&CursorArea{Cursor: CursorPointer, Child: &mock.Widget{}},
+		&CursorArea{Cursor: CursorText, Child: &Label{Text: "A"}},
+	)
+
+	// This should mount with an error.
+	err := errors.New("Mock error 1")
+	testMountWidgetsFail(t, err,
+		&CursorArea{Child: &mock.Widget{Err: err}},
+	)
+}
+
+func TestCursorAreaClose(t *testing.T) {
+	testCloseWidgets(t,
+		&CursorArea{},
+		&CursorArea{Cursor: CursorPointer, Child: &mock.Widget{}},
+	)
+}
+
+func TestCursorAreaUpdateProps(t *testing.T) {
+	child := mock.Widget{}
+
+	testUpdateWidgets(t, []base.Widget{
+		&CursorArea{},
+		&CursorArea{Child: &child},
+		&CursorArea{Child: &child, Cursor: CursorPointer},
+	}, []base.Widget{
+		&CursorArea{Child: &child, Cursor: CursorMove},
+		&CursorArea{},
+		&CursorArea{Child: &child, Cursor: CursorPointer},
+	})
+}
+
+func TestCursorArea_Cursor(t *testing.T) {
+	window, closer := goeytest.WithWindow(t, &Padding{
+		Insets: UniformInsets(10 * DIP),
+		Child: &CursorArea{
+			Cursor: CursorPointer,
+			Child:  &mock.Widget{Size: base.Size{20 * DIP, 20 * DIP}},
+		},
+	})
+	defer closer()
+
+	err := loop.Do(func() error {
+		// Checking the cursor at a position is only simulated when headless.
+		ca, ok := interface{}(window).(interface {
+			CursorAt(image.Point) Cursor
+		})
+		if !ok {
+			return nil
+		}
+
+		inside := image.Pt((15 * DIP).PixelsX(), (15 * DIP).PixelsY())
+		if got := ca.CursorAt(inside); got != CursorPointer {
+			t.Errorf("Incorrect cursor inside area, want %s, got %s", CursorPointer, got)
+		}
+		if got := ca.CursorAt(image.Point{}); got != CursorDefault {
+			t.Errorf("Incorrect cursor outside area, want %s, got %s", CursorDefault, got)
+		}
+
+		// The window's cursor is used outside the area.
+		window.SetCursor(CursorCrosshair)
+		if got := ca.CursorAt(image.Point{}); got != CursorCrosshair {
+			t.Errorf("Incorrect cursor outside area, want %s, got %s", CursorCrosshair, got)
+		}
+
+		// The busy cursor overrides the area.
+		window.SetBusy(true)
+		if got := ca.CursorAt(inside); got != CursorBusy {
+			t.Errorf("Incorrect cursor while busy, want %s, got %s", CursorBusy, got)
+		}
+		window.SetBusy(false)
+		window.SetCursor(CursorDefault)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("failed loop.Do: %s", err)
+	}
+}
@@ -1,8 +1,9 @@
 package goey
 
 import (
-	"github.com/chaolihf/goey/base"
 	"time"
+
+	"github.com/chaolihf/goey/base"
 )
 
 var (
@@ -11,12 +12,17 @@ var (
 
 // DateInput describes a widget that users input or update a single date.
 // The model for the value is a time.Time value.
+//
+// The fields Min and Max may be left as the zero value for time.Time, in
+// which case the range for the date is unbounded in that direction.
 type DateInput struct {
-	Value    time.Time             // Values is the current string for the field
-	Disabled bool                  // Disabled is a flag indicating that the user cannot interact with this field
-	OnChange func(value time.Time) // OnChange will be called whenever the user changes the value for this field
-	OnFocus  func()                // OnFocus will be called whenever the field receives the keyboard focus
-	OnBlur   func()                // OnBlur will be called whenever the field loses the keyboard focus
+	Value     time.Time             // Values is the current string for the field
+	Disabled  bool                  // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus bool                  // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
+	Min, Max  time.Time             // Min and Max set the range of Value, if not zero
+	OnChange  func(value time.Time) // OnChange will be called whenever the user changes the value for this field
+	OnFocus   func()                // OnFocus will be called whenever the field receives the keyboard focus
+	OnBlur    func()                // OnBlur will be called whenever the field loses the keyboard focus
 }
 
 // Kind returns the concrete type for use in the Widget interface.
@@ -28,8 +34,21 @@ func (*DateInput) Kind() *base.Kind {
 // Mount creates a text field in the GUI.  The newly created widget
 // will be a child of the widget specified by parent.
 func (w *DateInput) Mount(parent base.Control) (base.Element, error) {
+	// Make sure that the value is within the range.
+	w.UpdateValue()
+
 	// Forward to the platform-dependant code
-	return w.mount(parent)
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
+}
+
+// UpdateValue clamps the field Value to the range [Min,Max].  If either Min
+// or Max is zero, then the range is not bounded in that direction.
+func (w *DateInput) UpdateValue() {
+	w.Value = clampTime(w.Value, w.Min, w.Max)
 }
 
 func (*dateinputElement) Kind() *base.Kind {
@@ -37,5 +56,22 @@ func (*dateinputElement) Kind() *base.Kind {
 }
 
 func (w *dateinputElement) UpdateProps(data base.Widget) error {
-	return w.updateProps(data.(*DateInput))
+	widget := data.(*DateInput)
+
+	// Make sure that the value is within the range.
+	widget.UpdateValue()
+	// Forward to the platform-dependant code
+	return w.updateProps(widget)
+}
+
+// clampTime clamps the value to the range [min,max].  If either min or max
+// is zero, then the range is not bounded in that direction.
+func clampTime(value, min, max time.Time) time.Time {
+	if !min.IsZero() && value.Before(min) {
+		return min
+	}
+	if !max.IsZero() && value.After(max) {
+		return max
+	}
+	return value
 }
@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package goey
@@ -12,6 +13,7 @@ import (
 
 type dateinputElement struct {
 	Control
+	min, max time.Time
 
 	onChange func(time.Time)
 	onFocus  func()
@@ -21,11 +23,13 @@ type dateinputElement struct {
 func (w *DateInput) mount(parent base.Control) (base.Element, error) {
 	control := gtk.MountDateInput(parent.Handle,
 		w.Value.Year(), uint(w.Value.Month()), uint(w.Value.Day()), w.Disabled,
-		w.OnChange != nil, w.OnFocus != nil, w.OnBlur != nil)
+		w.needsOnChange(), w.OnFocus != nil, w.OnBlur != nil)
 
 	// Create the element
 	retval := &dateinputElement{
 		Control:  Control{control},
+		min:      w.Min,
+		max:      w.Max,
 		onChange: w.OnChange,
 		onFocus:  w.OnFocus,
 		onBlur:   w.OnBlur,
@@ -35,7 +39,21 @@ func (w *DateInput) mount(parent base.Control) (base.Element, error) {
 	return retval, nil
 }
 
+// needsOnChange returns true if the element needs to monitor changes to the
+// selected date, either to call OnChange, or to enforce the range.
+func (w *DateInput) needsOnChange() bool {
+	return w.OnChange != nil || !w.Min.IsZero() || !w.Max.IsZero()
+}
+
 func (w *dateinputElement) OnChange(value time.Time) {
+	// The calendar does not support a range, so any selection outside of the
+	// range is moved back inside.  Selecting the new date will trigger another
+	// call to this method.
+	if clamped := clampTime(value, w.min, w.max); !clamped.Equal(value) {
+		gtk.DateInputSelect(w.handle, clamped.Year(), uint(clamped.Month()), uint(clamped.Day()))
+		return
+	}
+
 	if w.onChange != nil {
 		w.onChange(value)
 	}
@@ -57,6 +75,8 @@ func (w *dateinputElement) Props() base.Widget {
 	return &DateInput{
 		Value:    time.Date(year, time.Month(month), int(day), 0, 0, 0, 0, time.Local),
 		Disabled: !gtk.WidgetSensitive(w.handle),
+		Min:      w.min,
+		Max:      w.max,
 		OnChange: w.onChange,
 		OnFocus:  w.onFocus,
 		OnBlur:   w.onBlur,
@@ -68,8 +88,10 @@ func (w *dateinputElement) updateProps(data *DateInput) error {
 	w.onChange = nil // temporarily break OnChange to prevent event
 	gtk.DateInputUpdate(w.handle,
 		data.Value.Year(), uint(data.Value.Month()), uint(data.Value.Day()), data.Disabled,
-		data.OnChange != nil, data.OnFocus != nil, data.OnBlur != nil)
+		data.needsOnChange(), data.OnFocus != nil, data.OnBlur != nil)
 
+	w.min = data.Min
+	w.max = data.Max
 	w.onChange = data.OnChange
 	w.onFocus = data.OnFocus
 	w.onBlur = data.OnBlur
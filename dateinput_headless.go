@@ -0,0 +1,62 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type dateinputElement struct {
+	Control
+	props DateInput
+}
+
+func (w *DateInput) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &dateinputElement{
+		Control: newControl(parent, 120, 23),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// SetValue simulates a user selecting a date.  The date is clamped to the
+// allowed range.
+func (w *dateinputElement) SetValue(value time.Time) {
+	if w.props.Disabled {
+		return
+	}
+
+	value = clampTime(value, w.props.Min, w.props.Max)
+	if value.Equal(w.props.Value) {
+		return
+	}
+	w.props.Value = value
+	if w.props.OnChange != nil {
+		w.props.OnChange(value)
+	}
+}
+
+func (w *dateinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	paintTextField(dst, bounds, w.node, w.props.Value.Format("2006-01-02"), "", w.props.Disabled)
+}
+
+func (w *dateinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *dateinputElement) updateProps(data *DateInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
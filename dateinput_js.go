@@ -1,5 +1,5 @@
-//go:build go1.12
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package goey
 
@@ -13,6 +13,7 @@ import (
 
 type dateinputElement struct {
 	Control
+	min, max time.Time
 
 	onChange goeyjs.ChangeDateCB
 	onFocus  goeyjs.FocusCB
@@ -87,6 +88,8 @@ func (w *dateinputElement) Props() base.Widget {
 	return &DateInput{
 		Value:    value.Local(),
 		Disabled: w.handle.Get("disabled").Truthy(),
+		Min:      w.min,
+		Max:      w.max,
 		OnChange: w.onChange.Fn,
 		OnFocus:  w.onFocus.Fn,
 		OnBlur:   w.onBlur.Fn,
@@ -96,6 +99,9 @@ func (w *dateinputElement) Props() base.Widget {
 func (w *dateinputElement) updateProps(data *DateInput) error {
 	w.handle.Set("value", data.Value.Format("2006-01-02"))
 	w.handle.Set("disabled", data.Disabled)
+	setTimeAttribute(w.handle, "min", data.Min, "2006-01-02")
+	setTimeAttribute(w.handle, "max", data.Max, "2006-01-02")
+	w.min, w.max = data.Min, data.Max
 
 	w.onChange.Set(w.handle, data.OnChange)
 	w.onFocus.Set(w.handle, data.OnFocus)
@@ -103,3 +109,13 @@ func (w *dateinputElement) updateProps(data *DateInput) error {
 
 	return nil
 }
+
+// setTimeAttribute sets an attribute, such as min or max, on an HTMLInputElement.
+// If the value is zero, the attribute is removed.
+func setTimeAttribute(handle js.Value, name string, value time.Time, layout string) {
+	if value.IsZero() {
+		handle.Call("removeAttribute", name)
+		return
+	}
+	handle.Set(name, value.Format(layout))
+}
@@ -16,6 +16,7 @@ func TestDateInputMount(t *testing.T) {
 		&DateInput{Value: v1},
 		&DateInput{Value: v2, Disabled: true},
 		&DateInput{Value: v3},
+		&DateInput{Value: v2, Min: v1, Max: v3},
 	)
 }
 
@@ -56,3 +57,35 @@ func TestDateInputUpdate(t *testing.T) {
 		&DateInput{Value: v1, Disabled: true},
 	})
 }
+
+func TestDateInputUpdateValue(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 0, 0, 0, 0, time.Local)
+	v2 := time.Date(2007, time.February, 3, 0, 0, 0, 0, time.Local)
+	v3 := time.Date(2007, time.March, 4, 0, 0, 0, 0, time.Local)
+
+	cases := []struct {
+		in, min, max, out time.Time
+	}{
+		{v2, time.Time{}, time.Time{}, v2},
+		{v1, v2, v3, v2},
+		{v2, v1, v3, v2},
+		{v3, v1, v2, v2},
+		{v1, v2, time.Time{}, v2},
+		{v3, v2, time.Time{}, v3},
+		{v1, time.Time{}, v2, v1},
+		{v3, time.Time{}, v2, v2},
+	}
+
+	for i, v := range cases {
+		widget := DateInput{
+			Value: v.in,
+			Min:   v.min,
+			Max:   v.max,
+		}
+
+		widget.UpdateValue()
+		if got := widget.Value; !got.Equal(v.out) {
+			t.Errorf("case %d: got %v, want %v", i, got, v.out)
+		}
+	}
+}
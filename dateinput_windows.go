@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package goey
 
 import (
@@ -19,14 +22,39 @@ func init() {
 }
 
 func (w *DateInput) systemTime() win.SYSTEMTIME {
+	return toSystemTime(w.Value)
+}
+
+func toSystemTime(t time.Time) win.SYSTEMTIME {
 	return win.SYSTEMTIME{
-		WYear:   uint16(w.Value.Year()),
-		WMonth:  uint16(w.Value.Month()),
-		WDay:    uint16(w.Value.Day()),
-		WHour:   uint16(w.Value.Hour()),
-		WMinute: uint16(w.Value.Minute()),
-		WSecond: uint16(w.Value.Second()),
+		WYear:   uint16(t.Year()),
+		WMonth:  uint16(t.Month()),
+		WDay:    uint16(t.Day()),
+		WHour:   uint16(t.Hour()),
+		WMinute: uint16(t.Minute()),
+		WSecond: uint16(t.Second()),
+	}
+}
+
+func fromSystemTime(st *win.SYSTEMTIME) time.Time {
+	return time.Date(int(st.WYear), time.Month(st.WMonth), int(st.WDay),
+		int(st.WHour), int(st.WMinute), int(st.WSecond), 0, time.Local)
+}
+
+// setDateTimeRange is a wrapper around the message DTM_SETRANGE.  If either
+// min or max is zero, that limit is cleared.
+func setDateTimeRange(hwnd win.HWND, min, max time.Time) {
+	st := [2]win.SYSTEMTIME{}
+	flags := uintptr(0)
+	if !min.IsZero() {
+		st[0] = toSystemTime(min)
+		flags |= win.GDTR_MIN
+	}
+	if !max.IsZero() {
+		st[1] = toSystemTime(max)
+		flags |= win.GDTR_MAX
 	}
+	win.SendMessage(hwnd, win.DTM_SETRANGE, flags, uintptr(unsafe.Pointer(&st[0])))
 }
 
 func (w *DateInput) mount(parent base.Control) (base.Element, error) {
@@ -39,6 +67,7 @@ func (w *DateInput) mount(parent base.Control) (base.Element, error) {
 	// Set the properties for the control
 	st := w.systemTime()
 	win.SendMessage(hwnd, win.DTM_SETSYSTEMTIME, win.GDT_VALID, uintptr(unsafe.Pointer(&st)))
+	setDateTimeRange(hwnd, w.Min, w.Max)
 	if w.Disabled {
 		win.EnableWindow(hwnd, false)
 	}
@@ -48,6 +77,8 @@ func (w *DateInput) mount(parent base.Control) (base.Element, error) {
 
 	retval := &dateinputElement{
 		Control:  Control{hwnd},
+		min:      w.Min,
+		max:      w.Max,
 		onChange: w.OnChange,
 		onFocus:  w.OnFocus,
 		onBlur:   w.OnBlur,
@@ -59,6 +90,8 @@ func (w *DateInput) mount(parent base.Control) (base.Element, error) {
 
 type dateinputElement struct {
 	Control
+	min      time.Time
+	max      time.Time
 	onChange func(value time.Time)
 	onFocus  func()
 	onBlur   func()
@@ -85,9 +118,10 @@ func (w *dateinputElement) Props() base.Widget {
 	win.SendMessage(w.Hwnd, win.DTM_GETSYSTEMTIME, 0, uintptr(unsafe.Pointer(&st)))
 
 	return &DateInput{
-		Value: time.Date(int(st.WYear), time.Month(st.WMonth), int(st.WDay),
-			int(st.WHour), int(st.WMinute), int(st.WSecond), 0, time.Local),
+		Value:    fromSystemTime(&st),
 		Disabled: !win.IsWindowEnabled(w.Hwnd),
+		Min:      w.min,
+		Max:      w.max,
 		OnChange: w.onChange,
 		OnFocus:  w.onFocus,
 		OnBlur:   w.onBlur,
@@ -95,6 +129,10 @@ func (w *dateinputElement) Props() base.Widget {
 }
 
 func (w *dateinputElement) updateProps(data *DateInput) error {
+	// Update the range before the value, so that the new value is not clamped
+	// by the old range.
+	setDateTimeRange(w.Hwnd, data.Min, data.Max)
+	w.min, w.max = data.Min, data.Max
 	st := data.systemTime()
 	win.SendMessage(w.Hwnd, win.DTM_SETSYSTEMTIME, win.GDT_VALID, uintptr(unsafe.Pointer(&st)))
 
@@ -130,8 +168,7 @@ func dateinputWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintp
 		case win.DTN_DATETIMECHANGE:
 			if w := dateinputGetPtr(hwnd); w.onChange != nil {
 				nmhdr := (*win.NMDATETIMECHANGE)(unsafe.Pointer(lParam))
-				st := time.Date(int(nmhdr.St.WYear), time.Month(nmhdr.St.WMonth), int(nmhdr.St.WDay), int(nmhdr.St.WHour), int(nmhdr.St.WMinute), int(nmhdr.St.WSecond), 0, time.Local)
-				w.onChange(st)
+				w.onChange(fromSystemTime(&nmhdr.St))
 			}
 
 		case win2.MCN_SELECT:
@@ -0,0 +1,72 @@
+package goey
+
+import (
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+var (
+	dateTimeInputKind = base.NewKind("github.com/chaolihf/goey.DateTimeInput")
+)
+
+// DateTimeInput describes a widget that users input or update a date and
+// time of day.  The model for the value is a time.Time value.
+//
+// The fields Min and Max may be left as the zero value for time.Time, in
+// which case the range is unbounded in that direction.
+type DateTimeInput struct {
+	Value     time.Time             // Value is the current date and time for the field
+	Disabled  bool                  // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus bool                  // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
+	Seconds   bool                  // Seconds is a flag indicating that seconds can be edited
+	Hour12    bool                  // Hour12 is a flag indicating that the time uses a 12-hour clock
+	Min, Max  time.Time             // Min and Max set the range of Value, if not zero
+	OnChange  func(value time.Time) // OnChange will be called whenever the user changes the value for this field
+	OnFocus   func()                // OnFocus will be called whenever the field receives the keyboard focus
+	OnBlur    func()                // OnBlur will be called whenever the field loses the keyboard focus
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*DateTimeInput) Kind() *base.Kind {
+	return &dateTimeInputKind
+}
+
+// Mount creates a date and time field in the GUI.  The newly created widget
+// will be a child of the widget specified by parent.
+func (w *DateTimeInput) Mount(parent base.Control) (base.Element, error) {
+	// Make sure that the value is within the range.
+	w.UpdateValue()
+
+	// Forward to the platform-dependant code
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
+}
+
+// UpdateValue clamps the field Value to the range [Min,Max].  If either Min
+// or Max is zero, then the range is not bounded in that direction.  If
+// seconds are not shown, Value is truncated to the minute.
+func (w *DateTimeInput) UpdateValue() {
+	value := w.Value
+	if !w.Seconds {
+		value = withClock(value, value.Hour(), value.Minute(), 0)
+	}
+	w.Value = clampTime(value, w.Min, w.Max)
+}
+
+func (*datetimeinputElement) Kind() *base.Kind {
+	return &dateTimeInputKind
+}
+
+func (w *datetimeinputElement) UpdateProps(data base.Widget) error {
+	widget := data.(*DateTimeInput)
+
+	// Make sure that the value is within the range.
+	widget.UpdateValue()
+	// Forward to the platform-dependant code
+	return w.updateProps(widget)
+}
@@ -0,0 +1,117 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package goey
+
+import (
+	"time"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+// Range of the control, as Unix time, when the range is not set.  GLib can
+// only represent dates between the years 1 and 9999, so a day is left at
+// either end to allow for the local time zone.
+const (
+	minUnixTime = -62135596800 + 86400 // 0001-01-02 00:00:00 UTC
+	maxUnixTime = 253402300799 - 86400 // 9999-12-30 23:59:59 UTC
+)
+
+type datetimeinputElement struct {
+	Control
+	min, max time.Time
+
+	onChange func(time.Time)
+	onFocus  func()
+	onBlur   func()
+}
+
+func (w *DateTimeInput) flags() uint {
+	flags := uint(gtk.TimeDate)
+	if w.Seconds {
+		flags |= gtk.TimeSeconds
+	}
+	if w.Hour12 {
+		flags |= gtk.TimeHour12
+	}
+	return flags
+}
+
+// unixRange returns the range for the control, as Unix time.
+func (w *DateTimeInput) unixRange() (float64, float64) {
+	min, max := int64(minUnixTime), int64(maxUnixTime)
+	if !w.Min.IsZero() && w.Min.Unix() > min {
+		min = w.Min.Unix()
+	}
+	if !w.Max.IsZero() && w.Max.Unix() < max {
+		max = w.Max.Unix()
+	}
+	return float64(min), float64(max)
+}
+
+func (w *DateTimeInput) mount(parent base.Control) (base.Element, error) {
+	min, max := w.unixRange()
+	control := gtk.MountTimeInput(parent.Handle,
+		float64(w.Value.Unix()), min, max, w.flags(), w.Disabled,
+		w.OnChange != nil, w.OnFocus != nil, w.OnBlur != nil)
+
+	// Create the element
+	retval := &datetimeinputElement{
+		Control:  Control{control},
+		min:      w.Min,
+		max:      w.Max,
+		onChange: w.OnChange,
+		onFocus:  w.OnFocus,
+		onBlur:   w.OnBlur,
+	}
+	gtk.RegisterWidget(control, retval)
+
+	return retval, nil
+}
+
+func (w *datetimeinputElement) OnChange(value int64) {
+	if w.onChange != nil {
+		w.onChange(time.Unix(value, 0))
+	}
+}
+
+func (w *datetimeinputElement) OnFocus() {
+	w.onFocus()
+}
+
+func (w *datetimeinputElement) OnBlur() {
+	w.onBlur()
+}
+
+func (w *datetimeinputElement) Props() base.Widget {
+	flags := gtk.TimeInputFlags(w.handle)
+
+	return &DateTimeInput{
+		Value:    time.Unix(gtk.TimeInputValue(w.handle), 0),
+		Disabled: !gtk.WidgetSensitive(w.handle),
+		Seconds:  flags&gtk.TimeSeconds != 0,
+		Hour12:   flags&gtk.TimeHour12 != 0,
+		Min:      w.min,
+		Max:      w.max,
+		OnChange: w.onChange,
+		OnFocus:  w.onFocus,
+		OnBlur:   w.onBlur,
+	}
+}
+
+func (w *datetimeinputElement) updateProps(data *DateTimeInput) error {
+	min, max := data.unixRange()
+	gtk.TimeInputUpdate(w.handle,
+		float64(data.Value.Unix()), min, max, data.flags(), data.Disabled,
+		data.OnChange != nil, data.OnFocus != nil, data.OnBlur != nil)
+
+	w.min = data.Min
+	w.max = data.Max
+	w.onChange = data.OnChange
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+
+	return nil
+}
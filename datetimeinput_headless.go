@@ -0,0 +1,65 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type datetimeinputElement struct {
+	Control
+	props DateTimeInput
+}
+
+func (w *DateTimeInput) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &datetimeinputElement{
+		Control: newControl(parent, 180, 23),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// SetValue simulates a user selecting a date and time.  The value is
+// normalized in the same manner as for UpdateProps.
+func (w *datetimeinputElement) SetValue(value time.Time) {
+	if w.props.Disabled {
+		return
+	}
+
+	props := w.props
+	props.Value = value
+	props.UpdateValue()
+	if props.Value.Equal(w.props.Value) {
+		return
+	}
+	w.props.Value = props.Value
+	if w.props.OnChange != nil {
+		w.props.OnChange(props.Value)
+	}
+}
+
+func (w *datetimeinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	text := w.props.Value.Format("2006-01-02 " + clockLayout(w.props.Seconds, w.props.Hour12))
+	paintTextField(dst, bounds, w.node, text, "", w.props.Disabled)
+}
+
+func (w *datetimeinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *datetimeinputElement) updateProps(data *DateTimeInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
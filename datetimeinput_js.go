@@ -0,0 +1,133 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package goey
+
+import (
+	"syscall/js"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/js"
+)
+
+// datetimeLayout returns the format used by an HTMLInputElement of type
+// datetime-local.
+func datetimeLayout(seconds bool) string {
+	return "2006-01-02T" + timeLayout(seconds)
+}
+
+type datetimeinputElement struct {
+	Control
+	seconds  bool
+	hour12   bool
+	min, max time.Time
+
+	onChange goeyjs.ChangeTimeCB
+	onFocus  goeyjs.FocusCB
+	onBlur   goeyjs.BlurCB
+}
+
+func (w *DateTimeInput) mount(parent base.Control) (base.Element, error) {
+	// Create the control
+	handle := goeyjs.CreateElement("input", "goey form-control")
+	handle.Set("type", "datetime-local")
+	parent.Handle.Call("appendChild", handle)
+
+	// Create the element
+	retval := &datetimeinputElement{
+		Control: Control{handle},
+	}
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+func (w *datetimeinputElement) Close() {
+	w.onChange.Close()
+	w.onFocus.Close()
+	w.onBlur.Close()
+
+	w.Control.Close()
+}
+
+func (w *datetimeinputElement) createMeasurementElement() js.Value {
+	handle := goeyjs.CreateElement("input", "form-control goey-measure")
+	handle.Set("type", "datetime-local")
+	if w.seconds {
+		handle.Set("step", 1)
+	}
+
+	goeyjs.AppendChildToBody(handle)
+
+	return handle
+}
+
+func (w *datetimeinputElement) Layout(bc base.Constraints) base.Size {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := base.FromPixelsX(handle.Get("offsetWidth").Int() + 1)
+	width = bc.ConstrainWidth(width)
+	height := base.FromPixelsY(handle.Get("offsetHeight").Int() + 1)
+	height = bc.ConstrainHeight(height)
+
+	return base.Size{width, height}
+}
+
+func (w *datetimeinputElement) MinIntrinsicHeight(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	height := handle.Get("offsetHeight").Int()
+
+	return base.FromPixelsY(height)
+}
+
+func (w *datetimeinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := handle.Get("offsetWidth").Int()
+
+	return base.FromPixelsX(width + 1)
+}
+
+func (w *datetimeinputElement) Props() base.Widget {
+	value, _ := goeyjs.ParseTime(w.handle.Get("value").String(), time.Time{})
+
+	return &DateTimeInput{
+		Value:    value,
+		Disabled: w.handle.Get("disabled").Truthy(),
+		Seconds:  w.seconds,
+		Hour12:   w.hour12,
+		Min:      w.min,
+		Max:      w.max,
+		OnChange: w.onChange.Fn,
+		OnFocus:  w.onFocus.Fn,
+		OnBlur:   w.onBlur.Fn,
+	}
+}
+
+func (w *datetimeinputElement) updateProps(data *DateTimeInput) error {
+	// The browser controls whether a 12-hour clock is used, based on the
+	// user's locale.
+	if data.Seconds {
+		w.handle.Set("step", 1)
+	} else {
+		w.handle.Call("removeAttribute", "step")
+	}
+	setTimeAttribute(w.handle, "min", data.Min, datetimeLayout(data.Seconds))
+	setTimeAttribute(w.handle, "max", data.Max, datetimeLayout(data.Seconds))
+	w.handle.Set("value", data.Value.Format(datetimeLayout(data.Seconds)))
+	w.handle.Set("disabled", data.Disabled)
+	w.seconds = data.Seconds
+	w.hour12 = data.Hour12
+	w.min, w.max = data.Min, data.Max
+
+	w.onChange.Set(w.handle, data.OnChange)
+	w.onFocus.Set(w.handle, data.OnFocus)
+	w.onBlur.Set(w.handle, data.OnBlur)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package goey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+func TestDateTimeInputMount(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2007, time.February, 3, 8, 30, 15, 0, time.Local)
+	v3 := time.Date(2007, time.March, 4, 23, 59, 0, 0, time.Local)
+
+	testMountWidgets(t,
+		&DateTimeInput{Value: v1},
+		&DateTimeInput{Value: v2, Seconds: true},
+		&DateTimeInput{Value: v3, Disabled: true},
+		&DateTimeInput{Value: v1, Hour12: true},
+		&DateTimeInput{Value: v3, Min: v1, Max: v3},
+	)
+}
+
+func TestDateTimeInputClose(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2007, time.February, 3, 8, 30, 15, 0, time.Local)
+	v3 := time.Date(2007, time.March, 4, 23, 59, 0, 0, time.Local)
+
+	testCloseWidgets(t,
+		&DateTimeInput{Value: v1},
+		&DateTimeInput{Value: v2, Seconds: true},
+		&DateTimeInput{Value: v3, Disabled: true},
+	)
+}
+
+func TestDateTimeInputEvents(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2007, time.January, 2, 8, 30, 0, 0, time.Local)
+
+	testCheckFocusAndBlur(t,
+		&DateTimeInput{Value: v1},
+		&DateTimeInput{Value: v2},
+		&DateTimeInput{Value: v2},
+	)
+}
+
+func TestDateTimeInputUpdate(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2007, time.January, 2, 8, 30, 15, 0, time.Local)
+
+	testUpdateWidgets(t, []base.Widget{
+		&DateTimeInput{Value: v1},
+		&DateTimeInput{Value: v2, Disabled: true, Seconds: true},
+		&DateTimeInput{Value: v2},
+	}, []base.Widget{
+		&DateTimeInput{Value: v2, Seconds: true},
+		&DateTimeInput{Value: v2, Disabled: false},
+		&DateTimeInput{Value: v1, Disabled: true, Hour12: true},
+	})
+}
+
+func TestDateTimeInputUpdateValue(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local)
+	v2 := time.Date(2007, time.February, 3, 8, 30, 0, 0, time.Local)
+	v3 := time.Date(2007, time.March, 4, 23, 59, 0, 0, time.Local)
+
+	cases := []struct {
+		in       time.Time
+		seconds  bool
+		min, max time.Time
+		out      time.Time
+	}{
+		{v1, true, time.Time{}, time.Time{}, v1},
+		{v1, false, time.Time{}, time.Time{}, v1.Truncate(time.Minute)},
+		{v1, false, v2, v3, v2},
+		{v2, false, v1, v3, v2},
+		{v3, false, v1, v2, v2},
+		{v1, true, v2, time.Time{}, v2},
+		{v3, false, time.Time{}, v2, v2},
+	}
+
+	for i, v := range cases {
+		widget := DateTimeInput{
+			Value:   v.in,
+			Seconds: v.seconds,
+			Min:     v.min,
+			Max:     v.max,
+		}
+
+		widget.UpdateValue()
+		if got := widget.Value; !got.Equal(v.out) {
+			t.Errorf("case %d: got %v, want %v", i, got, v.out)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+//go:build !headless
+// +build !headless
+
+package goey
+
+import (
+	"unsafe"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/win"
+)
+
+func (w *DateTimeInput) format() string {
+	return "yyyy'-'MM'-'dd' '" + timeFormat(w.Seconds, w.Hour12)
+}
+
+func (w *DateTimeInput) mount(parent base.Control) (base.Element, error) {
+	hwnd, err := mountTimepick(parent, 0, w.format(), w.Value)
+	if err != nil {
+		return nil, err
+	}
+	if w.Disabled {
+		win.EnableWindow(hwnd, false)
+	}
+
+	retval := &datetimeinputElement{timepick{
+		Control:  Control{hwnd},
+		seconds:  w.Seconds,
+		hour12:   w.Hour12,
+		min:      w.Min,
+		max:      w.Max,
+		onChange: w.OnChange,
+		onFocus:  w.OnFocus,
+		onBlur:   w.OnBlur,
+	}}
+	retval.setValue(w.Value, w.Min, w.Max)
+	win.SetWindowLongPtr(hwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(&retval.timepick)))
+
+	return retval, nil
+}
+
+type datetimeinputElement struct {
+	timepick
+}
+
+func (w *datetimeinputElement) Layout(bc base.Constraints) base.Size {
+	height := w.MinIntrinsicHeight(0)
+	width := w.MinIntrinsicWidth(0)
+	return bc.Constrain(base.Size{width, height})
+}
+
+func (w *datetimeinputElement) MinIntrinsicHeight(base.Length) base.Length {
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/dn742486.aspx#sizingandspacing
+	return 23 * DIP
+}
+
+func (w *datetimeinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	// Need space for both the date and the time.
+	return 150 * DIP
+}
+
+func (w *datetimeinputElement) Props() base.Widget {
+	return &DateTimeInput{
+		Value:    w.value(),
+		Disabled: !win.IsWindowEnabled(w.Hwnd),
+		Seconds:  w.seconds,
+		Hour12:   w.hour12,
+		Min:      w.min,
+		Max:      w.max,
+		OnChange: w.onChange,
+		OnFocus:  w.onFocus,
+		OnBlur:   w.onBlur,
+	}
+}
+
+func (w *datetimeinputElement) updateProps(data *DateTimeInput) error {
+	if data.Seconds != w.seconds || data.Hour12 != w.hour12 {
+		err := setTimepickFormat(w.Hwnd, data.format())
+		if err != nil {
+			return err
+		}
+	}
+	w.setValue(data.Value, data.Min, data.Max)
+
+	w.SetDisabled(data.Disabled)
+	w.seconds = data.Seconds
+	w.hour12 = data.Hour12
+	w.min = data.Min
+	w.max = data.Max
+	w.onChange = data.OnChange
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+	return nil
+}
@@ -0,0 +1,95 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+// DecorationElement is the element created by mounting a Decoration.
+type DecorationElement struct {
+	Control
+	parent base.Control
+
+	fill   color.RGBA
+	stroke color.RGBA
+	insets Insets
+	radius base.Length
+
+	child     base.Element
+	childSize base.Size
+}
+
+func (w *Decoration) mount(parent base.Control) (base.Element, error) {
+	retval := &DecorationElement{
+		Control: newControl(parent, 0, 0),
+		parent:  parent,
+		fill:    w.Fill,
+		stroke:  w.Stroke,
+		insets:  w.Insets,
+		radius:  w.Radius,
+	}
+	retval.node.Paint = retval.paint
+
+	// The child is a sibling of the decoration, and is drawn above it.
+	child, err := base.Mount(parent, w.Child)
+	if err != nil {
+		retval.Control.Close()
+		return nil, err
+	}
+	retval.child = child
+
+	return retval, nil
+}
+
+func (w *DecorationElement) Close() {
+	if w.child != nil {
+		w.child.Close()
+		w.child = nil
+	}
+	w.Control.Close()
+}
+
+func (w *DecorationElement) paint(dst draw.Image, bounds image.Rectangle) {
+	if w.fill.A != 0 {
+		headless.Fill(dst, bounds, w.fill)
+	}
+	if w.stroke.A != 0 {
+		headless.Stroke(dst, bounds, w.stroke)
+	}
+}
+
+func (w *DecorationElement) props() *Decoration {
+	return &Decoration{
+		Fill:   w.fill,
+		Stroke: w.stroke,
+		Insets: w.insets,
+		Radius: w.radius,
+	}
+}
+
+func (w *DecorationElement) SetBounds(bounds base.Rectangle) {
+	w.Control.SetBounds(bounds)
+
+	bounds.Min.X += w.insets.Left
+	bounds.Min.Y += w.insets.Top
+	bounds.Max.X -= w.insets.Right
+	bounds.Max.Y -= w.insets.Bottom
+	w.child.SetBounds(bounds)
+}
+
+func (w *DecorationElement) updateProps(data *Decoration) (err error) {
+	w.fill = data.Fill
+	w.stroke = data.Stroke
+	w.insets = data.Insets
+	w.radius = data.Radius
+	w.child, err = base.DiffChild(w.parent, w.child, data.Child)
+
+	return err
+}
@@ -0,0 +1,28 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"syscall"
+
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+var (
+	// The hook is used to set the title of the color and font dialogs.
+	// Callbacks are a limited resource, so only one is created.
+	chooserHookCallback = syscall.NewCallback(chooserHookProc)
+	chooserTitle        string
+)
+
+func chooserHookProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) uintptr {
+	if msg == win.WM_INITDIALOG {
+		activeDialogForTesting = hwnd
+		win2.SetWindowText(hwnd, chooserTitle)
+	}
+
+	// Allow the default dialog box procedure to process the message.
+	return 0
+}
@@ -0,0 +1,78 @@
+package dialog
+
+import (
+	"errors"
+	"image/color"
+	"strings"
+)
+
+// ColorChooser is a builder to construct a dialog that lets the user select
+// a color.
+type ColorChooser struct {
+	Dialog
+	title   string
+	initial color.RGBA
+	alpha   bool
+}
+
+// NewColorChooser initializes a new color chooser object.  The initial
+// color is opaque black.
+func NewColorChooser() *ColorChooser {
+	return &ColorChooser{
+		title:   "goey",
+		initial: color.RGBA{0, 0, 0, 0xff},
+	}
+}
+
+// Show completes building of the color chooser, and shows the dialog to the
+// user.  If the user accepts the dialog, the color selected is returned, and
+// the flag will be true.  If the user cancels the dialog, the flag will be
+// false.
+//
+// Unless alpha has been enabled with WithAlpha, the color returned will be
+// opaque.
+func (m *ColorChooser) Show() (color.RGBA, bool, error) {
+	if m.err != nil {
+		return color.RGBA{}, false, m.err
+	}
+
+	clr, ok, err := m.show()
+	if !ok || err != nil {
+		return color.RGBA{}, false, err
+	}
+	if !m.alpha {
+		clr.A = 0xff
+	}
+	return clr, true, nil
+}
+
+// WithAlpha allows the user to select the alpha channel of the color.  On
+// platforms where the native dialog cannot edit the alpha channel, the alpha
+// of the initial color is preserved.
+func (m *ColorChooser) WithAlpha() *ColorChooser {
+	m.alpha = true
+	return m
+}
+
+// WithInitial sets the color initially selected in the dialog.
+func (m *ColorChooser) WithInitial(clr color.RGBA) *ColorChooser {
+	m.initial = clr
+	return m
+}
+
+// WithOwner sets the owner of the dialog box.
+func (m *ColorChooser) WithOwner(owner Owner) *ColorChooser {
+	m.owner = owner
+	return m
+}
+
+// WithTitle sets the title of the dialog box.
+func (m *ColorChooser) WithTitle(text string) *ColorChooser {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		m.err = errors.New("Invalid argument, 'text' cannot be empty in call to WithTitle")
+	} else {
+		m.title = text
+	}
+	return m
+}
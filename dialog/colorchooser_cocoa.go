@@ -0,0 +1,27 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package dialog
+
+import (
+	"image/color"
+
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+func (m *ColorChooser) show() (color.RGBA, bool, error) {
+	rgba := [4]float64{
+		float64(m.initial.R) / 0xff,
+		float64(m.initial.G) / 0xff,
+		float64(m.initial.B) / 0xff,
+		float64(m.initial.A) / 0xff,
+	}
+	rgba = cocoa.ColorPanel(m.title, rgba, m.alpha)
+	return color.RGBA{
+		R: uint8(rgba[0]*0xff + 0.5),
+		G: uint8(rgba[1]*0xff + 0.5),
+		B: uint8(rgba[2]*0xff + 0.5),
+		A: uint8(rgba[3]*0xff + 0.5),
+	}, true, nil
+}
@@ -0,0 +1,39 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package dialog
+
+import (
+	"image/color"
+
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+func (m *ColorChooser) show() (color.RGBA, bool, error) {
+	rgba := [4]float64{
+		float64(m.initial.R) / 0xff,
+		float64(m.initial.G) / 0xff,
+		float64(m.initial.B) / 0xff,
+		float64(m.initial.A) / 0xff,
+	}
+	dlg := gtk.MountColorChooserDialog(m.owner.Handle, m.title, rgba, m.alpha)
+	activeDialogForTesting = dlg
+	defer func() {
+		activeDialogForTesting = 0
+		gtk.WidgetClose(dlg)
+	}()
+
+	rc := int(int32(gtk.DialogRun(dlg)))
+	if rc != gtkResponseOK {
+		return color.RGBA{}, false, nil
+	}
+
+	rgba = gtk.ColorChooserDialogRGBA(dlg)
+	return color.RGBA{
+		R: uint8(rgba[0]*0xff + 0.5),
+		G: uint8(rgba[1]*0xff + 0.5),
+		B: uint8(rgba[2]*0xff + 0.5),
+		A: uint8(rgba[3]*0xff + 0.5),
+	}, true, nil
+}
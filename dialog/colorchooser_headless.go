@@ -0,0 +1,32 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+import (
+	"image/color"
+)
+
+func (m *ColorChooser) show() (color.RGBA, bool, error) {
+	accepted := false
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		if r == '\n' || r == '\x1b' {
+			accepted, dlg.done = r == '\n', true
+		}
+	}
+	dlg.onButton = func(index int) {
+		accepted, dlg.done = index == 0, true
+	}
+
+	dlg.run()
+	if !accepted {
+		return color.RGBA{}, false, nil
+	}
+
+	clr := m.initial
+	if !m.alpha {
+		clr.A = 0xff
+	}
+	return clr, true, nil
+}
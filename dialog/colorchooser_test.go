@@ -0,0 +1,69 @@
+package dialog
+
+import (
+	"fmt"
+	"image/color"
+	"testing"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+func ExampleNewColorChooser() {
+	// The following creates a modal dialog asking the user to select a color.
+	clr, ok, err := NewColorChooser().WithTitle("Example").WithInitial(color.RGBA{0xff, 0, 0, 0xff}).Show()
+	if err != nil {
+		fmt.Println("Error: ", err)
+	} else if ok {
+		fmt.Printf("Selected color #%02x%02x%02x\n", clr.R, clr.G, clr.B)
+	}
+}
+
+func TestNewColorChooser(t *testing.T) {
+	initial := color.RGBA{0x80, 0x40, 0x20, 0x80}
+
+	cases := []struct {
+		build    func() (color.RGBA, bool, error)
+		async    func()
+		ok       bool
+		value    color.RGBA
+		accepted bool
+	}{
+		{func() (color.RGBA, bool, error) {
+			return NewColorChooser().WithTitle(t.Name()).WithInitial(initial).Show()
+		}, asyncKeyEnter, true, color.RGBA{0x80, 0x40, 0x20, 0xff}, true},
+		{func() (color.RGBA, bool, error) {
+			return NewColorChooser().WithTitle(t.Name()).WithInitial(initial).WithAlpha().Show()
+		}, asyncKeyEnter, true, initial, true},
+		{func() (color.RGBA, bool, error) {
+			return NewColorChooser().WithTitle(t.Name()).WithInitial(initial).Show()
+		}, asyncKeyEscape, true, color.RGBA{}, false},
+		{func() (color.RGBA, bool, error) { return color.RGBA{}, false, NewColorChooser().WithTitle("").Err() }, nil, false, color.RGBA{}, false},
+		{func() (color.RGBA, bool, error) { return NewColorChooser().WithTitle("").Show() }, nil, false, color.RGBA{}, false},
+	}
+
+	init := func() error {
+		for i, v := range cases {
+			if v.async != nil {
+				v.async()
+			}
+
+			value, accepted, err := v.build()
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d,  want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+			if value != v.value || accepted != v.accepted {
+				t.Errorf("Case %d,  want %v and %v, got %v and %v", i, v.value, v.accepted, value, accepted)
+			}
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
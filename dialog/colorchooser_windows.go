@@ -0,0 +1,49 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"fmt"
+	"image/color"
+	"unsafe"
+
+	"github.com/chaolihf/win"
+)
+
+var (
+	// Custom colors are shared by all color dialogs, so that they persist
+	// between calls.
+	customColors [16]win.COLORREF
+)
+
+func (m *ColorChooser) show() (color.RGBA, bool, error) {
+	chooserTitle = m.title
+	defer func() {
+		activeDialogForTesting = 0
+	}()
+
+	cc := win.CHOOSECOLOR{
+		LStructSize:  uint32(unsafe.Sizeof(win.CHOOSECOLOR{})),
+		HwndOwner:    m.owner.HWnd,
+		RgbResult:    win.COLORREF(m.initial.R) | win.COLORREF(m.initial.G)<<8 | win.COLORREF(m.initial.B)<<16,
+		LpCustColors: &customColors,
+		Flags:        win.CC_RGBINIT | win.CC_FULLOPEN | win.CC_ANYCOLOR | win.CC_ENABLEHOOK,
+		LpfnHook:     chooserHookCallback,
+	}
+	if !win.ChooseColor(&cc) {
+		if err := win.CommDlgExtendedError(); err != 0 {
+			return color.RGBA{}, false, fmt.Errorf("call to ChooseColor failed with code %x", err)
+		}
+		return color.RGBA{}, false, nil
+	}
+
+	// The native dialog cannot edit the alpha channel, so the alpha of the
+	// initial color is preserved.
+	return color.RGBA{
+		R: uint8(cc.RgbResult),
+		G: uint8(cc.RgbResult >> 8),
+		B: uint8(cc.RgbResult >> 16),
+		A: m.initial.A,
+	}, true, nil
+}
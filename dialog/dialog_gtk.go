@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package dialog
@@ -16,6 +17,12 @@ type Owner struct {
 	Handle uintptr
 }
 
+const (
+	// gtkResponseOK matches GTK_RESPONSE_OK, which is the response used by
+	// the color and font chooser dialogs when the user accepts a selection.
+	gtkResponseOK = -5
+)
+
 var (
 	activeDialogForTesting uintptr
 )
@@ -57,3 +64,25 @@ func asyncTypeKeys(text string, initialWait time.Duration) <-chan error {
 
 	return errs
 }
+
+func asyncClickButton(index int, initialWait time.Duration) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		time.Sleep(initialWait)
+		err := loop.Do(func() error {
+			if activeDialogForTesting == 0 {
+				panic("dialog is closed")
+			}
+			gtk.DialogResponse(activeDialogForTesting, index)
+			return nil
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return errs
+}
@@ -0,0 +1,95 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+import (
+	"time"
+
+	"github.com/chaolihf/goey/internal/headless"
+	"github.com/chaolihf/goey/loop"
+)
+
+// Owner holds a pointer to the owning window.
+// This type varies between platforms.
+type Owner struct {
+	Node *headless.Node
+}
+
+// modalDialog holds the state of a dialog while it is shown.  Without a
+// display, there are no controls, so simulated input is delivered directly
+// to the callbacks.
+type modalDialog struct {
+	onKey    func(r rune)
+	onButton func(index int)
+	done     bool
+}
+
+var (
+	activeDialogForTesting *modalDialog
+)
+
+// run blocks until the dialog has been closed, while continuing to process
+// actions posted to the event loop.
+func (d *modalDialog) run() {
+	activeDialogForTesting = d
+	defer func() {
+		activeDialogForTesting = nil
+	}()
+
+	loop.RunModal(func() bool {
+		return d.done
+	})
+}
+
+func asyncTypeKeys(text string, initialWait time.Duration) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		time.Sleep(initialWait)
+		for _, r := range text {
+			err := loop.Do(func() error {
+				if activeDialogForTesting == nil {
+					panic("dialog is closed")
+				}
+				if activeDialogForTesting.onKey != nil {
+					activeDialogForTesting.onKey(r)
+				}
+				return nil
+			})
+			if err != nil {
+				errs <- err
+				return
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}()
+
+	return errs
+}
+
+func asyncClickButton(index int, initialWait time.Duration) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		time.Sleep(initialWait)
+		err := loop.Do(func() error {
+			if activeDialogForTesting == nil {
+				panic("dialog is closed")
+			}
+			if activeDialogForTesting.onButton != nil {
+				activeDialogForTesting.onButton(index)
+			}
+			return nil
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return errs
+}
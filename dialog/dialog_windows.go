@@ -1,7 +1,11 @@
+//go:build !headless
+// +build !headless
+
 package dialog
 
 import (
 	"fmt"
+	"syscall"
 	"time"
 	"unsafe"
 
@@ -15,6 +19,10 @@ type Owner struct {
 	HWnd win.HWND
 }
 
+var (
+	activeDialogForTesting win.HWND
+)
+
 func asyncTypeKeys(text string, initialWait time.Duration) <-chan error {
 	errs := make(chan error, 1)
 
@@ -59,3 +67,38 @@ func asyncTypeKeys(text string, initialWait time.Duration) <-chan error {
 
 	return errs
 }
+
+func asyncClickButton(index int, initialWait time.Duration) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		time.Sleep(initialWait)
+		err := loop.Do(func() error {
+			if activeDialogForTesting == 0 {
+				panic("dialog is closed")
+			}
+
+			// Buttons are listed in the same order as they are shown.
+			buffer := [16]uint16{}
+			for hwnd := win.GetWindow(activeDialogForTesting, win.GW_CHILD); hwnd != 0; hwnd = win.GetWindow(hwnd, win.GW_HWNDNEXT) {
+				n, _ := win.GetClassName(hwnd, &buffer[0], len(buffer))
+				if syscall.UTF16ToString(buffer[:n]) != "Button" {
+					continue
+				}
+				if index == 0 {
+					win.PostMessage(hwnd, win.BM_CLICK, 0, 0)
+					return nil
+				}
+				index--
+			}
+			return fmt.Errorf("button not found")
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return errs
+}
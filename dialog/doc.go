@@ -1,3 +1,4 @@
-// Package dialog provides common dialog boxes, such as message boxes, and open
-// and save file dialogs.
+// Package dialog provides common dialog boxes, such as message boxes,
+// questions, prompts, progress dialogs, open and save file dialogs, and color
+// and font choosers.
 package dialog
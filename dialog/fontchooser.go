@@ -0,0 +1,67 @@
+package dialog
+
+import (
+	"errors"
+	"strings"
+)
+
+// Font describes a font selected using a FontChooser.
+type Font struct {
+	Family string  // Name of the font family
+	Size   float64 // Size of the font, in points
+	Bold   bool    // Whether or not the font is bold
+	Italic bool    // Whether or not the font is italic
+}
+
+// FontChooser is a builder to construct a dialog that lets the user select
+// a font.
+type FontChooser struct {
+	Dialog
+	title   string
+	initial Font
+}
+
+// NewFontChooser initializes a new font chooser object.
+func NewFontChooser() *FontChooser {
+	return &FontChooser{title: "goey"}
+}
+
+// Show completes building of the font chooser, and shows the dialog to the
+// user.  If the user accepts the dialog, the font selected is returned, and
+// the flag will be true.  If the user cancels the dialog, the flag will be
+// false.
+func (m *FontChooser) Show() (Font, bool, error) {
+	if m.err != nil {
+		return Font{}, false, m.err
+	}
+
+	return m.show()
+}
+
+// WithInitial sets the font initially selected in the dialog.  If the
+// family is empty, the platform's default font is used.
+func (m *FontChooser) WithInitial(font Font) *FontChooser {
+	if font.Size < 0 {
+		m.err = errors.New("Invalid argument, 'font.Size' cannot be negative in call to WithInitial")
+	} else {
+		m.initial = font
+	}
+	return m
+}
+
+// WithOwner sets the owner of the dialog box.
+func (m *FontChooser) WithOwner(owner Owner) *FontChooser {
+	m.owner = owner
+	return m
+}
+
+// WithTitle sets the title of the dialog box.
+func (m *FontChooser) WithTitle(text string) *FontChooser {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		m.err = errors.New("Invalid argument, 'text' cannot be empty in call to WithTitle")
+	} else {
+		m.title = text
+	}
+	return m
+}
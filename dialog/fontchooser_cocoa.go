@@ -0,0 +1,19 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+func (m *FontChooser) show() (Font, bool, error) {
+	family, size, bold, italic := cocoa.FontPanel(m.title, m.initial.Family, m.initial.Size, m.initial.Bold, m.initial.Italic)
+	return Font{
+		Family: family,
+		Size:   size,
+		Bold:   bold,
+		Italic: italic,
+	}, true, nil
+}
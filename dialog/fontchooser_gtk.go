@@ -0,0 +1,31 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+func (m *FontChooser) show() (Font, bool, error) {
+	dlg := gtk.MountFontChooserDialog(m.owner.Handle, m.title, m.initial.Family, m.initial.Size, m.initial.Bold, m.initial.Italic)
+	activeDialogForTesting = dlg
+	defer func() {
+		activeDialogForTesting = 0
+		gtk.WidgetClose(dlg)
+	}()
+
+	rc := int(int32(gtk.DialogRun(dlg)))
+	if rc != gtkResponseOK {
+		return Font{}, false, nil
+	}
+
+	family, size, bold, italic := gtk.FontChooserDialogFont(dlg)
+	return Font{
+		Family: family,
+		Size:   size,
+		Bold:   bold,
+		Italic: italic,
+	}, true, nil
+}
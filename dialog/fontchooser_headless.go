@@ -0,0 +1,31 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+func (m *FontChooser) show() (Font, bool, error) {
+	accepted := false
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		if r == '\n' || r == '\x1b' {
+			accepted, dlg.done = r == '\n', true
+		}
+	}
+	dlg.onButton = func(index int) {
+		accepted, dlg.done = index == 0, true
+	}
+
+	dlg.run()
+	if !accepted {
+		return Font{}, false, nil
+	}
+
+	font := m.initial
+	if font.Family == "" {
+		font.Family = "Sans"
+	}
+	if font.Size <= 0 {
+		font.Size = 10
+	}
+	return font, true, nil
+}
@@ -0,0 +1,66 @@
+package dialog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+func ExampleNewFontChooser() {
+	// The following creates a modal dialog asking the user to select a font.
+	font, ok, err := NewFontChooser().WithTitle("Example").Show()
+	if err != nil {
+		fmt.Println("Error: ", err)
+	} else if ok {
+		fmt.Printf("Selected font %s at %.1f points\n", font.Family, font.Size)
+	}
+}
+
+func TestNewFontChooser(t *testing.T) {
+	cases := []struct {
+		build    func() (Font, bool, error)
+		async    func()
+		ok       bool
+		accepted bool
+	}{
+		{func() (Font, bool, error) {
+			return NewFontChooser().WithTitle(t.Name()).Show()
+		}, asyncKeyEnter, true, true},
+		{func() (Font, bool, error) {
+			return NewFontChooser().WithTitle(t.Name()).Show()
+		}, asyncKeyEscape, true, false},
+		{func() (Font, bool, error) { return Font{}, false, NewFontChooser().WithTitle("").Err() }, nil, false, false},
+		{func() (Font, bool, error) { return Font{}, false, NewFontChooser().WithInitial(Font{Size: -1}).Err() }, nil, false, false},
+		{func() (Font, bool, error) { return NewFontChooser().WithInitial(Font{Size: -1}).Show() }, nil, false, false},
+	}
+
+	init := func() error {
+		for i, v := range cases {
+			if v.async != nil {
+				v.async()
+			}
+
+			font, accepted, err := v.build()
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d,  want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+			if accepted != v.accepted {
+				t.Errorf("Case %d,  want %v, got %v", i, v.accepted, accepted)
+			}
+			if accepted && (font.Family == "" || font.Size <= 0) {
+				t.Errorf("Case %d,  font is not complete, %v", i, font)
+			}
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
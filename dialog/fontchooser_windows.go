@@ -0,0 +1,97 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/win"
+)
+
+const (
+	cfScreenFonts         = 0x00000001
+	cfEnableHook          = 0x00000008
+	cfInitToLogFontStruct = 0x00000040
+	cfNoVertFonts         = 0x01000000
+)
+
+var (
+	comdlg32       = syscall.NewLazyDLL("comdlg32.dll")
+	procChooseFont = comdlg32.NewProc("ChooseFontW")
+)
+
+// chooseFont mirrors the CHOOSEFONTW structure, which is missing from the
+// package win.
+type chooseFont struct {
+	lStructSize    uint32
+	hwndOwner      win.HWND
+	hDC            win.HDC
+	lpLogFont      *win.LOGFONT
+	iPointSize     int32
+	flags          uint32
+	rgbColors      win.COLORREF
+	lCustData      uintptr
+	lpfnHook       uintptr
+	lpTemplateName *uint16
+	hInstance      win.HINSTANCE
+	lpszStyle      *uint16
+	nFontType      uint16
+	_              uint16
+	nSizeMin       int32
+	nSizeMax       int32
+}
+
+func (m *FontChooser) show() (Font, bool, error) {
+	chooserTitle = m.title
+	defer func() {
+		activeDialogForTesting = 0
+	}()
+
+	lf := win.LOGFONT{}
+	flags := uint32(cfScreenFonts | cfNoVertFonts | cfEnableHook)
+	if m.initial.Family != "" {
+		family, err := syscall.UTF16FromString(m.initial.Family)
+		if err != nil {
+			return Font{}, false, err
+		}
+		copy(lf.LfFaceName[:len(lf.LfFaceName)-1], family)
+		if m.initial.Size > 0 {
+			hdc := win.GetDC(0)
+			lf.LfHeight = -win.MulDiv(int32(m.initial.Size*10+0.5), win.GetDeviceCaps(hdc, win.LOGPIXELSY), 720)
+			win.ReleaseDC(0, hdc)
+		}
+		lf.LfWeight = win.FW_NORMAL
+		if m.initial.Bold {
+			lf.LfWeight = win.FW_BOLD
+		}
+		if m.initial.Italic {
+			lf.LfItalic = 1
+		}
+		flags |= cfInitToLogFontStruct
+	}
+
+	cf := chooseFont{
+		lStructSize: uint32(unsafe.Sizeof(chooseFont{})),
+		hwndOwner:   m.owner.HWnd,
+		lpLogFont:   &lf,
+		flags:       flags,
+		lpfnHook:    chooserHookCallback,
+	}
+	rc, _, _ := procChooseFont.Call(uintptr(unsafe.Pointer(&cf)))
+	if rc == 0 {
+		if err := win.CommDlgExtendedError(); err != 0 {
+			return Font{}, false, fmt.Errorf("call to ChooseFont failed with code %x", err)
+		}
+		return Font{}, false, nil
+	}
+
+	return Font{
+		Family: syscall.UTF16ToString(lf.LfFaceName[:]),
+		Size:   float64(cf.iPointSize) / 10,
+		Bold:   lf.LfWeight >= win.FW_BOLD,
+		Italic: lf.LfItalic != 0,
+	}, true, nil
+}
@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package dialog
@@ -0,0 +1,31 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+func (m *Message) show() error {
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		if r == '\n' || r == '\x1b' {
+			dlg.done = true
+		}
+	}
+	dlg.onButton = func(int) {
+		dlg.done = true
+	}
+
+	dlg.run()
+	return nil
+}
+
+func (m *Message) withError() {
+	m.icon = 1
+}
+
+func (m *Message) withWarn() {
+	m.icon = 2
+}
+
+func (m *Message) withInfo() {
+	m.icon = 3
+}
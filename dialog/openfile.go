@@ -8,9 +8,12 @@ import (
 // OpenFile is a builder to construct an open file dialog to the user.
 type OpenFile struct {
 	Dialog
-	title    string
-	filename string
-	filters  []filter
+	title       string
+	filename    string
+	dir         string
+	filters     []filter
+	filterIndex int
+	multiple    bool
 }
 
 type filter struct {
@@ -22,18 +25,40 @@ type filter struct {
 // Use of the method OpenFileDialog on an existing Window is preferred, as the
 // message can be set as a child of the top-level window.
 func NewOpenFile() *OpenFile {
-	return &OpenFile{title: "goey"}
+	return &OpenFile{title: "goey", filterIndex: -1}
 }
 
 // Show completes building of the message, and shows the message to the user.
+// If the dialog allows multiple selection, only the first filename is
+// returned.  If the user cancels the dialog, the filename will be empty.
 func (m *OpenFile) Show() (string, error) {
+	filenames, err := m.ShowMultiple()
+	if err != nil || len(filenames) == 0 {
+		return "", err
+	}
+	return filenames[0], nil
+}
+
+// ShowMultiple completes building of the message, and shows the message to
+// the user.  All of the selected filenames are returned.  If the user cancels
+// the dialog, the list will be empty.
+func (m *OpenFile) ShowMultiple() ([]string, error) {
 	if m.err != nil {
-		return "", m.err
+		return nil, m.err
 	}
 
+	m.filterIndex = -1
 	return m.show()
 }
 
+// FilterIndex returns the index of the filter that was selected when the
+// user closed the dialog.  The index is the position of the filter in the
+// order in which they were added by AddFilter.  If there are no filters, or if
+// the dialog has not been shown, the index will be -1.
+func (m *OpenFile) FilterIndex() int {
+	return m.filterIndex
+}
+
 // AddFilter adds a filter to the list of filename patterns that the user can
 // select.
 func (m *OpenFile) AddFilter(name, pattern string) *OpenFile {
@@ -41,12 +66,26 @@ func (m *OpenFile) AddFilter(name, pattern string) *OpenFile {
 	return m
 }
 
+// WithDirectory sets the initial directory for the dialog.  If a filename
+// has also been set, the filename takes precedence.
+func (m *OpenFile) WithDirectory(dir string) *OpenFile {
+	m.dir = dir
+	return m
+}
+
 // WithFilename sets the current or default filename for the dialog.
 func (m *OpenFile) WithFilename(filename string) *OpenFile {
 	m.filename = filename
 	return m
 }
 
+// WithMultiple allows the user to select more than one file.  Use the method
+// ShowMultiple to retrieve all of the filenames.
+func (m *OpenFile) WithMultiple() *OpenFile {
+	m.multiple = true
+	return m
+}
+
 // WithOwner sets the owner of the dialog box.
 func (m *OpenFile) WithOwner(owner Owner) *OpenFile {
 	m.owner = owner
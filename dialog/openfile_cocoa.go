@@ -1,13 +1,21 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package dialog
 
 import (
+	"path/filepath"
+
 	"github.com/chaolihf/goey/internal/cocoa"
 )
 
-func (m *OpenFile) show() (string, error) {
-	retval := cocoa.OpenPanel(m.owner.Window, m.filename)
+func (m *OpenFile) show() ([]string, error) {
+	dir, base := m.dir, ""
+	if m.filename != "" {
+		dir, base = filepath.Dir(m.filename), filepath.Base(m.filename)
+	}
+
+	retval := cocoa.OpenPanelFiles(m.owner.Window, dir, base, m.multiple, false)
 	return retval, nil
 }
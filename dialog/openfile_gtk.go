@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package dialog
@@ -7,7 +8,7 @@ import (
 	"github.com/chaolihf/goey/internal/gtk"
 )
 
-func (m *OpenFile) show() (string, error) {
+func (m *OpenFile) show() ([]string, error) {
 	dlg := gtk.MountOpenDialog(m.owner.Handle, m.title, m.filename)
 	activeDialogForTesting = dlg
 	defer func() {
@@ -15,13 +16,18 @@ func (m *OpenFile) show() (string, error) {
 		gtk.WidgetClose(dlg)
 	}()
 
+	if m.filename == "" && m.dir != "" {
+		gtk.DialogSetFolder(dlg, m.dir)
+	}
+	gtk.DialogSetMultiple(dlg, m.multiple)
 	for _, v := range m.filters {
 		gtk.DialogAddFilter(dlg, v.name, v.pattern)
 	}
 
 	rc := gtk.DialogRun(dlg)
 	if rc != gtk.DialogResponseAccept() {
-		return "", nil
+		return nil, nil
 	}
-	return gtk.DialogGetFilename(dlg), nil
+	m.filterIndex = gtk.DialogFilterIndex(dlg)
+	return gtk.DialogGetFilenames(dlg), nil
 }
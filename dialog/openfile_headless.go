@@ -0,0 +1,44 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+import (
+	"path/filepath"
+)
+
+func (m *OpenFile) show() ([]string, error) {
+	accepted := false
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		if r == '\n' || r == '\x1b' {
+			accepted, dlg.done = r == '\n', true
+		}
+	}
+	dlg.onButton = func(index int) {
+		accepted, dlg.done = index == 0, true
+	}
+
+	dlg.run()
+	if !accepted || m.filename == "" {
+		return nil, nil
+	}
+
+	filename, err := absFilename(m.dir, m.filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(m.filters) > 0 {
+		m.filterIndex = 0
+	}
+	return []string{filename}, nil
+}
+
+// absFilename resolves the filename relative to the dialog's directory, or
+// the working directory if none was set.
+func absFilename(dir, filename string) (string, error) {
+	if dir != "" && !filepath.IsAbs(filename) {
+		filename = filepath.Join(dir, filename)
+	}
+	return filepath.Abs(filename)
+}
@@ -3,6 +3,7 @@ package dialog
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/chaolihf/goey/loop"
@@ -62,3 +63,51 @@ func TestNewOpenFile(t *testing.T) {
 		t.Fatalf("Failed to run event loop, %s", err)
 	}
 }
+
+func TestOpenFileShowMultiple(t *testing.T) {
+	wd := getwd(t)
+
+	cases := []struct {
+		build     func() *OpenFile
+		asyncKey  rune
+		filenames []string
+		filter    int
+	}{
+		{func() *OpenFile { return NewOpenFile().WithMultiple() }, '\x1b', nil, -1},
+		{func() *OpenFile { return NewOpenFile().WithMultiple().WithDirectory(wd) }, '\x1b', nil, -1},
+		{func() *OpenFile {
+			return NewOpenFile().WithMultiple().WithFilename("./openfile_test.go")
+		}, '\n', []string{filepath.Join(wd, "openfile_test.go")}, -1},
+		{func() *OpenFile {
+			return NewOpenFile().WithMultiple().WithFilename("./openfile_test.go").AddFilter("Go Source Files", "*.go").AddFilter("All Files", "*")
+		}, '\n', []string{filepath.Join(wd, "openfile_test.go")}, 0},
+	}
+	init := func() error {
+		for i, v := range cases {
+			if v.asyncKey == '\n' {
+				asyncKeyEnter()
+			} else if v.asyncKey == '\x1b' {
+				asyncKeyEscape()
+			}
+
+			dlg := v.build()
+			filenames, err := dlg.ShowMultiple()
+			if err != nil {
+				t.Errorf("Case %d, unexpected error, %s", i, err)
+			}
+			if !reflect.DeepEqual(filenames, v.filenames) {
+				t.Errorf("Case %d, want %v, got %v", i, v.filenames, filenames)
+			}
+			if got := dlg.FilterIndex(); got != v.filter {
+				t.Errorf("Case %d, want filter %d, got %d", i, v.filter, got)
+			}
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
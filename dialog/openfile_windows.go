@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package dialog
 
 import (
@@ -9,10 +12,10 @@ import (
 	"github.com/chaolihf/win"
 )
 
-func (m *OpenFile) show() (string, error) {
+func (m *OpenFile) show() ([]string, error) {
 	title, err := syscall.UTF16PtrFromString(m.title)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	ofn := win.OPENFILENAME{
@@ -22,21 +25,47 @@ func (m *OpenFile) show() (string, error) {
 		LpstrTitle:  title,
 		Flags:       win.OFN_PATHMUSTEXIST | win.OFN_FILEMUSTEXIST,
 	}
+	if m.multiple {
+		ofn.Flags |= win.OFN_ALLOWMULTISELECT | win.OFN_EXPLORER
+	}
+	if err := buildInitialDir(&ofn, m.dir); err != nil {
+		return nil, err
+	}
 
-	filename := [1024]uint16{}
-	buffer, err := buildFileString(&ofn, filename[:], m.filename)
+	// When selecting multiple files, the buffer needs to be much larger.
+	var buffer []uint16
+	if m.multiple {
+		buffer = make([]uint16, 32*1024)
+	} else {
+		buffer = make([]uint16, 1024)
+	}
+	buffer, err = buildFileString(&ofn, buffer, m.filename)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	rc := win.GetOpenFileName(&ofn)
 	if !rc {
 		if err := win.CommDlgExtendedError(); err != 0 {
-			return "", fmt.Errorf("call to GetOpenFileName failed with code %x", err)
+			return nil, fmt.Errorf("call to GetOpenFileName failed with code %x", err)
+		}
+		return nil, nil
+	}
+	m.filterIndex = filterIndex(&ofn, m.filters)
+
+	// If more than one file was selected, the buffer contains the directory
+	// followed by the filenames, all separated by null characters.
+	if m.multiple && ofn.NFileOffset > 0 && buffer[ofn.NFileOffset-1] == 0 {
+		dir := syscall.UTF16ToString(buffer[:ofn.NFileOffset])
+		retval := []string(nil)
+		for i := int(ofn.NFileOffset); buffer[i] != 0; {
+			filename := syscall.UTF16ToString(buffer[i:])
+			retval = append(retval, filepath.Join(dir, filename))
+			i += len(syscall.StringToUTF16(filename))
 		}
-		return "", nil
+		return retval, nil
 	}
-	return syscall.UTF16ToString(buffer), nil
+	return []string{syscall.UTF16ToString(buffer)}, nil
 }
 
 func buildFilterString(filters []filter) *uint16 {
@@ -82,3 +111,24 @@ func buildFileString(ofn *win.OPENFILENAME, buffer []uint16, filename string) ([
 	ofn.NMaxFile = uint32(cap(buffer))
 	return buffer[:cap(buffer)], nil
 }
+
+func buildInitialDir(ofn *win.OPENFILENAME, dir string) error {
+	if dir == "" {
+		return nil
+	}
+
+	tmp, err := syscall.UTF16PtrFromString(filepath.FromSlash(dir))
+	if err != nil {
+		return err
+	}
+	ofn.LpstrInitialDir = tmp
+	return nil
+}
+
+func filterIndex(ofn *win.OPENFILENAME, filters []filter) int {
+	// The index reported by windows is one-based.
+	if len(filters) == 0 || ofn.NFilterIndex == 0 {
+		return -1
+	}
+	return int(ofn.NFilterIndex) - 1
+}
@@ -0,0 +1,52 @@
+package dialog
+
+import (
+	"errors"
+	"strings"
+)
+
+// OpenFolder is a builder to construct a dialog to the user to select a
+// directory.
+type OpenFolder struct {
+	Dialog
+	title string
+	dir   string
+}
+
+// NewOpenFolder initializes a new open folder dialog.
+func NewOpenFolder() *OpenFolder {
+	return &OpenFolder{title: "goey"}
+}
+
+// Show completes building of the dialog, and shows the dialog to the user.
+// If the user cancels the dialog, the returned path will be empty.
+func (m *OpenFolder) Show() (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+
+	return m.show()
+}
+
+// WithDirectory sets the initial directory for the dialog.
+func (m *OpenFolder) WithDirectory(dir string) *OpenFolder {
+	m.dir = dir
+	return m
+}
+
+// WithOwner sets the owner of the dialog box.
+func (m *OpenFolder) WithOwner(owner Owner) *OpenFolder {
+	m.owner = owner
+	return m
+}
+
+// WithTitle adds a title to the dialog.
+func (m *OpenFolder) WithTitle(text string) *OpenFolder {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		m.err = errors.New("Invalid argument, 'text' cannot be empty in call to WithTitle")
+	} else {
+		m.title = text
+	}
+	return m
+}
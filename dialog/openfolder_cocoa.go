@@ -0,0 +1,17 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+func (m *OpenFolder) show() (string, error) {
+	retval := cocoa.OpenPanelFiles(m.owner.Window, m.dir, "", false, true)
+	if len(retval) == 0 {
+		return "", nil
+	}
+	return retval[0], nil
+}
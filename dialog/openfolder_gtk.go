@@ -0,0 +1,28 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+func (m *OpenFolder) show() (string, error) {
+	dlg := gtk.MountOpenFolderDialog(m.owner.Handle, m.title)
+	activeDialogForTesting = dlg
+	defer func() {
+		activeDialogForTesting = 0
+		gtk.WidgetClose(dlg)
+	}()
+
+	if m.dir != "" {
+		gtk.DialogSetFolder(dlg, m.dir)
+	}
+
+	rc := gtk.DialogRun(dlg)
+	if rc != gtk.DialogResponseAccept() {
+		return "", nil
+	}
+	return gtk.DialogGetFilename(dlg), nil
+}
@@ -0,0 +1,27 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+import (
+	"path/filepath"
+)
+
+func (m *OpenFolder) show() (string, error) {
+	accepted := false
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		if r == '\n' || r == '\x1b' {
+			accepted, dlg.done = r == '\n', true
+		}
+	}
+	dlg.onButton = func(index int) {
+		accepted, dlg.done = index == 0, true
+	}
+
+	dlg.run()
+	if !accepted || m.dir == "" {
+		return "", nil
+	}
+	return filepath.Abs(m.dir)
+}
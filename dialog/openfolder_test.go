@@ -0,0 +1,51 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+func TestNewOpenFolder(t *testing.T) {
+	wd := getwd(t)
+
+	cases := []struct {
+		build    func() (string, error)
+		asyncKey rune
+		dir      string
+		ok       bool
+	}{
+		{func() (string, error) { return NewOpenFolder().WithTitle(t.Name()).Show() }, '\x1b', "", true},
+		{func() (string, error) { return "", NewOpenFolder().WithTitle("").Err() }, 0, "", false},
+		{func() (string, error) { return NewOpenFolder().WithTitle("").Show() }, 0, "", false},
+		{func() (string, error) { return NewOpenFolder().WithDirectory(wd).Show() }, '\x1b', "", true},
+		{func() (string, error) { return NewOpenFolder().WithDirectory(wd).Show() }, '\n', wd, true},
+	}
+	init := func() error {
+		for i, v := range cases {
+			if v.asyncKey == '\n' {
+				asyncKeyEnter()
+			} else if v.asyncKey == '\x1b' {
+				asyncKeyEscape()
+			}
+
+			dir, err := v.build()
+			if dir != v.dir {
+				t.Errorf("Case %d, want %s, got %s", i, v.dir, dir)
+			}
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d,  want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
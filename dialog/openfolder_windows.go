@@ -0,0 +1,77 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/win"
+)
+
+const (
+	bifReturnOnlyFSDirs = 0x0001
+	bifNewDialogStyle   = 0x0040
+	bffmInitialized     = 1
+	bffmSetSelection    = win.WM_USER + 103
+)
+
+var (
+	// Callbacks are a limited resource, so only one is created.  The
+	// initial directory is passed using a package variable, which is safe as
+	// all dialogs are run on the GUI thread.
+	openFolderCallback = syscall.NewCallback(openFolderProc)
+	openFolderDir      *uint16
+)
+
+func (m *OpenFolder) show() (string, error) {
+	title, err := syscall.UTF16PtrFromString(m.title)
+	if err != nil {
+		return "", err
+	}
+	if m.dir != "" {
+		openFolderDir, err = syscall.UTF16PtrFromString(filepath.FromSlash(m.dir))
+		if err != nil {
+			return "", err
+		}
+		defer func() {
+			openFolderDir = nil
+		}()
+	}
+
+	// The new dialog style requires that COM is initialized.
+	if hr := win.CoInitializeEx(nil, win.COINIT_APARTMENTTHREADED); hr == win.S_OK || hr == win.S_FALSE {
+		defer win.CoUninitialize()
+	}
+
+	bi := win.BROWSEINFO{
+		HwndOwner: m.owner.HWnd,
+		LpszTitle: title,
+		UlFlags:   bifReturnOnlyFSDirs | bifNewDialogStyle,
+		Lpfn:      openFolderCallback,
+	}
+	pidl := win.SHBrowseForFolder(&bi)
+	activeDialogForTesting = 0
+	if pidl == 0 {
+		return "", nil
+	}
+	defer win.CoTaskMemFree(pidl)
+
+	buffer := [win.MAX_PATH]uint16{}
+	if !win.SHGetPathFromIDList(pidl, &buffer[0]) {
+		return "", syscall.GetLastError()
+	}
+	return syscall.UTF16ToString(buffer[:]), nil
+}
+
+func openFolderProc(hwnd win.HWND, msg uint32, lParam uintptr, lpData uintptr) uintptr {
+	if msg == bffmInitialized {
+		activeDialogForTesting = hwnd
+		if openFolderDir != nil {
+			win.SendMessage(hwnd, bffmSetSelection, 1, uintptr(unsafe.Pointer(openFolderDir)))
+		}
+	}
+	return 0
+}
@@ -0,0 +1,159 @@
+package dialog
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+// Progress is a builder to construct a dialog that shows the progress of a
+// long-running operation.  The dialog includes a progress bar, a line of
+// status text, and a button so that the user can cancel the operation.
+type Progress struct {
+	Dialog
+	title string
+	text  string
+	min   int
+	max   int
+}
+
+// ProgressHandle is used to update a progress dialog once it has been shown.
+// Unlike most GUI objects, the methods of the handle are safe to call from
+// any goroutine, and are intended to be used by a worker goroutine.  Updates
+// are marshalled to the GUI thread using loop.Do, or applied immediately when
+// called from the GUI thread.
+type ProgressHandle struct {
+	progressImpl
+	ctx    context.Context
+	cancel context.CancelFunc
+	min    int
+	max    int
+	done   bool
+}
+
+// NewProgress initializes a new progress object with the specified title.
+// Use of the method Progress on an existing Window is preferred, as the
+// dialog can be set as a child of the top-level window.
+func NewProgress(title string) *Progress {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		retval := &Progress{}
+		retval.err = errors.New("Invalid argument, 'title' cannot be empty in call to NewProgress")
+		return retval
+	}
+	return &Progress{title: title, max: 100}
+}
+
+// Show completes building of the progress dialog, and shows the dialog to the
+// user.  This method does not block.  The dialog remains open until the
+// method Done is called on the returned handle.  While the dialog is open,
+// the owner is disabled, and the event loop will not terminate.
+//
+// Like other modifications to the GUI, this method must be called on the GUI
+// thread.
+func (m *Progress) Show() (*ProgressHandle, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	retval := &ProgressHandle{
+		ctx:    ctx,
+		cancel: cancel,
+		min:    m.min,
+		max:    m.max,
+	}
+	err := m.show(retval)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	loop.AddLockCount(1)
+	return retval, nil
+}
+
+// WithOwner sets the owner of the dialog box.
+func (m *Progress) WithOwner(owner Owner) *Progress {
+	m.owner = owner
+	return m
+}
+
+// WithRange sets the range of values for the progress bar.  By default, the
+// range is from 0 to 100.
+func (m *Progress) WithRange(min, max int) *Progress {
+	if min >= max {
+		m.err = errors.New("Invalid argument, 'min' must be less than 'max' in call to WithRange")
+	} else {
+		m.min, m.max = min, max
+	}
+	return m
+}
+
+// WithText sets the initial status text.
+func (m *Progress) WithText(text string) *Progress {
+	m.text = text
+	return m
+}
+
+// Cancelled returns a channel that is closed when the user cancels the
+// operation.
+func (h *ProgressHandle) Cancelled() <-chan struct{} {
+	return h.ctx.Done()
+}
+
+// Context returns a context that is cancelled when the user cancels the
+// operation.
+func (h *ProgressHandle) Context() context.Context {
+	return h.ctx
+}
+
+// Done closes the dialog, and re-enables the owner.  It is safe to call this
+// method more than once.
+func (h *ProgressHandle) Done() error {
+	return loop.Do(func() error {
+		if !h.done {
+			h.done = true
+			h.close()
+			loop.AddLockCount(-1)
+		}
+		return nil
+	})
+}
+
+// SetText changes the status text.  After the dialog has been closed, this
+// method does nothing.
+func (h *ProgressHandle) SetText(text string) error {
+	return loop.Do(func() error {
+		if !h.done {
+			h.setText(text)
+		}
+		return nil
+	})
+}
+
+// SetValue changes the position of the progress bar.  The value will be
+// clamped to the range of the progress bar.  After the dialog has been
+// closed, this method does nothing.
+func (h *ProgressHandle) SetValue(value int) error {
+	if value < h.min {
+		value = h.min
+	} else if value > h.max {
+		value = h.max
+	}
+
+	return loop.Do(func() error {
+		if !h.done {
+			h.setValue(value)
+		}
+		return nil
+	})
+}
+
+// onCancel should be called by the platform-specific code, on the GUI
+// thread, when the user cancels the operation.
+func (h *ProgressHandle) onCancel() {
+	h.cancel()
+}
@@ -0,0 +1,31 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+type progressImpl struct {
+	handle *cocoa.ProgressPanel
+}
+
+func (m *Progress) show(h *ProgressHandle) error {
+	h.handle = cocoa.NewProgressPanel(m.title, m.text, m.min, m.max, h.onCancel)
+	return nil
+}
+
+func (h *progressImpl) close() {
+	h.handle.Close()
+	h.handle = nil
+}
+
+func (h *progressImpl) setText(text string) {
+	h.handle.SetText(text)
+}
+
+func (h *progressImpl) setValue(value int) {
+	h.handle.SetValue(value)
+}
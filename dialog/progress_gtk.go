@@ -0,0 +1,38 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type progressImpl struct {
+	handle uintptr
+	lower  int
+	upper  int
+}
+
+func (m *Progress) show(h *ProgressHandle) error {
+	h.handle = gtk.MountProgressDialog(m.owner.Handle, m.title, m.text, h.onCancel)
+	h.lower, h.upper = m.min, m.max
+	activeDialogForTesting = h.handle
+	return nil
+}
+
+func (h *progressImpl) close() {
+	if activeDialogForTesting == h.handle {
+		activeDialogForTesting = 0
+	}
+	gtk.ProgressDialogClose(h.handle)
+	h.handle = 0
+}
+
+func (h *progressImpl) setText(text string) {
+	gtk.ProgressDialogSetText(h.handle, text)
+}
+
+func (h *progressImpl) setValue(value int) {
+	gtk.ProgressDialogSetFraction(h.handle, float64(value-h.lower)/float64(h.upper-h.lower))
+}
@@ -0,0 +1,39 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+type progressImpl struct {
+	dialog *modalDialog
+	text   string
+	value  int
+}
+
+func (m *Progress) show(h *ProgressHandle) error {
+	// The progress dialog is not modal, so there is no call to run.
+	h.dialog = &modalDialog{
+		onButton: func(index int) {
+			if index == 0 {
+				h.onCancel()
+			}
+		},
+	}
+	h.text, h.value = m.text, m.min
+	activeDialogForTesting = h.dialog
+	return nil
+}
+
+func (h *progressImpl) close() {
+	if activeDialogForTesting == h.dialog {
+		activeDialogForTesting = nil
+	}
+	h.dialog.done = true
+}
+
+func (h *progressImpl) setText(text string) {
+	h.text = text
+}
+
+func (h *progressImpl) setValue(value int) {
+	h.value = value
+}
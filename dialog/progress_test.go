@@ -0,0 +1,109 @@
+package dialog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+func ExampleNewProgress() {
+	// The following shows a progress dialog, and updates the dialog from a
+	// worker goroutine.
+	h, err := NewProgress("Example").WithText("Working...").Show()
+	if err != nil {
+		fmt.Println("Error: ", err)
+		return
+	}
+
+	go func() {
+		defer h.Done()
+
+		for i := 0; i <= 100; i += 10 {
+			select {
+			case <-h.Cancelled():
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			h.SetValue(i)
+		}
+	}()
+}
+
+func TestNewProgress(t *testing.T) {
+	const text = "Some text for the body of the dialog box."
+
+	cases := []struct {
+		build  func() (*ProgressHandle, error)
+		async  func()
+		ok     bool
+		cancel bool
+	}{
+		{func() (*ProgressHandle, error) {
+			return NewProgress(t.Name()).WithText(text).Show()
+		}, nil, true, false},
+		{func() (*ProgressHandle, error) {
+			return NewProgress(t.Name()).WithText(text).WithRange(-10, 10).Show()
+		}, nil, true, false},
+		{func() (*ProgressHandle, error) {
+			return NewProgress(t.Name()).WithText(text).Show()
+		}, func() { asyncChooseButton(0) }, true, true},
+		{func() (*ProgressHandle, error) { return nil, NewProgress("").Err() }, nil, false, false},
+		{func() (*ProgressHandle, error) { return NewProgress("").Show() }, nil, false, false},
+		{func() (*ProgressHandle, error) { return nil, NewProgress(t.Name()).WithRange(5, 5).Err() }, nil, false, false},
+	}
+
+	init := func() error {
+		for i, v := range cases {
+			h, err := v.build()
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d,  want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+			if h == nil {
+				continue
+			}
+
+			if v.async != nil {
+				v.async()
+			}
+			go func(i int, cancel bool) {
+				defer h.Done()
+
+				for j := -20; j <= 120; j += 20 {
+					if err := h.SetValue(j); err != nil {
+						t.Errorf("Case %d, failed to set value, %s", i, err)
+					}
+				}
+				if err := h.SetText("Almost done..."); err != nil {
+					t.Errorf("Case %d, failed to set text, %s", i, err)
+				}
+
+				if cancel {
+					select {
+					case <-h.Cancelled():
+					case <-time.After(10 * asyncWait):
+						t.Errorf("Case %d, dialog was not cancelled", i)
+						return
+					}
+					if err := h.Context().Err(); err != context.Canceled {
+						t.Errorf("Case %d, want %v, got %v", i, context.Canceled, err)
+					}
+				} else if err := h.Context().Err(); err != nil {
+					t.Errorf("Case %d, want <nil>, got %v", i, err)
+				}
+			}(i, v.cancel)
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
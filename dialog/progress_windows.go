@@ -0,0 +1,123 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"syscall"
+	"unsafe"
+
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/win"
+)
+
+const (
+	progressTextID = 100
+	progressBarID  = 101
+)
+
+var (
+	procCreateDialogIndirectParam = user32.NewProc("CreateDialogIndirectParamW")
+
+	// Callbacks are a limited resource, so only one is created.  The
+	// progress dialogs are identified by their window handle.
+	progressCallback = syscall.NewCallback(progressDialogProc)
+	activeProgress   = make(map[win.HWND]*ProgressHandle)
+)
+
+type progressImpl struct {
+	hwnd  win.HWND
+	owner win.HWND
+}
+
+func (m *Progress) template() dialogTemplate {
+	const (
+		width  = 230
+		margin = 7
+	)
+
+	const (
+		textY   = margin
+		barY    = textY + 8 + 4
+		buttonY = barY + 10 + 7
+	)
+
+	t := dialogTemplate{}
+	t.appendHeader(win.DS_MODALFRAME|win.DS_CENTER, 3, width, buttonY+14+margin, m.title)
+	t.appendItem(win.SS_LEFT|win.SS_ENDELLIPSIS, margin, textY, width-2*margin, 8, progressTextID, staticClass, m.text)
+	t.appendItemClass(0, margin, barY, width-2*margin, 10, progressBarID, "msctls_progress32", "")
+	t.appendItem(win.BS_PUSHBUTTON|win.WS_TABSTOP, width-margin-50, buttonY, 50, 14, win.IDCANCEL, buttonClass, "Cancel")
+	return t
+}
+
+func (m *Progress) show(h *ProgressHandle) error {
+	template := m.template()
+
+	// The dialog is modeless, so that the GUI thread is not blocked, but the
+	// owner is disabled until the dialog is closed.
+	rc, _, err := procCreateDialogIndirectParam.Call(0, uintptr(unsafe.Pointer(&template[0])),
+		uintptr(m.owner.HWnd), progressCallback, 0)
+	if rc == 0 {
+		return err
+	}
+	hwnd := win.HWND(rc)
+
+	win.SendDlgItemMessage(hwnd, progressBarID, win.PBM_SETRANGE32, uintptr(m.min), uintptr(m.max))
+	win.SendDlgItemMessage(hwnd, progressBarID, win.PBM_SETPOS, uintptr(m.min), 0)
+
+	h.hwnd = hwnd
+	h.owner = m.owner.HWnd
+	activeProgress[hwnd] = h
+	if h.owner != 0 {
+		win.EnableWindow(h.owner, false)
+	}
+	win.ShowWindow(hwnd, win.SW_SHOW)
+	activeDialogForTesting = hwnd
+	return nil
+}
+
+func (h *progressImpl) close() {
+	// The owner must be enabled before the dialog is destroyed, or another
+	// application may be activated.
+	if h.owner != 0 {
+		win.EnableWindow(h.owner, true)
+	}
+	win.DestroyWindow(h.hwnd)
+	delete(activeProgress, h.hwnd)
+	if activeDialogForTesting == h.hwnd {
+		activeDialogForTesting = 0
+	}
+	h.hwnd = 0
+}
+
+func (h *progressImpl) setText(text string) {
+	win2.SetWindowText(win.GetDlgItem(h.hwnd, progressTextID), text)
+}
+
+func (h *progressImpl) setValue(value int) {
+	win.SendDlgItemMessage(h.hwnd, progressBarID, win.PBM_SETPOS, uintptr(value), 0)
+}
+
+func progressDialogProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_ACTIVATE:
+		// Keyboard navigation for modeless dialogs requires that the event
+		// loop knows which window is active.
+		if win.LOWORD(uint32(wParam)) != win.WA_INACTIVE {
+			loop.SetActiveWindow(hwnd)
+		}
+		return win.FALSE
+
+	case win.WM_COMMAND:
+		if win.LOWORD(uint32(wParam)) == win.IDCANCEL {
+			if h, ok := activeProgress[hwnd]; ok {
+				win.EnableWindow(win.GetDlgItem(hwnd, win.IDCANCEL), false)
+				h.onCancel()
+			}
+			return win.TRUE
+		}
+	}
+
+	return win.FALSE
+}
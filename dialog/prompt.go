@@ -0,0 +1,86 @@
+package dialog
+
+import (
+	"errors"
+	"strings"
+)
+
+// Prompt is a builder to construct a dialog that asks the user to enter a
+// single line of text.
+type Prompt struct {
+	Dialog
+	text      string
+	title     string
+	value     string
+	password  bool
+	validator func(string) error
+}
+
+// NewPrompt initializes a new prompt object with the specified text.
+// Use of the method Prompt on an existing Window is preferred, as the
+// prompt can be set as a child of the top-level window.
+func NewPrompt(text string) *Prompt {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		retval := &Prompt{}
+		retval.err = errors.New("Invalid argument, 'text' cannot be empty in call to NewPrompt")
+		return retval
+	}
+	return &Prompt{text: text, title: "goey"}
+}
+
+// Show completes building of the prompt, and shows the prompt to the user.
+// If the user accepts the dialog, the value entered is returned, and the flag
+// will be true.  If the user cancels the dialog, the flag will be false.
+func (m *Prompt) Show() (string, bool, error) {
+	if m.err != nil {
+		return "", false, m.err
+	}
+
+	return m.show()
+}
+
+// validate checks the value entered by the user.
+func (m *Prompt) validate(value string) error {
+	if m.validator == nil {
+		return nil
+	}
+	return m.validator(value)
+}
+
+// WithDefault sets the initial value for the prompt.
+func (m *Prompt) WithDefault(value string) *Prompt {
+	m.value = value
+	return m
+}
+
+// WithOwner sets the owner of the dialog box.
+func (m *Prompt) WithOwner(owner Owner) *Prompt {
+	m.owner = owner
+	return m
+}
+
+// WithPassword hides the characters entered by the user.
+func (m *Prompt) WithPassword() *Prompt {
+	m.password = true
+	return m
+}
+
+// WithTitle adds a title to the prompt's dialog.
+func (m *Prompt) WithTitle(text string) *Prompt {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		m.err = errors.New("Invalid argument, 'text' cannot be empty in call to WithTitle")
+	} else {
+		m.title = text
+	}
+	return m
+}
+
+// WithValidator sets a function to check the value when the user accepts the
+// dialog.  If the function returns an error, the error's message is shown to
+// the user, and the dialog remains open.
+func (m *Prompt) WithValidator(validator func(string) error) *Prompt {
+	m.validator = validator
+	return m
+}
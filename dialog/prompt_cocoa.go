@@ -0,0 +1,28 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+func (m *Prompt) show() (string, bool, error) {
+	value, msg := m.value, ""
+	for {
+		var ok bool
+		value, ok = cocoa.PromptDialog(m.owner.Window, m.text, m.title, value, msg, m.password)
+		if !ok {
+			return "", false, nil
+		}
+
+		// If the value is rejected, show the error, and let the user
+		// try again.
+		if err := m.validate(value); err != nil {
+			msg = err.Error()
+			continue
+		}
+		return value, true, nil
+	}
+}
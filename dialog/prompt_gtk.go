@@ -0,0 +1,34 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package dialog
+
+import (
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+func (m *Prompt) show() (string, bool, error) {
+	dlg := gtk.MountPromptDialog(m.owner.Handle, m.title, m.text, m.value, m.password)
+	activeDialogForTesting = dlg
+	defer func() {
+		activeDialogForTesting = 0
+		gtk.WidgetClose(dlg)
+	}()
+
+	for {
+		rc := gtk.DialogRun(dlg)
+		if rc != gtk.DialogResponseAccept() {
+			return "", false, nil
+		}
+
+		// If the value is rejected, show the error, and let the user
+		// try again.
+		value := gtk.PromptDialogText(dlg)
+		if err := m.validate(value); err != nil {
+			gtk.PromptDialogSetError(dlg, err.Error())
+			continue
+		}
+		return value, true, nil
+	}
+}
@@ -0,0 +1,39 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+func (m *Prompt) show() (string, bool, error) {
+	value, accepted := m.value, false
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		switch {
+		case r == '\n':
+			// If the value is rejected, let the user try again.
+			if m.validate(value) == nil {
+				accepted, dlg.done = true, true
+			}
+		case r == '\x1b':
+			dlg.done = true
+		case r == '\b':
+			if len(value) > 0 {
+				runes := []rune(value)
+				value = string(runes[:len(runes)-1])
+			}
+		case r >= ' ':
+			value += string(r)
+		}
+	}
+	dlg.onButton = func(index int) {
+		if index == 0 && m.validate(value) == nil {
+			accepted = true
+		}
+		dlg.done = accepted || index != 0
+	}
+
+	dlg.run()
+	if !accepted {
+		return "", false, nil
+	}
+	return value, true, nil
+}
@@ -0,0 +1,83 @@
+package dialog
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+func ExampleNewPrompt() {
+	// The following creates a modal dialog asking the user for their name.
+	name, ok, err := NewPrompt("What is your name?").WithTitle("Example").Show()
+	if err != nil {
+		fmt.Println("Error: ", err)
+	} else if ok {
+		fmt.Println("Hello, ", name)
+	}
+}
+
+func TestNewPrompt(t *testing.T) {
+	const text = "Some text for the body of the dialog box."
+
+	notEmpty := func(value string) error {
+		if value == "" {
+			return errors.New("value cannot be empty")
+		}
+		return nil
+	}
+
+	cases := []struct {
+		build    func() (string, bool, error)
+		async    func()
+		ok       bool
+		value    string
+		accepted bool
+	}{
+		{func() (string, bool, error) {
+			return NewPrompt(text).WithTitle(t.Name()).Show()
+		}, func() { asyncTypeKeys("Hello\n", asyncWait) }, true, "Hello", true},
+		{func() (string, bool, error) {
+			return NewPrompt(text).WithTitle(t.Name()).Show()
+		}, asyncKeyEscape, true, "", false},
+		{func() (string, bool, error) {
+			return NewPrompt(text).WithTitle(t.Name()).WithDefault("Default").Show()
+		}, asyncKeyEnter, true, "Default", true},
+		{func() (string, bool, error) {
+			return NewPrompt(text).WithTitle(t.Name()).WithPassword().Show()
+		}, func() { asyncTypeKeys("secret\n", asyncWait) }, true, "secret", true},
+		{func() (string, bool, error) {
+			return NewPrompt(text).WithTitle(t.Name()).WithValidator(notEmpty).Show()
+		}, func() { asyncTypeKeys("\nabc\n", asyncWait) }, true, "abc", true},
+		{func() (string, bool, error) { return "", false, NewPrompt("").Err() }, nil, false, "", false},
+		{func() (string, bool, error) { return NewPrompt("").Show() }, nil, false, "", false},
+		{func() (string, bool, error) { return "", false, NewPrompt("Some text...").WithTitle("").Err() }, nil, false, "", false},
+	}
+
+	init := func() error {
+		for i, v := range cases {
+			if v.async != nil {
+				v.async()
+			}
+
+			value, accepted, err := v.build()
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d,  want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+			if value != v.value || accepted != v.accepted {
+				t.Errorf("Case %d,  want %q and %v, got %q and %v", i, v.value, v.accepted, value, accepted)
+			}
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
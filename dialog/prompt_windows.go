@@ -0,0 +1,111 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"syscall"
+	"unsafe"
+
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+const (
+	promptTextID  = 100
+	promptEditID  = 101
+	promptErrorID = 102
+)
+
+var (
+	procDialogBoxIndirectParam = user32.NewProc("DialogBoxIndirectParamW")
+
+	// Callbacks are a limited resource, so only one is created.  The prompt
+	// currently being shown is passed using a package variable, which is safe
+	// as all dialogs are run on the GUI thread.
+	promptCallback = syscall.NewCallback(promptDialogProc)
+	activePrompt   *promptState
+)
+
+type promptState struct {
+	prompt *Prompt
+	value  string
+}
+
+func (m *Prompt) template() dialogTemplate {
+	const (
+		width  = 230
+		margin = 7
+	)
+
+	textHeight := estimateTextHeight(m.text)
+	editY := margin + textHeight + 4
+	errorY := editY + 18
+	buttonY := errorY + 12
+
+	editStyle := uint32(win.WS_BORDER | win.WS_TABSTOP | win.ES_AUTOHSCROLL)
+	if m.password {
+		editStyle |= win.ES_PASSWORD
+	}
+
+	t := dialogTemplate{}
+	t.appendHeader(win.DS_MODALFRAME|win.DS_CENTER, 5, width, buttonY+14+margin, m.title)
+	t.appendItem(win.SS_LEFT, margin, margin, width-2*margin, textHeight, promptTextID, staticClass, m.text)
+	t.appendItem(editStyle, margin, editY, width-2*margin, 14, promptEditID, editClass, "")
+	t.appendItem(win.SS_LEFT, margin, errorY, width-2*margin, 8, promptErrorID, staticClass, "")
+	t.appendItem(win.BS_DEFPUSHBUTTON|win.WS_TABSTOP, width-margin-50-4-50, buttonY, 50, 14, win.IDOK, buttonClass, "OK")
+	t.appendItem(win.BS_PUSHBUTTON|win.WS_TABSTOP, width-margin-50, buttonY, 50, 14, win.IDCANCEL, buttonClass, "Cancel")
+	return t
+}
+
+func (m *Prompt) show() (string, bool, error) {
+	template := m.template()
+
+	state := &promptState{prompt: m, value: m.value}
+	prev := activePrompt
+	activePrompt = state
+	defer func() {
+		activePrompt = prev
+		activeDialogForTesting = 0
+	}()
+
+	rc, _, err := procDialogBoxIndirectParam.Call(0, uintptr(unsafe.Pointer(&template[0])),
+		uintptr(m.owner.HWnd), promptCallback, 0)
+	if int(rc) == -1 {
+		return "", false, err
+	}
+	if rc != win.IDOK {
+		return "", false, nil
+	}
+	return state.value, true, nil
+}
+
+func promptDialogProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_INITDIALOG:
+		activeDialogForTesting = hwnd
+		win2.SetWindowText(win.GetDlgItem(hwnd, promptEditID), activePrompt.value)
+		// Let the dialog manager set the focus.
+		return win.TRUE
+
+	case win.WM_COMMAND:
+		switch win.LOWORD(uint32(wParam)) {
+		case win.IDOK:
+			value := win2.GetWindowText(win.GetDlgItem(hwnd, promptEditID))
+			if err := activePrompt.prompt.validate(value); err != nil {
+				win2.SetWindowText(win.GetDlgItem(hwnd, promptErrorID), err.Error())
+				win.MessageBeep(win.MB_ICONWARNING)
+				return win.TRUE
+			}
+			activePrompt.value = value
+			win.EndDialog(hwnd, win.IDOK)
+			return win.TRUE
+
+		case win.IDCANCEL:
+			win.EndDialog(hwnd, win.IDCANCEL)
+			return win.TRUE
+		}
+	}
+
+	return win.FALSE
+}
@@ -0,0 +1,168 @@
+package dialog
+
+import (
+	"errors"
+	"strings"
+)
+
+// Button identifies a button in a question dialog.
+type Button int
+
+// Buttons with standard labels.  Buttons with custom labels, added using
+// WithButtons, are identified by Custom, Custom+1, and so on.
+const (
+	OK Button = iota + 1
+	Cancel
+	Yes
+	No
+	Custom
+)
+
+// Question is a builder to construct a dialog that asks the user a question,
+// and reports which button the user chose.
+type Question struct {
+	Dialog
+	text    string
+	title   string
+	buttons []Button
+	labels  []string
+	def     Button
+	cancel  Button
+}
+
+// NewQuestion initializes a new question object with the specified text.
+// By default, the dialog will contain the buttons OK and Cancel.
+func NewQuestion(text string) *Question {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		retval := &Question{}
+		retval.err = errors.New("Invalid argument, 'text' cannot be empty in call to NewQuestion")
+		return retval
+	}
+
+	retval := &Question{text: text, title: "goey"}
+	return retval.WithOKCancel()
+}
+
+// Show completes building of the question, and shows the question to the
+// user.  The return value identifies the button chosen by the user.  If the
+// user dismisses the dialog without choosing a button, for example by pressing
+// the escape key, then the cancel button is returned.  If the dialog does not
+// have a cancel button, Show returns zero.
+func (q *Question) Show() (Button, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if q.index(q.def) < 0 {
+		return 0, errors.New("Invalid argument, the default button is not part of the dialog")
+	}
+	if q.cancel != 0 && q.index(q.cancel) < 0 {
+		return 0, errors.New("Invalid argument, the cancel button is not part of the dialog")
+	}
+
+	index, err := q.show()
+	if err != nil {
+		return 0, err
+	}
+	if index < 0 {
+		return q.cancel, nil
+	}
+	return q.buttons[index], nil
+}
+
+// index returns the position of the button in the dialog, or -1 if the
+// button is not part of the dialog.
+func (q *Question) index(b Button) int {
+	for i, v := range q.buttons {
+		if v == b {
+			return i
+		}
+	}
+	return -1
+}
+
+// label returns the text for the button at the specified position.
+func (q *Question) label(index int) string {
+	if q.labels != nil {
+		return q.labels[index]
+	}
+	return standardLabel(q.buttons[index])
+}
+
+// WithOKCancel sets the dialog's buttons to OK and Cancel.
+func (q *Question) WithOKCancel() *Question {
+	q.buttons, q.labels = []Button{OK, Cancel}, nil
+	q.def, q.cancel = OK, Cancel
+	return q
+}
+
+// WithYesNo sets the dialog's buttons to Yes and No.  The button No is used
+// as the cancel button.
+func (q *Question) WithYesNo() *Question {
+	q.buttons, q.labels = []Button{Yes, No}, nil
+	q.def, q.cancel = Yes, No
+	return q
+}
+
+// WithYesNoCancel sets the dialog's buttons to Yes, No, and Cancel.
+func (q *Question) WithYesNoCancel() *Question {
+	q.buttons, q.labels = []Button{Yes, No, Cancel}, nil
+	q.def, q.cancel = Yes, Cancel
+	return q
+}
+
+// WithButtons sets the dialog's buttons to use custom labels.  The buttons
+// are identified by Custom, Custom+1, and so on.  The first button will be the
+// default, and there is no cancel button.  At most three buttons can be
+// specified.
+func (q *Question) WithButtons(labels ...string) *Question {
+	if len(labels) == 0 || len(labels) > 3 {
+		q.err = errors.New("Invalid argument, 'labels' must contain between one and three items in call to WithButtons")
+		return q
+	}
+
+	q.buttons = make([]Button, len(labels))
+	q.labels = make([]string, len(labels))
+	for i, v := range labels {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			q.err = errors.New("Invalid argument, 'labels' cannot contain empty items in call to WithButtons")
+			return q
+		}
+		q.buttons[i] = Custom + Button(i)
+		q.labels[i] = v
+	}
+	q.def, q.cancel = Custom, 0
+	return q
+}
+
+// WithDefault sets the button that will be chosen when the user presses the
+// enter key.
+func (q *Question) WithDefault(b Button) *Question {
+	q.def = b
+	return q
+}
+
+// WithCancel sets the button that will be reported when the user dismisses
+// the dialog.  Use zero to indicate that the dialog has no cancel button.
+func (q *Question) WithCancel(b Button) *Question {
+	q.cancel = b
+	return q
+}
+
+// WithOwner sets the owner of the dialog box.
+func (q *Question) WithOwner(owner Owner) *Question {
+	q.owner = owner
+	return q
+}
+
+// WithTitle adds a title to the question's dialog.
+func (q *Question) WithTitle(text string) *Question {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		q.err = errors.New("Invalid argument, 'text' cannot be empty in call to WithTitle")
+	} else {
+		q.title = text
+	}
+	return q
+}
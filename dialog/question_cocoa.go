@@ -0,0 +1,40 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package dialog
+
+import (
+	"bytes"
+
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+func standardLabel(b Button) string {
+	switch b {
+	case OK:
+		return "OK"
+	case Cancel:
+		return "Cancel"
+	case Yes:
+		return "Yes"
+	case No:
+		return "No"
+	}
+	panic("unreachable")
+}
+
+func (q *Question) show() (int, error) {
+	labels := bytes.Buffer{}
+	for i := range q.buttons {
+		labels.WriteString(q.label(i))
+		labels.WriteByte(0)
+	}
+	labels.WriteByte(0)
+
+	cancel := -1
+	if q.cancel != 0 {
+		cancel = q.index(q.cancel)
+	}
+	return cocoa.QuestionDialog(q.owner.Window, q.text, q.title, labels.String(), q.index(q.def), cancel), nil
+}
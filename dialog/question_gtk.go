@@ -0,0 +1,49 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package dialog
+
+import (
+	"bytes"
+
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+func standardLabel(b Button) string {
+	switch b {
+	case OK:
+		return "_OK"
+	case Cancel:
+		return "_Cancel"
+	case Yes:
+		return "_Yes"
+	case No:
+		return "_No"
+	}
+	panic("unreachable")
+}
+
+func (q *Question) show() (int, error) {
+	labels := bytes.Buffer{}
+	for i := range q.buttons {
+		labels.WriteString(q.label(i))
+		labels.WriteByte(0)
+	}
+	labels.WriteByte(0)
+
+	dlg := gtk.MountQuestionDialog(q.owner.Handle, q.title, q.text, labels.String(), q.index(q.def))
+	activeDialogForTesting = dlg
+	defer func() {
+		activeDialogForTesting = 0
+		gtk.WidgetClose(dlg)
+	}()
+
+	// Responses for the buttons are their indices.  Any negative response
+	// indicates that the dialog was dismissed.
+	rc := int(int32(gtk.DialogRun(dlg)))
+	if rc >= len(q.buttons) {
+		return -1, nil
+	}
+	return rc, nil
+}
@@ -0,0 +1,40 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+func standardLabel(b Button) string {
+	switch b {
+	case OK:
+		return "OK"
+	case Cancel:
+		return "Cancel"
+	case Yes:
+		return "Yes"
+	case No:
+		return "No"
+	}
+	panic("unreachable")
+}
+
+func (q *Question) show() (int, error) {
+	rc := -1
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		switch r {
+		case '\n':
+			rc, dlg.done = q.index(q.def), true
+		case '\x1b':
+			rc, dlg.done = -1, true
+		}
+	}
+	dlg.onButton = func(index int) {
+		if index >= 0 && index < len(q.buttons) {
+			rc = index
+		}
+		dlg.done = true
+	}
+
+	dlg.run()
+	return rc, nil
+}
@@ -0,0 +1,85 @@
+package dialog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+func ExampleNewQuestion() {
+	// The following creates a modal dialog asking the user a question.
+	button, err := NewQuestion("Save changes before closing?").WithTitle("Example").WithYesNoCancel().Show()
+	if err != nil {
+		fmt.Println("Error: ", err)
+	} else if button == Yes {
+		fmt.Println("Saving changes...")
+	}
+}
+
+func TestNewQuestion(t *testing.T) {
+	const text = "Some text for the body of the dialog box."
+
+	cases := []struct {
+		build  func() (Button, error)
+		async  func()
+		ok     bool
+		button Button
+	}{
+		{func() (Button, error) {
+			return NewQuestion(text).WithTitle(t.Name()).Show()
+		}, asyncKeyEnter, true, OK},
+		{func() (Button, error) {
+			return NewQuestion(text).WithTitle(t.Name()).Show()
+		}, asyncKeyEscape, true, Cancel},
+		{func() (Button, error) {
+			return NewQuestion(text).WithTitle(t.Name()).WithYesNo().Show()
+		}, func() { asyncChooseButton(1) }, true, No},
+		{func() (Button, error) {
+			return NewQuestion(text).WithTitle(t.Name()).WithYesNoCancel().WithDefault(No).Show()
+		}, asyncKeyEnter, true, No},
+		{func() (Button, error) {
+			return NewQuestion(text).WithTitle(t.Name()).WithYesNoCancel().Show()
+		}, func() { asyncChooseButton(2) }, true, Cancel},
+		{func() (Button, error) {
+			return NewQuestion(text).WithTitle(t.Name()).WithButtons("Save", "Discard", "Cancel").WithCancel(Custom + 2).Show()
+		}, func() { asyncChooseButton(1) }, true, Custom + 1},
+		{func() (Button, error) {
+			return NewQuestion(text).WithTitle(t.Name()).WithButtons("Save", "Discard", "Cancel").WithCancel(Custom + 2).Show()
+		}, asyncKeyEscape, true, Custom + 2},
+		{func() (Button, error) { return 0, NewQuestion("").Err() }, nil, false, 0},
+		{func() (Button, error) { return NewQuestion("").Show() }, nil, false, 0},
+		{func() (Button, error) { return 0, NewQuestion("Some text...").WithTitle("").Err() }, nil, false, 0},
+		{func() (Button, error) { return 0, NewQuestion("Some text...").WithButtons().Err() }, nil, false, 0},
+		{func() (Button, error) { return 0, NewQuestion("Some text...").WithButtons("A", "").Err() }, nil, false, 0},
+		{func() (Button, error) { return 0, NewQuestion("Some text...").WithButtons("A", "B", "C", "D").Err() }, nil, false, 0},
+		{func() (Button, error) { return NewQuestion("Some text...").WithDefault(Yes).Show() }, nil, false, 0},
+		{func() (Button, error) { return NewQuestion("Some text...").WithYesNo().WithCancel(Cancel).Show() }, nil, false, 0},
+	}
+
+	init := func() error {
+		for i, v := range cases {
+			if v.async != nil {
+				v.async()
+			}
+
+			button, err := v.build()
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d,  want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+			if button != v.button {
+				t.Errorf("Case %d,  want button %v, got %v", i, v.button, button)
+			}
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
@@ -0,0 +1,153 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/win"
+)
+
+const (
+	whCBT        = 5
+	hcbtActivate = 5
+)
+
+var (
+	user32                  = syscall.NewLazyDLL("user32.dll")
+	procSetWindowsHookEx    = user32.NewProc("SetWindowsHookExW")
+	procUnhookWindowsHookEx = user32.NewProc("UnhookWindowsHookEx")
+	procCallNextHookEx      = user32.NewProc("CallNextHookEx")
+
+	// The hook is used to find the message box once it has been created, so
+	// that the buttons can be relabelled.  Callbacks are a limited resource,
+	// so only one is created.
+	questionHook         uintptr
+	questionHookCallback = syscall.NewCallback(questionHookProc)
+	questionButtons      []questionButton
+)
+
+type questionButton struct {
+	id    int32
+	label *uint16
+}
+
+func standardLabel(b Button) string {
+	switch b {
+	case OK:
+		return "OK"
+	case Cancel:
+		return "Cancel"
+	case Yes:
+		return "&Yes"
+	case No:
+		return "&No"
+	}
+	panic("unreachable")
+}
+
+// style selects the style for the message box, and returns the IDs that will
+// be used for each of the buttons.  A message box only supports a few sets of
+// buttons, so the flag relabel indicates whether or not the labels need to be
+// replaced once the message box has been created.
+func (q *Question) style() (style uint32, ids []int32, relabel bool) {
+	if q.labels == nil {
+		switch {
+		case q.buttons[0] == OK:
+			return win.MB_OKCANCEL, []int32{win.IDOK, win.IDCANCEL}, false
+		case len(q.buttons) == 2:
+			return win.MB_YESNO, []int32{win.IDYES, win.IDNO}, false
+		default:
+			return win.MB_YESNOCANCEL, []int32{win.IDYES, win.IDNO, win.IDCANCEL}, false
+		}
+	}
+
+	// The user can only dismiss a message box if it has a cancel button, and
+	// that button is always last.
+	hasCancel := q.cancel != 0 && q.index(q.cancel) == len(q.buttons)-1
+	switch len(q.buttons) {
+	case 1:
+		return win.MB_OK, []int32{win.IDOK}, true
+	case 2:
+		if hasCancel {
+			return win.MB_OKCANCEL, []int32{win.IDOK, win.IDCANCEL}, true
+		}
+		return win.MB_YESNO, []int32{win.IDYES, win.IDNO}, true
+	default:
+		if hasCancel {
+			return win.MB_YESNOCANCEL, []int32{win.IDYES, win.IDNO, win.IDCANCEL}, true
+		}
+		return win.MB_ABORTRETRYIGNORE, []int32{win.IDABORT, win.IDRETRY, win.IDIGNORE}, true
+	}
+}
+
+func (q *Question) show() (int, error) {
+	text, err := syscall.UTF16PtrFromString(q.text)
+	if err != nil {
+		return -1, err
+	}
+	title, err := syscall.UTF16PtrFromString(q.title)
+	if err != nil {
+		return -1, err
+	}
+
+	style, ids, relabel := q.style()
+	if relabel {
+		questionButtons = make([]questionButton, len(ids))
+		for i, v := range ids {
+			label, err := syscall.UTF16PtrFromString(q.label(i))
+			if err != nil {
+				return -1, err
+			}
+			questionButtons[i] = questionButton{v, label}
+		}
+	}
+
+	hook, _, err := procSetWindowsHookEx.Call(whCBT, questionHookCallback, 0, uintptr(win.GetCurrentThreadId()))
+	if hook == 0 {
+		questionButtons = nil
+		return -1, err
+	}
+	questionHook = hook
+	defer func() {
+		if questionHook != 0 {
+			procUnhookWindowsHookEx.Call(questionHook)
+			questionHook = 0
+		}
+		questionButtons = nil
+		activeDialogForTesting = 0
+	}()
+
+	style |= win.MB_ICONQUESTION | uint32(q.index(q.def))*win.MB_DEFBUTTON2
+	rc := win.MessageBox(q.owner.HWnd, text, title, style)
+	if rc == 0 {
+		return -1, syscall.GetLastError()
+	}
+	for i, v := range ids {
+		if v == rc {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+func questionHookProc(code uintptr, wParam uintptr, lParam uintptr) uintptr {
+	if int32(code) != hcbtActivate || questionHook == 0 {
+		rc, _, _ := procCallNextHookEx.Call(questionHook, code, wParam, lParam)
+		return rc
+	}
+
+	// The first window activated is the message box.
+	hwnd := win.HWND(wParam)
+	activeDialogForTesting = hwnd
+	for _, v := range questionButtons {
+		win.SendMessage(win.GetDlgItem(hwnd, v.id), win.WM_SETTEXT, 0, uintptr(unsafe.Pointer(v.label)))
+	}
+
+	// The hook is no longer required.
+	procUnhookWindowsHookEx.Call(questionHook)
+	questionHook = 0
+	return 0
+}
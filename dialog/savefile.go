@@ -8,16 +8,18 @@ import (
 // SaveFile is a builder to construct an open file dialog to the user.
 type SaveFile struct {
 	Dialog
-	title    string
-	filename string
-	filters  []filter
+	title       string
+	filename    string
+	dir         string
+	filters     []filter
+	filterIndex int
 }
 
 // NewSaveFile initializes a new open file dialog.
 // Use of the method SaveFileDialog on an existing Window is preferred, as the
 // message can be set as a child of the top-level window.
 func NewSaveFile() *SaveFile {
-	return &SaveFile{title: "goey"}
+	return &SaveFile{title: "goey", filterIndex: -1}
 }
 
 // Show completes building of the message, and shows the message to the user.
@@ -26,9 +28,18 @@ func (m *SaveFile) Show() (string, error) {
 		return "", m.err
 	}
 
+	m.filterIndex = -1
 	return m.show()
 }
 
+// FilterIndex returns the index of the filter that was selected when the
+// user closed the dialog.  The index is the position of the filter in the
+// order in which they were added by AddFilter.  If there are no filters, or if
+// the dialog has not been shown, the index will be -1.
+func (m *SaveFile) FilterIndex() int {
+	return m.filterIndex
+}
+
 // AddFilter adds a filter to the list of filename patterns that the user can
 // select.
 func (m *SaveFile) AddFilter(name, pattern string) *SaveFile {
@@ -36,6 +47,13 @@ func (m *SaveFile) AddFilter(name, pattern string) *SaveFile {
 	return m
 }
 
+// WithDirectory sets the initial directory for the dialog.  If a filename
+// has also been set, the filename takes precedence.
+func (m *SaveFile) WithDirectory(dir string) *SaveFile {
+	m.dir = dir
+	return m
+}
+
 // WithFilename sets the current or default filename for the dialog.
 func (m *SaveFile) WithFilename(filename string) *SaveFile {
 	m.filename = filename
@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package dialog
@@ -15,6 +16,9 @@ func (m *SaveFile) show() (string, error) {
 		gtk.WidgetClose(dlg)
 	}()
 
+	if m.filename == "" && m.dir != "" {
+		gtk.DialogSetFolder(dlg, m.dir)
+	}
 	for _, v := range m.filters {
 		gtk.DialogAddFilter(dlg, v.name, v.pattern)
 	}
@@ -23,5 +27,6 @@ func (m *SaveFile) show() (string, error) {
 	if rc != gtk.DialogResponseAccept() {
 		return "", nil
 	}
+	m.filterIndex = gtk.DialogFilterIndex(dlg)
 	return gtk.DialogGetFilename(dlg), nil
 }
@@ -0,0 +1,31 @@
+//go:build headless
+// +build headless
+
+package dialog
+
+func (m *SaveFile) show() (string, error) {
+	accepted := false
+	dlg := &modalDialog{}
+	dlg.onKey = func(r rune) {
+		if r == '\n' || r == '\x1b' {
+			accepted, dlg.done = r == '\n', true
+		}
+	}
+	dlg.onButton = func(index int) {
+		accepted, dlg.done = index == 0, true
+	}
+
+	dlg.run()
+	if !accepted || m.filename == "" {
+		return "", nil
+	}
+
+	filename, err := absFilename(m.dir, m.filename)
+	if err != nil {
+		return "", err
+	}
+	if len(m.filters) > 0 {
+		m.filterIndex = 0
+	}
+	return filename, nil
+}
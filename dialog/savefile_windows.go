@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package dialog
 
 import (
@@ -20,6 +23,9 @@ func (m *SaveFile) show() (string, error) {
 		LpstrFilter: buildFilterString(m.filters),
 		LpstrTitle:  title,
 	}
+	if err := buildInitialDir(&ofn, m.dir); err != nil {
+		return "", err
+	}
 
 	filename := [1024]uint16{}
 	buffer, err := buildFileString(&ofn, filename[:], m.filename)
@@ -34,5 +40,6 @@ func (m *SaveFile) show() (string, error) {
 		}
 		return "", nil
 	}
+	m.filterIndex = filterIndex(&ofn, m.filters)
 	return syscall.UTF16ToString(buffer), nil
 }
@@ -0,0 +1,92 @@
+//go:build !headless
+// +build !headless
+
+package dialog
+
+import (
+	"syscall"
+	"unicode/utf8"
+
+	"github.com/chaolihf/win"
+)
+
+// Atoms for the predefined window classes, which can be used in place of the
+// class name for items in a dialog template.
+const (
+	buttonClass = 0x0080
+	editClass   = 0x0081
+	staticClass = 0x0082
+)
+
+// dialogTemplate is used to build an in-memory dialog template.  Refer to
+// the documentation for DLGTEMPLATE and DLGITEMTEMPLATE.
+type dialogTemplate []uint16
+
+func (t *dialogTemplate) appendUint32(v uint32) {
+	*t = append(*t, uint16(v), uint16(v>>16))
+}
+
+func (t *dialogTemplate) appendString(s string) {
+	tmp, _ := syscall.UTF16FromString(s)
+	*t = append(*t, tmp...)
+}
+
+// appendHeader starts the template.  The dialog uses the shell font, and the
+// dimensions are in dialog units.
+func (t *dialogTemplate) appendHeader(style uint32, count uint16, cx, cy int16, title string) {
+	t.appendUint32(style | win.WS_POPUP | win.WS_CAPTION | win.WS_SYSMENU | win.DS_SHELLFONT)
+	t.appendUint32(0)
+	*t = append(*t, count, 0, 0, uint16(cx), uint16(cy))
+	*t = append(*t, 0, 0) // No menu, and the default class
+	t.appendString(title)
+	*t = append(*t, 8)
+	t.appendString("MS Shell Dlg")
+}
+
+func (t *dialogTemplate) appendItemHeader(style uint32, x, y, cx, cy int16, id uint16) {
+	// Items must be aligned on a DWORD boundary.
+	if len(*t)%2 != 0 {
+		*t = append(*t, 0)
+	}
+	t.appendUint32(style | win.WS_CHILD | win.WS_VISIBLE)
+	t.appendUint32(0)
+	*t = append(*t, uint16(x), uint16(y), uint16(cx), uint16(cy), id)
+}
+
+// appendItem adds a control using one of the predefined window classes.
+func (t *dialogTemplate) appendItem(style uint32, x, y, cx, cy int16, id uint16, class uint16, text string) {
+	t.appendItemHeader(style, x, y, cx, cy, id)
+	*t = append(*t, 0xFFFF, class)
+	t.appendString(text)
+	*t = append(*t, 0)
+}
+
+// appendItemClass adds a control using a window class identified by name.
+func (t *dialogTemplate) appendItemClass(style uint32, x, y, cx, cy int16, id uint16, class string, text string) {
+	t.appendItemHeader(style, x, y, cx, cy, id)
+	t.appendString(class)
+	t.appendString(text)
+	*t = append(*t, 0)
+}
+
+// estimateTextHeight returns the approximate height, in dialog units, required
+// to show the text in a static control.
+func estimateTextHeight(text string) int16 {
+	lines := 0
+	for _, v := range splitLines(text) {
+		lines += 1 + utf8.RuneCountInString(v)/50
+	}
+	return int16(8 * lines)
+}
+
+func splitLines(text string) []string {
+	lines := []string{}
+	start := 0
+	for i, r := range text {
+		if r == '\n' {
+			lines = append(lines, text[start:i])
+			start = i + 1
+		}
+	}
+	return append(lines, text[start:])
+}
@@ -34,3 +34,7 @@ func asyncKeyEnter() {
 func asyncKeyEscape() {
 	asyncTypeKeys("\x1b", asyncWait)
 }
+
+func asyncChooseButton(index int) {
+	asyncClickButton(index, asyncWait)
+}
@@ -0,0 +1,16 @@
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+)
+
+// DialogButtons returns a widget that arranges the buttons in a row, aligned
+// to the right.  This is the standard layout for the buttons at the bottom of
+// a dialog.
+func DialogButtons(buttons ...base.Widget) base.Widget {
+	return &HBox{
+		AlignMain:  MainEnd,
+		AlignCross: CrossCenter,
+		Children:   buttons,
+	}
+}
@@ -39,4 +39,11 @@
 // available under the macos branch.  Development has been done on linux using
 // GNUstep, so the build tags will need to be updated to build on a darwin
 // system.
+//
+// Headless
+//
+// Building with the tag headless selects a pure Go backend that does not
+// require a display.  Controls are simulated, and windows are rendered in
+// software, so that applications and tests can run on CI machines without
+// an X server.
 package goey
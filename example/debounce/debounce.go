@@ -19,7 +19,7 @@ func Debounce(cb func(string)) func(string) {
 // debouncer managers the internal state to debounce a callback.
 type debouncer struct {
 	duration time.Duration
-	timer    *time.Timer
+	timer    *loop.Timer
 	cb       func(string)
 	value    string
 }
@@ -34,7 +34,9 @@ func (d *debouncer) OnEvent(s string) {
 	if d.timer != nil {
 		d.timer.Stop()
 	}
-	d.timer = time.AfterFunc(
+	// The timer calls emitEvent on the GUI thread, so the callback can
+	// update the GUI directly.
+	d.timer = loop.AfterFunc(
 		d.duration,
 		d.emitEvent,
 	)
@@ -43,11 +45,5 @@ func (d *debouncer) OnEvent(s string) {
 // emitEvent will call the original callback with the last value received for
 // the event.
 func (d *debouncer) emitEvent() {
-	// This method is called from an arbitrary goroutine, and definitely not
-	// OS thread used for the GUI event loop.  Need to ensure that the event
-	// callback is on the correct thread, so use loop.Do.
-	loop.Do(func() error {
-		d.cb(d.value)
-		return nil
-	})
+	d.cb(d.value)
 }
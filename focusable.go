@@ -0,0 +1,156 @@
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/goey/windows"
+)
+
+var (
+	focusableKind = base.NewKind("github.com/chaolihf/goey.Focusable")
+)
+
+// Focusable wraps an input widget to control how it receives the keyboard
+// focus.  Layout and all other behavior are delegated to the child widget.
+//
+// A widget with a key can be focused using the window's Focus method, for
+// example to move the caret to the first invalid field after validation.
+// Keys should be unique within the application.
+//
+// If Autofocus is set, the child takes the keyboard focus once it has been
+// mounted.  Changing Autofocus when calling UpdateProps has no effect.
+//
+// A positive TabIndex moves the child ahead of the controls without an
+// override in the keyboard navigation order.  Controls with a TabIndex are
+// visited in increasing order.  On Windows, the override only applies
+// among controls with the same parent window.  On GTK, a control within a
+// container, such as Tabs, moves the container ahead.  On Cocoa, TabIndex is
+// currently ignored.
+type Focusable struct {
+	Key       string      // Key used to find the child with Window.Focus
+	Autofocus bool        // Autofocus moves the focus to the child when mounted
+	TabIndex  int         // TabIndex overrides the position of the child in the keyboard navigation order
+	Child     base.Widget // Child widget
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*Focusable) Kind() *base.Kind {
+	return &focusableKind
+}
+
+// Mount creates the child widget in the GUI, and registers its key and tab
+// index.  The newly created widget will be a child of the widget specified
+// by parent.
+func (w *Focusable) Mount(parent base.Control) (base.Element, error) {
+	// Mount the child
+	child, err := base.Mount(parent, w.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	retval := &FocusableElement{
+		parent:    parent,
+		child:     child,
+		key:       w.Key,
+		autofocus: w.Autofocus,
+		tabIndex:  w.TabIndex,
+	}
+	retval.register()
+
+	if w.Autofocus {
+		autofocus(child)
+	}
+
+	return retval, nil
+}
+
+// FocusableElement is the element created by mounting a Focusable.
+type FocusableElement struct {
+	parent    base.Control
+	child     base.Element
+	key       string
+	autofocus bool
+	tabIndex  int
+	target    windows.FocusTarget // Child, if registered
+}
+
+// register associates the key and tab index with the child, if the child can
+// take the focus.
+func (w *FocusableElement) register() {
+	if w.key == "" && w.tabIndex == 0 {
+		return
+	}
+	if target, ok := w.child.(windows.FocusTarget); ok {
+		windows.RegisterFocusTarget(w.key, w.tabIndex, target)
+		w.target = target
+	}
+}
+
+func (w *FocusableElement) unregister() {
+	if w.target != nil {
+		windows.UnregisterFocusTarget(w.target)
+		w.target = nil
+	}
+}
+
+func (w *FocusableElement) Close() {
+	w.unregister()
+	if w.child != nil {
+		w.child.Close()
+		w.child = nil
+	}
+}
+
+func (*FocusableElement) Kind() *base.Kind {
+	return &focusableKind
+}
+
+func (w *FocusableElement) Layout(bc base.Constraints) base.Size {
+	return w.child.Layout(bc)
+}
+
+func (w *FocusableElement) MinIntrinsicHeight(width base.Length) base.Length {
+	return w.child.MinIntrinsicHeight(width)
+}
+
+func (w *FocusableElement) MinIntrinsicWidth(height base.Length) base.Length {
+	return w.child.MinIntrinsicWidth(height)
+}
+
+func (w *FocusableElement) SetBounds(bounds base.Rectangle) {
+	w.child.SetBounds(bounds)
+}
+
+func (w *FocusableElement) updateProps(data *Focusable) (err error) {
+	w.unregister()
+	w.child, err = base.DiffChild(w.parent, w.child, data.Child)
+	w.key = data.Key
+	w.autofocus = data.Autofocus
+	w.tabIndex = data.TabIndex
+	w.register()
+	return err
+}
+
+func (w *FocusableElement) UpdateProps(data base.Widget) error {
+	return w.updateProps(data.(*Focusable))
+}
+
+func (w *FocusableElement) Children() base.Element {
+	return w.child
+}
+
+// autofocus moves the keyboard focus to the element.  The window is not yet
+// visible when the element is mounted, so the focus is moved once the event
+// loop is idle.  If the element has been closed in the meantime, for example
+// because it was replaced, the focus is not moved.
+func autofocus(elem base.Element) {
+	loop.Post(func() {
+		if c, ok := elem.(interface{ isClosed() bool }); ok && c.isClosed() {
+			return
+		}
+		if target, ok := elem.(windows.FocusTarget); ok {
+			target.TakeFocus()
+		}
+	})
+}
@@ -0,0 +1,55 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package goey
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/goeytest"
+	"github.com/chaolihf/goey/loop"
+)
+
+func TestFocusable_TabOrder(t *testing.T) {
+	window, closer := goeytest.WithWindow(t, &VBox{Children: []base.Widget{
+		&Focusable{Key: "a", Child: &TextInput{}},
+		&Focusable{Key: "b", TabIndex: 2, Child: &TextInput{}},
+		&Focusable{Key: "c", TabIndex: 1, Child: &TextInput{}},
+		&Focusable{Key: "d", Child: &TextInput{}},
+	}})
+	defer closer()
+
+	changes := []string(nil)
+	var typer Typeable
+	err := loop.Do(func() error {
+		window.SetOnFocusChange(func(key string) {
+			// The focus is cleared when navigation wraps around.
+			if key != "" {
+				changes = append(changes, key)
+			}
+		})
+		typer = window.Child().(*VboxElement).children[0].(*FocusableElement).child.(Typeable)
+		return window.Focus("c")
+	})
+	if err != nil {
+		t.Fatalf("failed loop.Do: %s", err)
+	}
+
+	// Controls with a tab index come first, followed by the remaining
+	// controls in their default order.
+	for err := range typer.TypeKeys("\t\t\t\t") {
+		t.Errorf("failed to type keys: %s", err)
+	}
+	err = loop.Do(func() error {
+		if want := []string{"c", "b", "a", "d", "c"}; !reflect.DeepEqual(changes, want) {
+			t.Errorf("Unexpected keyboard navigation order, want %v, got %v", want, changes)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("failed loop.Do: %s", err)
+	}
+}
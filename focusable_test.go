@@ -0,0 +1,196 @@
+package goey
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/goeytest"
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/goey/mock"
+	"github.com/chaolihf/goey/windows"
+)
+
+func (w *FocusableElement) Props() base.Widget {
+	child := base.Widget(nil)
+	if w.child != nil {
+		child = w.child.(Proper).Props()
+	}
+
+	return &Focusable{
+		Key:       w.key,
+		Autofocus: w.autofocus,
+		TabIndex:  w.tabIndex,
+		Child:     child,
+	}
+}
+
+func TestFocusableMount(t *testing.T) {
+	testMountWidgets(t,
+		&Focusable{},
+		&Focusable{Key: "a", Child: &mock.Widget{}},
+		&Focusable{Key: "b", TabIndex: 1, Child: &TextInput{}},
+	)
+
+	// This should mount with an error.
+	err := errors.New("Mock error 1")
+	testMountWidgetsFail(t, err,
+		&Focusable{Key: "a", Child: &mock.Widget{Err: err}},
+	)
+}
+
+func TestFocusableClose(t *testing.T) {
+	testCloseWidgets(t,
+		&Focusable{},
+		&Focusable{Key: "a", Child: &mock.Widget{}},
+		&Focusable{Key: "b", TabIndex: 1, Child: &TextInput{}},
+	)
+}
+
+func TestFocusableUpdateProps(t *testing.T) {
+	child := mock.Widget{}
+
+	testUpdateWidgets(t, []base.Widget{
+		&Focusable{},
+		&Focusable{Key: "a", Child: &child},
+		&Focusable{Key: "b", Child: &child, TabIndex: 1},
+	}, []base.Widget{
+		&Focusable{Key: "c", Child: &child, TabIndex: 2},
+		&Focusable{},
+		&Focusable{Key: "b", Child: &child, TabIndex: 1},
+	})
+}
+
+func TestFocusable_Focus(t *testing.T) {
+	focus := make(chan string, 10)
+	onFocus := func(key string) func() {
+		return func() { focus <- key }
+	}
+
+	window, closer := goeytest.WithWindow(t, &VBox{Children: []base.Widget{
+		&Focusable{Key: "a", Child: &TextInput{OnFocus: onFocus("a")}},
+		&Focusable{Key: "b", Autofocus: true, Child: &TextInput{OnFocus: onFocus("b")}},
+		&TextInput{OnFocus: onFocus("")},
+	}})
+	defer closer()
+
+	// Autofocus is applied once the event loop is idle.
+	select {
+	case key := <-focus:
+		if key != "b" {
+			t.Errorf("Autofocus moved focus to the wrong widget, want %s, got %s", "b", key)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Autofocus did not move focus")
+	}
+
+	changes := []string(nil)
+	err := loop.Do(func() error {
+		window.SetOnFocusChange(func(key string) {
+			changes = append(changes, key)
+		})
+
+		if err := window.Focus("a"); err != nil {
+			t.Errorf("Failed to focus widget, %s", err)
+		}
+		if err := window.Focus("missing"); err != windows.ErrKeyNotFound {
+			t.Errorf("Unexpected error for missing key, want %v, got %v", windows.ErrKeyNotFound, err)
+		}
+
+		// Widgets in other windows should not be found.
+		other, err := windows.NewWindow("Other", &Focusable{Key: "other", Child: &TextInput{}})
+		if err != nil {
+			return err
+		}
+		defer other.Close()
+		if err := window.Focus("other"); err != windows.ErrKeyNotFound {
+			t.Errorf("Unexpected error for key in another window, want %v, got %v", windows.ErrKeyNotFound, err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("failed loop.Do: %s", err)
+	}
+
+	select {
+	case key := <-focus:
+		if key != "a" {
+			t.Errorf("Focus moved focus to the wrong widget, want %s, got %s", "a", key)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Focus did not move focus")
+	}
+	err = loop.Do(func() error {
+		if len(changes) != 1 || changes[0] != "a" {
+			t.Errorf("Unexpected focus changes, want [a], got %v", changes)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("failed loop.Do: %s", err)
+	}
+}
+
+func TestAutofocus(t *testing.T) {
+	focus := make(chan string, 10)
+	onFocus := func(key string) func() {
+		return func() { focus <- key }
+	}
+
+	_, closer := goeytest.WithWindow(t, &VBox{Children: []base.Widget{
+		&TextInput{OnFocus: onFocus("a")},
+		&IntInput{Autofocus: true, OnFocus: onFocus("b")},
+	}})
+	defer closer()
+
+	select {
+	case key := <-focus:
+		if key != "b" {
+			t.Errorf("Autofocus moved focus to the wrong widget, want %s, got %s", "b", key)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Autofocus did not move focus")
+	}
+}
+
+func TestAutofocus_Closed(t *testing.T) {
+	focus := make(chan string, 10)
+	onFocus := func(key string) func() {
+		return func() { focus <- key }
+	}
+
+	window, closer := goeytest.WithWindow(t, nil)
+	defer closer()
+
+	// The widgets are replaced before the focus can be moved, so neither
+	// should take the focus.
+	done := make(chan struct{})
+	err := loop.Do(func() error {
+		err := window.SetChild(&VBox{Children: []base.Widget{
+			&TextInput{Autofocus: true, OnFocus: onFocus("a")},
+			&Focusable{Autofocus: true, Child: &TextInput{OnFocus: onFocus("b")}},
+		}})
+		if err != nil {
+			return err
+		}
+		if err := window.SetChild(&Label{Text: "Replaced"}); err != nil {
+			return err
+		}
+		return loop.Post(func() { close(done) })
+	})
+	if err != nil {
+		t.Fatalf("failed loop.Do: %s", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Posted actions were not run")
+	}
+	select {
+	case key := <-focus:
+		t.Errorf("Autofocus moved focus to a closed widget, got %s", key)
+	default:
+	}
+}
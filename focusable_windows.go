@@ -0,0 +1,15 @@
+//go:build !headless
+// +build !headless
+
+package goey
+
+import (
+	"github.com/chaolihf/win"
+)
+
+func (w *FocusableElement) SetOrder(previous win.HWND) win.HWND {
+	if w.child != nil {
+		previous = w.child.SetOrder(previous)
+	}
+	return previous
+}
@@ -0,0 +1,265 @@
+package goey
+
+import (
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+var (
+	gestureKind = base.NewKind("github.com/chaolihf/goey.Gesture")
+)
+
+const (
+	// Longest interval between two taps for them to count as a double tap.
+	doubleTapTime = 500 * time.Millisecond
+	// Furthest distance between two taps for them to count as a double tap.
+	doubleTapDistance = 4 * DIP
+)
+
+// PointerButton identifies the mouse button for a pointer event.
+type PointerButton int
+
+// Buttons for pointer events.
+const (
+	ButtonNone      PointerButton = iota // No button, used when the pointer moves
+	ButtonPrimary                        // Primary button, normally the left button
+	ButtonSecondary                      // Secondary button, normally the right button
+	ButtonMiddle                         // Middle button, or pressing the wheel
+)
+
+// PointerEvent describes a pointer event delivered to a Gesture.
+type PointerEvent struct {
+	Position base.Point    // Position relative to the top-left corner of the child
+	Button   PointerButton // Button that was pressed or released
+}
+
+// WheelEvent describes a mouse wheel event delivered to a Gesture.  The
+// deltas are measured in notches of the wheel, and may be fractional for
+// devices with smooth scrolling.  Positive values scroll down or right.
+type WheelEvent struct {
+	Position base.Point // Position relative to the top-left corner of the child
+	DeltaX   float64    // Horizontal scroll
+	DeltaY   float64    // Vertical scroll
+}
+
+// Gesture wraps another widget to receive pointer events, such as clicks,
+// over the child.  This allows widgets without their own callbacks, such as
+// Img, Label, or Decoration, to respond to the mouse.  Layout and all other
+// behavior are delegated to the child widget.
+//
+// A tap is a press and release of the primary button within the child.  A
+// second tap, close to and shortly after the first, is reported to both
+// OnTap and OnDoubleTap.  A right-click can be detected by checking the
+// button in OnPointerDown or OnPointerUp.
+//
+// On GTK, clicks handled by controls within the child, such as buttons, are
+// not reported to the gesture.  On Cocoa, events are not currently delivered.
+type Gesture struct {
+	OnTap         func(base.Point)   // Primary button clicked
+	OnDoubleTap   func(base.Point)   // Primary button clicked twice
+	OnHover       func(bool)         // Pointer entered, or left, the child
+	OnPointerDown func(PointerEvent) // Button pressed
+	OnPointerMove func(PointerEvent) // Pointer moved
+	OnPointerUp   func(PointerEvent) // Button released
+	OnWheel       func(WheelEvent)   // Mouse wheel scrolled
+	Child         base.Widget        // Child widget
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*Gesture) Kind() *base.Kind {
+	return &gestureKind
+}
+
+// Mount creates the child widget in the GUI, and starts listening for
+// pointer events.  The newly created widget will be a child of the widget
+// specified by parent.
+func (w *Gesture) Mount(parent base.Control) (base.Element, error) {
+	// Mount the child
+	child, err := base.Mount(parent, w.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	retval := &GestureElement{
+		parent: parent,
+		child:  child,
+	}
+	retval.setCallbacks(w)
+	if err := retval.mountNative(); err != nil {
+		child.Close()
+		return nil, err
+	}
+
+	return retval, nil
+}
+
+// GestureElement is the element created by mounting a Gesture.
+type GestureElement struct {
+	gestureNative
+
+	parent base.Control
+	child  base.Element
+	bounds base.Rectangle
+
+	onTap         func(base.Point)
+	onDoubleTap   func(base.Point)
+	onHover       func(bool)
+	onPointerDown func(PointerEvent)
+	onPointerMove func(PointerEvent)
+	onPointerUp   func(PointerEvent)
+	onWheel       func(WheelEvent)
+
+	hovered    bool       // Pointer is over the child
+	pressed    bool       // Primary button was pressed over the child
+	lastTap    time.Time  // Time of the last tap, for double taps
+	lastTapPos base.Point // Position of the last tap, for double taps
+}
+
+func (w *GestureElement) setCallbacks(data *Gesture) {
+	w.onTap = data.OnTap
+	w.onDoubleTap = data.OnDoubleTap
+	w.onHover = data.OnHover
+	w.onPointerDown = data.OnPointerDown
+	w.onPointerMove = data.OnPointerMove
+	w.onPointerUp = data.OnPointerUp
+	w.onWheel = data.OnWheel
+}
+
+func (w *GestureElement) Close() {
+	w.closeNative()
+	if w.child != nil {
+		w.child.Close()
+		w.child = nil
+	}
+}
+
+func (*GestureElement) Kind() *base.Kind {
+	return &gestureKind
+}
+
+func (w *GestureElement) Layout(bc base.Constraints) base.Size {
+	return w.child.Layout(bc)
+}
+
+func (w *GestureElement) MinIntrinsicHeight(width base.Length) base.Length {
+	return w.child.MinIntrinsicHeight(width)
+}
+
+func (w *GestureElement) MinIntrinsicWidth(height base.Length) base.Length {
+	return w.child.MinIntrinsicWidth(height)
+}
+
+// SetBounds updates the position of the child, and of the area where pointer
+// events are received.
+func (w *GestureElement) SetBounds(bounds base.Rectangle) {
+	w.bounds = bounds
+	w.setNativeBounds(bounds)
+	w.child.SetBounds(bounds)
+}
+
+func (w *GestureElement) updateProps(data *Gesture) (err error) {
+	w.child, err = base.DiffChild(w.parent, w.child, data.Child)
+	w.setCallbacks(data)
+	return err
+}
+
+func (w *GestureElement) UpdateProps(data base.Widget) error {
+	return w.updateProps(data.(*Gesture))
+}
+
+func (w *GestureElement) Children() base.Element {
+	return w.child
+}
+
+// contains returns true if the position, relative to the child, is within
+// the child's bounds.
+func (w *GestureElement) contains(pos base.Point) bool {
+	return pos.X >= 0 && pos.Y >= 0 && pos.X < w.bounds.Dx() && pos.Y < w.bounds.Dy()
+}
+
+// hover updates whether the pointer is over the child.
+func (w *GestureElement) hover(hovered bool) {
+	if hovered == w.hovered {
+		return
+	}
+	w.hovered = hovered
+
+	if w.onHover != nil {
+		w.onHover(hovered)
+	}
+}
+
+// pointerDown is called by the platform when a button is pressed over the
+// child.  The position is relative to the child.
+func (w *GestureElement) pointerDown(pos base.Point, button PointerButton) {
+	w.hover(true)
+	if button == ButtonPrimary {
+		w.pressed = true
+	}
+
+	if w.onPointerDown != nil {
+		w.onPointerDown(PointerEvent{pos, button})
+	}
+}
+
+// pointerMove is called by the platform when the pointer moves over the
+// child.
+func (w *GestureElement) pointerMove(pos base.Point) {
+	w.hover(true)
+
+	if w.onPointerMove != nil {
+		w.onPointerMove(PointerEvent{pos, ButtonNone})
+	}
+}
+
+// pointerUp is called by the platform when a button is released.  Taps are
+// recognized when the primary button is pressed and released within the
+// child.
+func (w *GestureElement) pointerUp(pos base.Point, button PointerButton) {
+	if w.onPointerUp != nil {
+		w.onPointerUp(PointerEvent{pos, button})
+	}
+
+	if button != ButtonPrimary || !w.pressed {
+		return
+	}
+	w.pressed = false
+	if !w.contains(pos) {
+		return
+	}
+
+	if w.onTap != nil {
+		w.onTap(pos)
+	}
+
+	// A third tap should not complete a second double tap, so the time of
+	// the last tap is cleared.
+	now := time.Now()
+	delta := pos.Sub(w.lastTapPos)
+	if now.Sub(w.lastTap) < doubleTapTime &&
+		delta.X.Clamp(-doubleTapDistance, doubleTapDistance) == delta.X &&
+		delta.Y.Clamp(-doubleTapDistance, doubleTapDistance) == delta.Y {
+		w.lastTap = time.Time{}
+		if w.onDoubleTap != nil {
+			w.onDoubleTap(pos)
+		}
+		return
+	}
+	w.lastTap, w.lastTapPos = now, pos
+}
+
+// pointerLeave is called by the platform when the pointer leaves the child.
+func (w *GestureElement) pointerLeave() {
+	w.pressed = false
+	w.hover(false)
+}
+
+// wheel is called by the platform when the mouse wheel is scrolled over the
+// child.
+func (w *GestureElement) wheel(pos base.Point, dx, dy float64) {
+	if w.onWheel != nil {
+		w.onWheel(WheelEvent{pos, dx, dy})
+	}
+}
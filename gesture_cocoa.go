@@ -0,0 +1,24 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+)
+
+type gestureNative struct{}
+
+func (w *GestureElement) mountNative() error {
+	// Pointer events are not currently delivered.
+	return nil
+}
+
+func (w *GestureElement) closeNative() {
+	// Do nothing.
+}
+
+func (w *GestureElement) setNativeBounds(bounds base.Rectangle) {
+	// Do nothing.
+}
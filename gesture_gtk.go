@@ -0,0 +1,86 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type gestureNative struct {
+	handle uintptr // Listener for pointer events over the parent.
+}
+
+func (w *GestureElement) mountNative() error {
+	// Events are received from the parent, so that controls within the child
+	// still receive the pointer.
+	w.handle = gtk.MountGestureArea(w.parent.Handle)
+	gtk.RegisterWidget(w.handle, w)
+	return nil
+}
+
+func (w *GestureElement) closeNative() {
+	if w.handle != 0 {
+		gtk.WidgetClose(w.handle)
+		w.handle = 0
+	}
+}
+
+func (w *GestureElement) setNativeBounds(bounds base.Rectangle) {
+	// Do nothing.  The bounds are checked when events are received.
+}
+
+func (w *GestureElement) OnDestroy() {
+	w.handle = 0
+}
+
+// gesturePosition converts a position relative to the parent into a
+// position relative to the child.
+func (w *GestureElement) gesturePosition(x, y float64) base.Point {
+	pos := base.Point{X: base.FromPixelsX(int(x)), Y: base.FromPixelsY(int(y))}
+	return pos.Sub(w.bounds.Min)
+}
+
+// gestureButton converts the GDK button number.
+func gestureButton(button int) PointerButton {
+	switch button {
+	case 1:
+		return ButtonPrimary
+	case 2:
+		return ButtonMiddle
+	case 3:
+		return ButtonSecondary
+	}
+	return ButtonNone
+}
+
+func (w *GestureElement) OnGestureButton(x, y float64, button int, pressed bool) {
+	pos := w.gesturePosition(x, y)
+	if pressed && w.contains(pos) {
+		w.pointerDown(pos, gestureButton(button))
+	} else if !pressed && (w.contains(pos) || w.pressed) {
+		w.pointerUp(pos, gestureButton(button))
+	}
+}
+
+func (w *GestureElement) OnGestureMotion(x, y float64) {
+	if pos := w.gesturePosition(x, y); w.contains(pos) {
+		w.pointerMove(pos)
+	} else if w.hovered {
+		w.pointerLeave()
+	}
+}
+
+func (w *GestureElement) OnGestureLeave() {
+	if w.hovered {
+		w.pointerLeave()
+	}
+}
+
+func (w *GestureElement) OnGestureScroll(x, y, dx, dy float64) {
+	if pos := w.gesturePosition(x, y); w.contains(pos) {
+		w.wheel(pos, dx, dy)
+	}
+}
@@ -0,0 +1,23 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+)
+
+type gestureNative struct{}
+
+func (w *GestureElement) mountNative() error {
+	// Do nothing.  There is no pointer, so events are never delivered.
+	return nil
+}
+
+func (w *GestureElement) closeNative() {
+	// Do nothing.
+}
+
+func (w *GestureElement) setNativeBounds(bounds base.Rectangle) {
+	// Do nothing.
+}
@@ -0,0 +1,106 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package goey
+
+import (
+	"syscall/js"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type gestureNative struct {
+	listeners map[string]js.Func // Listeners added to the parent element.
+}
+
+func (w *GestureElement) mountNative() error {
+	// The child may not have an element of its own, so the listeners are
+	// added to the parent, and events are filtered using the bounds.
+	w.listeners = map[string]js.Func{
+		"pointerdown": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if pos := w.eventPosition(args[0]); w.contains(pos) {
+				w.pointerDown(pos, gestureButton(args[0].Get("button").Int()))
+			}
+			return nil
+		}),
+		"pointermove": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if pos := w.eventPosition(args[0]); w.contains(pos) {
+				w.pointerMove(pos)
+			} else if w.hovered {
+				w.pointerLeave()
+			}
+			return nil
+		}),
+		"pointerup": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if pos := w.eventPosition(args[0]); w.contains(pos) || w.pressed {
+				w.pointerUp(pos, gestureButton(args[0].Get("button").Int()))
+			}
+			return nil
+		}),
+		"pointerleave": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if w.hovered {
+				w.pointerLeave()
+			}
+			return nil
+		}),
+		"wheel": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if pos := w.eventPosition(args[0]); w.contains(pos) {
+				dx, dy := wheelNotches(args[0])
+				w.wheel(pos, dx, dy)
+			}
+			return nil
+		}),
+	}
+	for name, fn := range w.listeners {
+		w.parent.Handle.Call("addEventListener", name, fn)
+	}
+	return nil
+}
+
+func (w *GestureElement) closeNative() {
+	for name, fn := range w.listeners {
+		w.parent.Handle.Call("removeEventListener", name, fn)
+		fn.Release()
+	}
+	w.listeners = nil
+}
+
+func (w *GestureElement) setNativeBounds(bounds base.Rectangle) {
+	// Do nothing.  The bounds are checked when events are received.
+}
+
+// eventPosition returns the position of the event relative to the child.
+func (w *GestureElement) eventPosition(event js.Value) base.Point {
+	rect := w.parent.Handle.Call("getBoundingClientRect")
+	x := event.Get("clientX").Float() - rect.Get("left").Float()
+	y := event.Get("clientY").Float() - rect.Get("top").Float()
+	pos := base.Point{X: base.FromPixelsX(int(x)), Y: base.FromPixelsY(int(y))}
+	return pos.Sub(w.bounds.Min)
+}
+
+// gestureButton converts the DOM button number.
+func gestureButton(button int) PointerButton {
+	switch button {
+	case 0:
+		return ButtonPrimary
+	case 1:
+		return ButtonMiddle
+	case 2:
+		return ButtonSecondary
+	}
+	return ButtonNone
+}
+
+// wheelNotches converts the deltas of a wheel event into notches.  Browsers
+// report the deltas in pixels, lines, or pages, so the conversion is
+// approximate.
+func wheelNotches(event js.Value) (float64, float64) {
+	dx, dy := event.Get("deltaX").Float(), event.Get("deltaY").Float()
+	switch event.Get("deltaMode").Int() {
+	case 0: // Pixels
+		return dx / 100, dy / 100
+	case 1: // Lines
+		return dx / 3, dy / 3
+	}
+	return dx, dy
+}
@@ -0,0 +1,118 @@
+package goey
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/mock"
+)
+
+func (w *GestureElement) Props() base.Widget {
+	child := base.Widget(nil)
+	if w.child != nil {
+		child = w.child.(Proper).Props()
+	}
+
+	return &Gesture{
+		OnTap:         w.onTap,
+		OnDoubleTap:   w.onDoubleTap,
+		OnHover:       w.onHover,
+		OnPointerDown: w.onPointerDown,
+		OnPointerMove: w.onPointerMove,
+		OnPointerUp:   w.onPointerUp,
+		OnWheel:       w.onWheel,
+		Child:         child,
+	}
+}
+
+func TestGestureMount(t *testing.T) {
+	testMountWidgets(t,
+		&Gesture{},
+		&Gesture{Child: &mock.Widget{}},
+		&Gesture{Child: &Label{Text: "A"}},
+	)
+
+	// This should mount with an error.
+	err := errors.New("Mock error 1")
+	testMountWidgetsFail(t, err,
+		&Gesture{Child: &mock.Widget{Err: err}},
+	)
+}
+
+func TestGestureClose(t *testing.T) {
+	testCloseWidgets(t,
+		&Gesture{},
+		&Gesture{Child: &mock.Widget{}},
+	)
+}
+
+func TestGestureUpdateProps(t *testing.T) {
+	child := mock.Widget{}
+
+	testUpdateWidgets(t, []base.Widget{
+		&Gesture{},
+		&Gesture{Child: &child},
+	}, []base.Widget{
+		&Gesture{Child: &child},
+		&Gesture{},
+	})
+}
+
+func TestGestureEvents(t *testing.T) {
+	log := []string(nil)
+	elem := &GestureElement{
+		child: mock.New(base.Size{20 * DIP, 20 * DIP}),
+	}
+	elem.setCallbacks(&Gesture{
+		OnTap:       func(pt base.Point) { log = append(log, fmt.Sprintf("tap %s", pt)) },
+		OnDoubleTap: func(pt base.Point) { log = append(log, fmt.Sprintf("double %s", pt)) },
+		OnHover:     func(hovered bool) { log = append(log, fmt.Sprintf("hover %v", hovered)) },
+		OnPointerDown: func(e PointerEvent) {
+			log = append(log, fmt.Sprintf("down %d", e.Button))
+		},
+		OnPointerUp: func(e PointerEvent) {
+			log = append(log, fmt.Sprintf("up %d", e.Button))
+		},
+		OnWheel: func(e WheelEvent) { log = append(log, fmt.Sprintf("wheel %v", e.DeltaY)) },
+	})
+	elem.SetBounds(base.Rect(10*DIP, 10*DIP, 30*DIP, 30*DIP))
+
+	pt := base.Point{5 * DIP, 5 * DIP}
+	outside := base.Point{25 * DIP, 5 * DIP}
+	cases := []struct {
+		action func()
+		want   []string
+	}{
+		{func() { elem.pointerMove(pt) }, []string{"hover true"}},
+		{func() {
+			elem.pointerDown(pt, ButtonPrimary)
+			elem.pointerUp(pt, ButtonPrimary)
+		}, []string{"down 1", "up 1", "tap " + pt.String()}},
+		{func() {
+			elem.pointerDown(pt, ButtonPrimary)
+			elem.pointerUp(pt, ButtonPrimary)
+		}, []string{"down 1", "up 1", "tap " + pt.String(), "double " + pt.String()}},
+		// Releasing the button outside the child is not a tap.
+		{func() {
+			elem.pointerDown(pt, ButtonPrimary)
+			elem.pointerUp(outside, ButtonPrimary)
+		}, []string{"down 1", "up 1"}},
+		// A right-click is not a tap.
+		{func() {
+			elem.pointerDown(pt, ButtonSecondary)
+			elem.pointerUp(pt, ButtonSecondary)
+		}, []string{"down 2", "up 2"}},
+		{func() { elem.wheel(pt, 0, 1) }, []string{"wheel 1"}},
+		{func() { elem.pointerLeave() }, []string{"hover false"}},
+	}
+
+	for i, v := range cases {
+		log = nil
+		v.action()
+		if fmt.Sprint(log) != fmt.Sprint(v.want) {
+			t.Errorf("Case %d: incorrect events, want %v, got %v", i, v.want, log)
+		}
+	}
+}
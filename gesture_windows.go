@@ -0,0 +1,128 @@
+//go:build !headless
+// +build !headless
+
+package goey
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/goey/base"
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+var (
+	// Hook for mouse messages on the GUI thread, installed while any
+	// gestures are mounted.
+	gestureHook     win2.HHOOK
+	gestureHookProc = syscall.NewCallback(gestureMouseProc)
+	gestures        []*GestureElement
+)
+
+type gestureNative struct{}
+
+func (w *GestureElement) mountNative() error {
+	// Most controls, such as labels and images, do not have a window
+	// procedure that could report mouse messages.  Instead, the messages are
+	// checked before they are dispatched.
+	if len(gestures) == 0 {
+		hook, err := win2.SetWindowsHookEx(win2.WH_MOUSE, gestureHookProc, 0, win.GetCurrentThreadId())
+		if err != nil {
+			return err
+		}
+		gestureHook = hook
+	}
+	gestures = append(gestures, w)
+	return nil
+}
+
+func (w *GestureElement) closeNative() {
+	for i, v := range gestures {
+		if v == w {
+			copy(gestures[i:], gestures[i+1:])
+			gestures[len(gestures)-1] = nil
+			gestures = gestures[:len(gestures)-1]
+			break
+		}
+	}
+	if len(gestures) == 0 && gestureHook != 0 {
+		win2.UnhookWindowsHookEx(gestureHook)
+		gestureHook = 0
+	}
+}
+
+func (w *GestureElement) setNativeBounds(bounds base.Rectangle) {
+	// Do nothing.  The bounds are checked when dispatching messages.
+}
+
+func (w *GestureElement) SetOrder(previous win.HWND) win.HWND {
+	if w.child != nil {
+		previous = w.child.SetOrder(previous)
+	}
+	return previous
+}
+
+func gestureMouseProc(nCode uintptr, wParam uintptr, lParam uintptr) uintptr {
+	if int32(nCode) == win2.HC_ACTION {
+		dispatchGesture(uint32(wParam), (*win2.MOUSEHOOKSTRUCTEX)(unsafe.Pointer(lParam)))
+	}
+	return win2.CallNextHookEx(gestureHook, int32(nCode), wParam, lParam)
+}
+
+// dispatchGesture delivers a mouse message to the gestures in the same window.
+func dispatchGesture(msg uint32, hs *win2.MOUSEHOOKSTRUCTEX) {
+	root := win.GetAncestor(hs.Hwnd, win.GA_ROOT)
+
+	// Callbacks may close gestures, so iterate over a copy.
+	for _, v := range append([]*GestureElement(nil), gestures...) {
+		if v.child == nil {
+			continue
+		}
+		if win.GetAncestor(v.parent.HWnd, win.GA_ROOT) != root {
+			if v.hovered {
+				v.pointerLeave()
+			}
+			continue
+		}
+
+		pt := hs.Pt
+		win.ScreenToClient(v.parent.HWnd, &pt)
+		pos := base.Point{X: base.FromPixelsX(int(pt.X)), Y: base.FromPixelsY(int(pt.Y))}.Sub(v.bounds.Min)
+		if !v.contains(pos) {
+			// A button released outside must still be reported, so that
+			// the press is not left pending.
+			if v.pressed && msg == win.WM_LBUTTONUP {
+				v.pointerUp(pos, ButtonPrimary)
+			}
+			if v.hovered {
+				v.pointerLeave()
+			}
+			continue
+		}
+
+		switch msg {
+		case win.WM_MOUSEMOVE:
+			v.pointerMove(pos)
+		case win.WM_LBUTTONDOWN, win.WM_LBUTTONDBLCLK:
+			v.pointerDown(pos, ButtonPrimary)
+		case win.WM_LBUTTONUP:
+			v.pointerUp(pos, ButtonPrimary)
+		case win.WM_RBUTTONDOWN, win.WM_RBUTTONDBLCLK:
+			v.pointerDown(pos, ButtonSecondary)
+		case win.WM_RBUTTONUP:
+			v.pointerUp(pos, ButtonSecondary)
+		case win.WM_MBUTTONDOWN, win.WM_MBUTTONDBLCLK:
+			v.pointerDown(pos, ButtonMiddle)
+		case win.WM_MBUTTONUP:
+			v.pointerUp(pos, ButtonMiddle)
+		case win.WM_MOUSEWHEEL:
+			// Positive deltas are away from the user, which scrolls up.
+			delta := float64(int16(hs.MouseData>>16)) / win2.WHEEL_DELTA
+			v.wheel(pos, 0, -delta)
+		case win2.WM_MOUSEHWHEEL:
+			delta := float64(int16(hs.MouseData>>16)) / win2.WHEEL_DELTA
+			v.wheel(pos, delta, 0)
+		}
+	}
+}
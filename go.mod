@@ -5,6 +5,7 @@ go 1.9
 require (
 	github.com/chaolihf/win v0.9.3
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
+	github.com/lxn/win v0.0.0-20210218163916-a377121e959e // indirect
 	gitlab.com/stone.code/assert v0.0.0-20191212205015-97cac9777adf
 	golang.org/x/image v0.0.0-20191009234506-e7c1f5e7dbb8
 )
@@ -0,0 +1,120 @@
+package goeytest
+
+import (
+	"errors"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Tolerance controls how closely an image must match its golden image.
+// Screenshots can differ slightly between machines, for example because of
+// font rendering or anti-aliasing, so an exact match is often too strict.
+type Tolerance struct {
+	// Channel is the largest difference allowed in any color channel before
+	// a pixel is counted as different.  The difference is measured using
+	// 8-bit channels.
+	Channel uint8
+	// Pixels is the fraction of pixels, between 0 and 1, that may differ.
+	Pixels float64
+}
+
+// DiffImages counts the pixels that differ between the two images by more than
+// the tolerance for each channel.  The images are compared relative to their
+// top-left corners.  If the images have different sizes, DiffImages returns
+// an error.
+func DiffImages(want, got image.Image, channel uint8) (int, error) {
+	wb, gb := want.Bounds(), got.Bounds()
+	if wb.Size() != gb.Size() {
+		return 0, errors.New("images have different sizes")
+	}
+
+	count := 0
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			r1, g1, b1, a1 := want.At(wb.Min.X+x, wb.Min.Y+y).RGBA()
+			r2, g2, b2, a2 := got.At(gb.Min.X+x, gb.Min.Y+y).RGBA()
+			if channelDiff(r1, r2) > channel || channelDiff(g1, g2) > channel ||
+				channelDiff(b1, b2) > channel || channelDiff(a1, a2) > channel {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+func channelDiff(a, b uint32) uint8 {
+	a, b = a>>8, b>>8
+	if a > b {
+		return uint8(a - b)
+	}
+	return uint8(b - a)
+}
+
+// CompareGolden checks that the image matches the golden image stored in the
+// PNG file, within the tolerance.  If the images do not match, the test is
+// marked as failed, and the image is saved next to the golden image, with the
+// suffix ".failed.png", for inspection.
+//
+// If the environment variable GOEY_UPDATE_GOLDEN is set, the golden image is
+// replaced with the image, and the test passes.  This can be used to create
+// the golden images, or to update them after an intentional change.
+func CompareGolden(t *testing.T, img image.Image, filename string, tolerance Tolerance) {
+	t.Helper()
+
+	if os.Getenv("GOEY_UPDATE_GOLDEN") != "" {
+		if err := writePNG(filename, img); err != nil {
+			t.Errorf("failed to update golden image: %s", err)
+		}
+		return
+	}
+
+	want, err := readPNG(filename)
+	if err != nil {
+		t.Errorf("failed to read golden image: %s (set GOEY_UPDATE_GOLDEN to create)", err)
+		return
+	}
+
+	count, err := DiffImages(want, img, tolerance.Channel)
+	if err != nil {
+		t.Errorf("image does not match %s: %s, want %v, got %v", filename, err, want.Bounds().Size(), img.Bounds().Size())
+	} else if size := img.Bounds().Size(); float64(count) > tolerance.Pixels*float64(size.X*size.Y) {
+		t.Errorf("image does not match %s: %d of %d pixels differ", filename, count, size.X*size.Y)
+	} else {
+		return
+	}
+
+	failed := strings.TrimSuffix(filename, ".png") + ".failed.png"
+	if err := writePNG(failed, img); err != nil {
+		t.Logf("failed to save image: %s", err)
+	}
+}
+
+func readPNG(filename string) (image.Image, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return png.Decode(file)
+}
+
+func writePNG(filename string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	err = png.Encode(file, img)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+package goeytest
+
+import (
+	"image"
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiffImages(t *testing.T) {
+	a := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	b := image.NewRGBA(image.Rect(10, 10, 14, 14))
+	b.Set(10, 10, color.RGBA{4, 0, 0, 0})
+	b.Set(11, 11, color.RGBA{0, 0, 16, 0})
+
+	cases := []struct {
+		channel uint8
+		want    int
+	}{
+		{0, 2},
+		{4, 1},
+		{16, 0},
+	}
+	for _, v := range cases {
+		if got, err := DiffImages(a, b, v.channel); err != nil || got != v.want {
+			t.Errorf("Incorrect count for channel %d, want %d, got %d, %v", v.channel, v.want, got, err)
+		}
+	}
+
+	if _, err := DiffImages(a, image.NewRGBA(image.Rect(0, 0, 4, 5)), 0); err == nil {
+		t.Errorf("Expected error for images with different sizes")
+	}
+}
+
+func TestCompareGolden(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goey")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory, %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	filename := filepath.Join(dir, "testdata", "golden.png")
+	if err := writePNG(filename, img); err != nil {
+		t.Fatalf("Failed to write golden image, %s", err)
+	}
+
+	// One pixel out of sixteen differs.
+	img.Set(0, 0, color.RGBA{255, 255, 255, 255})
+	CompareGolden(t, img, filename, Tolerance{Pixels: 0.1})
+	CompareGolden(t, img, filename, Tolerance{Channel: 255})
+
+	failed := filepath.Join(dir, "testdata", "golden.failed.png")
+	if _, err := os.Stat(failed); !os.IsNotExist(err) {
+		t.Errorf("Unexpected image saved for matching images")
+	}
+}
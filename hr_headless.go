@@ -0,0 +1,30 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type hrElement struct {
+	Control
+}
+
+func (w *HR) mount(parent base.Control) (base.Element, error) {
+	retval := &hrElement{
+		Control: newControl(parent, 13, 13),
+	}
+	retval.node.Paint = retval.paint
+
+	return retval, nil
+}
+
+func (w *hrElement) paint(dst draw.Image, bounds image.Rectangle) {
+	y := (bounds.Min.Y + bounds.Max.Y) / 2
+	headless.Fill(dst, image.Rect(bounds.Min.X, y, bounds.Max.X, y+1), headless.Border)
+}
@@ -2,14 +2,38 @@ package goey
 
 import (
 	"image"
+	"image/draw"
+	"image/gif"
 
+	"github.com/chaolihf/goey/animate"
 	"github.com/chaolihf/goey/base"
+	xdraw "golang.org/x/image/draw"
 )
 
 var (
 	imgKind = base.NewKind("github.com/chaolihf/goey.Img")
 )
 
+// ImageFit controls how the bitmap is scaled to fill the bounds of an Img.
+type ImageFit uint8
+
+// Allowed values for ImageFit.
+const (
+	FitNone    ImageFit = iota // Image is displayed at its natural size.
+	FitContain                 // Image is scaled, preserving aspect ratio, to fit inside the bounds.
+	FitCover                   // Image is scaled, preserving aspect ratio, to cover the bounds, and then cropped.
+	FitStretch                 // Image is scaled to exactly match the bounds.
+)
+
+// ScaledImage is an alternate version of an image, prepared for high DPI
+// displays.  The field Scale is the ratio of the alternate's resolution to
+// the resolution of the original image.  For example, an image at 2x
+// resolution should have a scale of 2.
+type ScaledImage struct {
+	Image image.Image // Image at the higher resolution.
+	Scale float64     // Ratio of resolution relative to the base image.
+}
+
 // Img describes a widget that contains a bitmap image.
 //
 // The size of the control depends on the value of Width and Height.
@@ -18,9 +42,22 @@ var (
 // as zero, then the size will be calculated from the image's size assuming
 // that its resolution is 92 DPI.  If only one dimension is zero, then it will
 // be calculate to maintain the aspect ratio of the image.
+//
+// If the field ImageSet is not empty, the alternate images will be used when
+// the display's DPI is larger than the nominal 96 DPI.  The image with the
+// smallest scale that is at least as large as the display's scale is
+// selected.  Sizing is always based on the field Image.
+//
+// If the field Animation is not nil, the frames of the GIF will be played
+// using the animation loop from the package animate.  In that case, the field
+// Image may be nil, and the first frame of the animation will be used for
+// sizing.
 type Img struct {
-	Image         image.Image // Image to be displayed.
-	Width, Height base.Length // Dimensions for the image (see notes on sizing).
+	Image         image.Image   // Image to be displayed.
+	ImageSet      []ScaledImage // Alternate images for high DPI displays.
+	Animation     *gif.GIF      // Animated image to be displayed instead of Image.
+	Fit           ImageFit      // Scaling of the image to the widget's bounds.
+	Width, Height base.Length   // Dimensions for the image (see notes on sizing).
 }
 
 // Kind returns the concrete type for use in the Widget interface.
@@ -36,7 +73,60 @@ func (w *Img) Mount(parent base.Control) (base.Element, error) {
 	w.UpdateDimensions()
 
 	// Forward to the platform-dependant code
-	return w.mount(parent)
+	elem, err := w.mount(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start any animation.
+	if elem, ok := elem.(*imgElement); ok && elem.animation != nil {
+		animate.AddAnimation(elem)
+	}
+	return elem, nil
+}
+
+// baseImage returns the image that determines the natural size of the widget.
+func (w *Img) baseImage() image.Image {
+	if w.Image == nil && w.Animation != nil && len(w.Animation.Image) > 0 {
+		return w.Animation.Image[0]
+	}
+	return w.Image
+}
+
+// SelectImage returns the image that should be displayed at the current DPI.
+// If there are no alternates in ImageSet with a scale large enough, the
+// alternate with the highest resolution is used.
+func (w *Img) SelectImage() image.Image {
+	if i := w.selectIndex(); i >= 0 {
+		return w.ImageSet[i].Image
+	}
+	return w.baseImage()
+}
+
+// selectIndex returns the index of the alternate image in ImageSet that
+// should be used, or -1 to use the base image.
+func (w *Img) selectIndex() int {
+	scale := float64(base.DPI.X) / 96
+	if scale <= 1 {
+		return -1
+	}
+
+	best, largest := -1, -1
+	for i, v := range w.ImageSet {
+		if v.Image == nil || v.Scale <= 1 {
+			continue
+		}
+		if v.Scale >= scale && (best < 0 || v.Scale < w.ImageSet[best].Scale) {
+			best = i
+		}
+		if largest < 0 || v.Scale > w.ImageSet[largest].Scale {
+			largest = i
+		}
+	}
+	if best >= 0 {
+		return best
+	}
+	return largest
 }
 
 func (*imgElement) Kind() *base.Kind {
@@ -44,6 +134,11 @@ func (*imgElement) Kind() *base.Kind {
 }
 
 func (w *imgElement) Layout(bc base.Constraints) base.Size {
+	// When the image is scaled to the bounds, any size is acceptable.
+	if w.fit != FitNone {
+		return bc.Constrain(base.Size{w.width, w.height})
+	}
+
 	// Determine ideal width.
 	return bc.ConstrainAndAttemptToPreserveAspectRatio(base.Size{w.width, w.height})
 }
@@ -63,18 +158,19 @@ func (w *imgElement) MinIntrinsicWidth(height base.Length) base.Length {
 }
 
 // UpdateDimensions calculates default values for Width and Height if either
-// or zero based on the image dimensions.  The member Image cannot be nil.
+// or zero based on the image dimensions.  The member Image cannot be nil,
+// unless the member Animation is set.
 func (w *Img) UpdateDimensions() {
 	if w.Width == 0 && w.Height == 0 {
-		bounds := w.Image.Bounds()
+		bounds := w.baseImage().Bounds()
 		// Assume that images are at 92 pixels per inch
 		w.Width = (1 * Inch).Scale(bounds.Dx(), 92)
 		w.Height = (1 * Inch).Scale(bounds.Dy(), 92)
 	} else if w.Width == 0 {
-		bounds := w.Image.Bounds()
+		bounds := w.baseImage().Bounds()
 		w.Width = w.Height.Scale(bounds.Dx(), bounds.Dy())
 	} else if w.Height == 0 {
-		bounds := w.Image.Bounds()
+		bounds := w.baseImage().Bounds()
 		w.Height = w.Width.Scale(bounds.Dy(), bounds.Dx())
 	}
 }
@@ -84,6 +180,260 @@ func (w *imgElement) UpdateProps(data base.Widget) error {
 
 	// Fill in the height and width if they are left at zero.
 	img.UpdateDimensions()
+	// Update the source for the bitmap.
+	oldAnimation := w.animation
+	w.imgContent.update(img)
+
 	// Forward to the platform-dependant code
-	return w.updateProps(data.(*Img))
+	err := w.updateProps(img)
+	if err != nil {
+		return err
+	}
+
+	// Start any new animation.  The element is registered again even if it
+	// was already animated, since the previous animation may have completed,
+	// and so have been removed from the animation loop.
+	if w.animation != nil && w.animation != oldAnimation {
+		animate.AddAnimation(w)
+	}
+	return nil
+}
+
+// AnimateFrame updates the displayed frame when the image is animated.
+// Users should not need to use this method directly.
+func (w *imgElement) AnimateFrame(t animate.Time) bool {
+	if w.animation == nil || w.closed {
+		return false
+	}
+
+	index, ok := w.animation.frameAt(t)
+	if index != w.frame {
+		w.frame = index
+		// Errors can't be reported from the animation loop.  If the frame
+		// cannot be updated, the previous frame will remain visible.
+		_ = w.updateImage(w.currentImage())
+	}
+	return ok
+}
+
+// rescale updates the displayed bitmap if it depends on the size of the
+// element's bounds, or on the current DPI.
+func (w *imgElement) rescale(bounds base.Rectangle) {
+	px := bounds.Pixels()
+	size := image.Point{px.Dx(), px.Dy()}
+
+	// The selected image may have changed if the DPI has changed.
+	selected := w.props.selectIndex()
+	if selected == w.selected && (w.fit == FitNone || size == w.pixels) {
+		return
+	}
+
+	w.selected = selected
+	w.source = w.props.SelectImage()
+	w.pixels = size
+	w.cache = nil
+	// Errors can't be reported from SetBounds.  If the bitmap cannot be
+	// updated, the previous bitmap will remain visible.
+	_ = w.updateImage(w.currentImage())
+}
+
+// imgContent holds the information required to select and render the bitmap
+// displayed by an Img.  It is shared by the platform specific elements.
+type imgContent struct {
+	props     Img           // Copy of the widget's properties.
+	fit       ImageFit      // Scaling of the bitmap to the bounds.
+	source    image.Image   // Source image, as selected for the current DPI.
+	selected  int           // Index of the source image in ImageSet, or -1.
+	animation *imgAnimation // Composited frames, if animated.
+	frame     int           // Index of the current frame, if animated.
+	pixels    image.Point   // Size of the bounds in pixels.
+	cache     []image.Image // Rendered bitmaps, indexed by frame.
+	closed    bool          // Set when the element has been closed.
+}
+
+func newImgContent(w *Img) imgContent {
+	c := imgContent{}
+	c.update(w)
+	return c
+}
+
+func (c *imgContent) update(w *Img) {
+	if c.props.Animation != w.Animation {
+		c.animation = newImgAnimation(w.Animation)
+		c.frame = 0
+	}
+	c.props = *w
+	c.fit = w.Fit
+	c.selected = w.selectIndex()
+	c.source = w.SelectImage()
+	c.cache = nil
+}
+
+// derived returns true if the bitmap displayed in the control does not
+// match the field Image, in which case the properties need to be reported
+// from the saved copy.
+func (c *imgContent) derived() bool {
+	return c.fit != FitNone || c.animation != nil || len(c.props.ImageSet) > 0
+}
+
+// currentImage returns the bitmap that should be displayed by the control.
+func (c *imgContent) currentImage() image.Image {
+	source, index := c.source, 0
+	if c.animation != nil {
+		source, index = c.animation.frames[c.frame], c.frame
+	}
+	if c.fit == FitNone || c.pixels.X <= 0 || c.pixels.Y <= 0 {
+		return source
+	}
+
+	if c.cache == nil {
+		c.cache = make([]image.Image, 1)
+		if c.animation != nil {
+			c.cache = make([]image.Image, len(c.animation.frames))
+		}
+	}
+	if c.cache[index] == nil {
+		c.cache[index] = scaleImage(source, c.fit, c.pixels)
+	}
+	return c.cache[index]
+}
+
+func (w *imgElement) propsDerived() *Img {
+	props := w.props
+	props.Width, props.Height = w.width, w.height
+	return &props
+}
+
+// scaleImage renders the image into a new bitmap with the requested size.
+func scaleImage(src image.Image, fit ImageFit, size image.Point) *image.RGBA {
+	dst := image.NewRGBA(image.Rectangle{Max: size})
+	sb := src.Bounds()
+	if sb.Empty() {
+		return dst
+	}
+
+	switch fit {
+	case FitStretch:
+		xdraw.ApproxBiLinear.Scale(dst, dst.Rect, src, sb, draw.Src, nil)
+
+	case FitContain, FitCover:
+		// Determine the scale factor, and compare as cross products to avoid
+		// rounding.
+		wider := size.X*sb.Dy() > size.Y*sb.Dx()
+		if (fit == FitContain) == wider {
+			// Height of the image matches height of the bounds.
+			dx := sb.Dx() * size.Y / sb.Dy()
+			x := (size.X - dx) / 2
+			xdraw.ApproxBiLinear.Scale(dst, image.Rect(x, 0, x+dx, size.Y), src, sb, draw.Src, nil)
+		} else {
+			// Width of the image matches width of the bounds.
+			dy := sb.Dy() * size.X / sb.Dx()
+			y := (size.Y - dy) / 2
+			xdraw.ApproxBiLinear.Scale(dst, image.Rect(0, y, size.X, y+dy), src, sb, draw.Src, nil)
+		}
+
+	default:
+		draw.Draw(dst, dst.Rect, src, sb.Min, draw.Src)
+	}
+	return dst
+}
+
+// imgAnimation holds the fully composited frames of an animated GIF.
+type imgAnimation struct {
+	frames    []*image.RGBA
+	ends      []animate.Time // Cumulative end time for each frame.
+	loopCount int
+	start     animate.Time
+}
+
+func newImgAnimation(g *gif.GIF) *imgAnimation {
+	if g == nil || len(g.Image) == 0 {
+		return nil
+	}
+
+	// Determine the size of the logical screen.
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	if bounds.Empty() {
+		for _, v := range g.Image {
+			bounds = bounds.Union(v.Bounds())
+		}
+	}
+
+	// Composite the frames, while respecting the disposal method for each
+	// frame.
+	canvas := image.NewRGBA(bounds)
+	retval := &imgAnimation{
+		frames:    make([]*image.RGBA, 0, len(g.Image)),
+		ends:      make([]animate.Time, 0, len(g.Image)),
+		loopCount: g.LoopCount,
+		start:     animate.CurrentTime(),
+	}
+	end := animate.Time(0)
+	for i, v := range g.Image {
+		var previous *image.RGBA
+		disposal := byte(gif.DisposalNone)
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
+		}
+		if disposal == gif.DisposalPrevious {
+			previous = cloneRGBA(canvas)
+		}
+
+		draw.Draw(canvas, v.Bounds(), v, v.Bounds().Min, draw.Over)
+		retval.frames = append(retval.frames, cloneRGBA(canvas))
+
+		// Delays are in 100ths of a second.  Like most browsers, very short
+		// delays are extended.
+		delay := 10
+		if i < len(g.Delay) && g.Delay[i] > 1 {
+			delay = g.Delay[i]
+		}
+		end += animate.Time(delay * 10)
+		retval.ends = append(retval.ends, end)
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, v.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	return retval
+}
+
+func cloneRGBA(img *image.RGBA) *image.RGBA {
+	return &image.RGBA{
+		Pix:    append([]uint8(nil), img.Pix...),
+		Stride: img.Stride,
+		Rect:   img.Rect,
+	}
+}
+
+// frameAt returns the index of the frame that should be displayed at the
+// specified time.  The second return value will be false once the animation
+// has completed.
+func (a *imgAnimation) frameAt(t animate.Time) (int, bool) {
+	total := a.ends[len(a.ends)-1]
+	elapsed := t - a.start
+	if t < a.start {
+		elapsed = 0
+	}
+
+	// Check if the animation has completed.  See the documentation for
+	// gif.GIF for the meaning of LoopCount.
+	if a.loopCount < 0 && elapsed >= total {
+		return len(a.frames) - 1, false
+	}
+	if a.loopCount > 0 && elapsed >= total*animate.Time(a.loopCount+1) {
+		return len(a.frames) - 1, false
+	}
+
+	elapsed = elapsed % total
+	for i, v := range a.ends {
+		if elapsed < v {
+			return i, true
+		}
+	}
+	return len(a.frames) - 1, true
 }
@@ -1,36 +1,45 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package goey
 
 import (
+	"image"
+
 	"github.com/chaolihf/goey/base"
 	"github.com/chaolihf/goey/internal/cocoa"
 )
 
 type imgElement struct {
+	imgContent
 	control *cocoa.ImageView
 	width   base.Length
 	height  base.Length
 }
 
 func (w *Img) mount(parent base.Control) (base.Element, error) {
+	// Select the source for the bitmap.
+	content := newImgContent(w)
+
 	// Convert the image to an NSImage
-	control, err := cocoa.NewImageView(parent.Handle, w.Image)
+	control, err := cocoa.NewImageView(parent.Handle, content.currentImage())
 	if err != nil {
 		return nil, err
 	}
 
 	retval := &imgElement{
-		control: control,
-		width:   w.Width,
-		height:  w.Height,
+		imgContent: content,
+		control:    control,
+		width:      w.Width,
+		height:     w.Height,
 	}
 
 	return retval, nil
 }
 
 func (w *imgElement) Close() {
+	w.closed = true
 	if w.control != nil {
 		w.control.Close()
 		w.control = nil
@@ -38,6 +47,12 @@ func (w *imgElement) Close() {
 }
 
 func (w *imgElement) Props() base.Widget {
+	// If the bitmap has been scaled, or otherwise modified, we can't recover
+	// the properties from the control.
+	if w.derived() {
+		return w.propsDerived()
+	}
+
 	return &Img{
 		Image:  w.control.Image(),
 		Width:  w.width,
@@ -48,10 +63,15 @@ func (w *imgElement) Props() base.Widget {
 func (w *imgElement) SetBounds(bounds base.Rectangle) {
 	px := bounds.Pixels()
 	w.control.SetFrame(px.Min.X, px.Min.Y, px.Dx(), px.Dy())
+	w.rescale(bounds)
+}
+
+func (w *imgElement) updateImage(img image.Image) error {
+	return w.control.SetImage(img)
 }
 
 func (w *imgElement) updateProps(data *Img) error {
-	err := w.control.SetImage(data.Image)
+	err := w.updateImage(w.currentImage())
 	if err != nil {
 		return err
 	}
@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package goey
@@ -12,6 +13,7 @@ import (
 
 type imgElement struct {
 	Control
+	imgContent
 
 	pix    []uint8
 	width  base.Length
@@ -19,22 +21,42 @@ type imgElement struct {
 }
 
 func (w *Img) mount(parent base.Control) (base.Element, error) {
-	img := gtk.ImageToRGBA(w.Image)
+	// Select the source for the bitmap.
+	content := newImgContent(w)
+
+	img := gtk.ImageToRGBA(content.currentImage())
 
 	handle := gtk.MountImage(parent.Handle, &img.Pix[0], img.Rect.Dx(), img.Rect.Dy(), img.Stride)
 
 	retval := &imgElement{
-		Control: Control{handle},
-		pix:     img.Pix,
-		width:   w.Width,
-		height:  w.Height,
+		Control:    Control{handle},
+		imgContent: content,
+		pix:        img.Pix,
+		width:      w.Width,
+		height:     w.Height,
 	}
 	gtk.RegisterWidget(handle, retval)
 
 	return retval, nil
 }
 
+func (w *imgElement) Close() {
+	w.closed = true
+	w.Control.Close()
+}
+
+func (w *imgElement) OnDestroy() {
+	w.closed = true
+	w.Control.OnDestroy()
+}
+
 func (w *imgElement) Props() base.Widget {
+	// If the bitmap has been scaled, or otherwise modified, we can't recover
+	// the properties from the control.
+	if w.derived() {
+		return w.propsDerived()
+	}
+
 	return &Img{
 		Image:  w.PropsImage(),
 		Width:  w.width,
@@ -84,13 +106,22 @@ func (w *imgElement) PropsImage() image.Image {
 	}
 }
 
-func (w *imgElement) updateProps(data *Img) error {
-	w.width, w.height = data.Width, data.Height
+func (w *imgElement) SetBounds(bounds base.Rectangle) {
+	w.Control.SetBounds(bounds)
+	w.rescale(bounds)
+}
 
+func (w *imgElement) updateImage(data image.Image) error {
 	// Create the bitmap
-	img := gtk.ImageToRGBA(data.Image)
+	img := gtk.ImageToRGBA(data)
 	gtk.ImageUpdate(w.handle, &img.Pix[0], img.Rect.Dx(), img.Rect.Dy(), img.Stride)
 	w.pix = img.Pix
 
 	return nil
 }
+
+func (w *imgElement) updateProps(data *Img) error {
+	w.width, w.height = data.Width, data.Height
+
+	return w.updateImage(w.currentImage())
+}
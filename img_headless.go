@@ -0,0 +1,67 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type imgElement struct {
+	Control
+	imgContent
+
+	image  image.Image
+	width  base.Length
+	height base.Length
+}
+
+func (w *Img) mount(parent base.Control) (base.Element, error) {
+	retval := &imgElement{
+		Control:    newControl(parent, 0, 0),
+		imgContent: newImgContent(w),
+		width:      w.Width,
+		height:     w.Height,
+	}
+	retval.node.Paint = retval.paint
+	retval.image = retval.currentImage()
+
+	return retval, nil
+}
+
+func (w *imgElement) Close() {
+	w.closed = true
+	w.Control.Close()
+}
+
+func (w *imgElement) paint(dst draw.Image, bounds image.Rectangle) {
+	if w.image == nil {
+		return
+	}
+	draw.Draw(dst, bounds, w.image, w.image.Bounds().Min, draw.Over)
+}
+
+func (w *imgElement) Props() base.Widget {
+	// A copy of the properties is always available, so there is no need to
+	// recover the image from the control.
+	return w.propsDerived()
+}
+
+func (w *imgElement) SetBounds(bounds base.Rectangle) {
+	w.Control.SetBounds(bounds)
+	w.rescale(bounds)
+}
+
+func (w *imgElement) updateImage(data image.Image) error {
+	w.image = data
+	return nil
+}
+
+func (w *imgElement) updateProps(data *Img) error {
+	w.width, w.height = data.Width, data.Height
+
+	return w.updateImage(w.currentImage())
+}
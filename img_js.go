@@ -1,5 +1,5 @@
-//go:build go1.12
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package goey
 
@@ -15,6 +15,7 @@ import (
 
 type imgElement struct {
 	Control
+	imgContent
 
 	width, height base.Length
 }
@@ -26,14 +27,26 @@ func (w *Img) mount(parent base.Control) (base.Element, error) {
 
 	// Create the element
 	retval := &imgElement{
-		Control: Control{handle},
+		Control:    Control{handle},
+		imgContent: newImgContent(w),
 	}
 	retval.updateProps(w)
 
 	return retval, nil
 }
 
+func (w *imgElement) Close() {
+	w.closed = true
+	w.Control.Close()
+}
+
 func (w *imgElement) Props() base.Widget {
+	// If the bitmap has been scaled, or otherwise modified, we can't recover
+	// the properties from the control.
+	if w.derived() {
+		return w.propsDerived()
+	}
+
 	return &Img{
 		Image:  w.propsImage(),
 		Width:  w.width,
@@ -53,9 +66,18 @@ func (w *imgElement) propsImage() image.Image {
 	return img
 }
 
+func (w *imgElement) SetBounds(bounds base.Rectangle) {
+	w.Control.SetBounds(bounds)
+	w.rescale(bounds)
+}
+
+func (w *imgElement) updateImage(img image.Image) error {
+	w.handle.Set("src", goeyjs.ImageToAttr(img))
+	return nil
+}
+
 func (w *imgElement) updateProps(data *Img) error {
-	w.handle.Set("src", goeyjs.ImageToAttr(data.Image))
 	w.width, w.height = data.Width, data.Height
 
-	return nil
+	return w.updateImage(w.currentImage())
 }
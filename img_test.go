@@ -4,9 +4,14 @@ import (
 	"image"
 	"image/color"
 	"image/draw"
+	"image/gif"
 	"testing"
+	"time"
 
+	"github.com/chaolihf/goey/animate"
 	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/goeytest"
+	"github.com/chaolihf/goey/loop"
 )
 
 func drawVerticalRGB(img draw.Image) {
@@ -68,6 +73,27 @@ func TestImgMount(t *testing.T) {
 	)
 }
 
+func TestImgMountFit(t *testing.T) {
+	bounds := image.Rect(0, 0, 92, 46)
+	images := []*image.RGBA{image.NewRGBA(bounds), image.NewRGBA(image.Rect(0, 0, 184, 92))}
+	drawVerticalRGB(images[0])
+	drawVerticalRGB(images[1])
+
+	palette := color.Palette{color.Black, color.White}
+	animation := &gif.GIF{
+		Image: []*image.Paletted{image.NewPaletted(bounds, palette), image.NewPaletted(bounds, palette)},
+		Delay: []int{10, 10},
+	}
+
+	testMountWidgets(t,
+		&Img{Image: images[0], Fit: FitContain, Width: 100 * DIP, Height: 100 * DIP},
+		&Img{Image: images[0], Fit: FitCover, Width: 100 * DIP, Height: 100 * DIP},
+		&Img{Image: images[0], Fit: FitStretch, Width: 100 * DIP, Height: 100 * DIP},
+		&Img{Image: images[0], ImageSet: []ScaledImage{{images[1], 2}}},
+		&Img{Animation: animation},
+	)
+}
+
 func TestImgClose(t *testing.T) {
 	bounds := image.Rect(0, 0, 92, 92)
 	images := []*image.RGBA{image.NewRGBA(bounds), image.NewRGBA(bounds), image.NewRGBA(bounds)}
@@ -166,6 +192,47 @@ func TestImgUpdate(t *testing.T) {
 	})
 }
 
+func TestImgUpdateAnimation(t *testing.T) {
+	bounds := image.Rect(0, 0, 4, 4)
+	palette := color.Palette{color.Black, color.White}
+	newGIF := func(loopCount int) *gif.GIF {
+		return &gif.GIF{
+			Image:     []*image.Paletted{image.NewPaletted(bounds, palette), image.NewPaletted(bounds, palette)},
+			Delay:     []int{1, 1},
+			LoopCount: loopCount,
+		}
+	}
+
+	// The first animation plays only once, so it will complete and be
+	// removed from the animation loop before the update.
+	window, closer := goeytest.WithWindow(t, &Img{Animation: newGIF(-1)})
+	defer closer()
+	time.Sleep(200 * time.Millisecond)
+
+	err := loop.Do(func() error {
+		return window.SetChild(&Img{Animation: newGIF(0)})
+	})
+	if err != nil {
+		t.Fatalf("failed to update animation: %s", err)
+	}
+
+	// The second animation loops forever, so both frames should be shown.
+	frames := map[int]bool{}
+	for deadline := time.Now().Add(time.Second); len(frames) < 2 && time.Now().Before(deadline); {
+		err := loop.Do(func() error {
+			frames[window.Child().(*imgElement).frame] = true
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("failed loop.Do: %s", err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if len(frames) < 2 {
+		t.Errorf("Updated animation was not played")
+	}
+}
+
 func TestImgUpdateDimensions(t *testing.T) {
 	img1 := image.RGBA{Rect: image.Rect(0, 0, 92, 92)}
 
@@ -197,3 +264,113 @@ func TestImgUpdateDimensions(t *testing.T) {
 		}
 	}
 }
+
+func TestImgSelectImage(t *testing.T) {
+	img1 := image.RGBA{Rect: image.Rect(0, 0, 92, 92)}
+	img2 := image.RGBA{Rect: image.Rect(0, 0, 184, 184)}
+	img3 := image.RGBA{Rect: image.Rect(0, 0, 276, 276)}
+
+	cases := []struct {
+		dpi      int
+		imageSet []ScaledImage
+		out      image.Image
+	}{
+		{96, nil, &img1},
+		{192, nil, &img1},
+		{96, []ScaledImage{{&img2, 2}, {&img3, 3}}, &img1},
+		{144, []ScaledImage{{&img2, 2}, {&img3, 3}}, &img2},
+		{192, []ScaledImage{{&img2, 2}, {&img3, 3}}, &img2},
+		{192, []ScaledImage{{&img3, 3}, {&img2, 2}}, &img2},
+		{240, []ScaledImage{{&img2, 2}, {&img3, 3}}, &img3},
+		{384, []ScaledImage{{&img2, 2}, {&img3, 3}}, &img3},
+	}
+
+	oldDPI := base.DPI
+	defer func() {
+		base.DPI = oldDPI
+	}()
+
+	for i, v := range cases {
+		base.DPI = image.Point{v.dpi, v.dpi}
+		widget := Img{
+			Image:    &img1,
+			ImageSet: v.imageSet,
+		}
+
+		if out := widget.SelectImage(); out != v.out {
+			t.Errorf("Case %d:  Failed to select image, got %v, want %v", i, out.Bounds(), v.out.Bounds())
+		}
+	}
+}
+
+func TestImgScaleImage(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 20, 10))
+	draw.Draw(src, src.Rect, image.NewUniform(color.RGBA{255, 0, 0, 255}), image.Point{}, draw.Src)
+	transparent := color.RGBA{}
+	red := color.RGBA{255, 0, 0, 255}
+
+	cases := []struct {
+		fit    ImageFit
+		size   image.Point
+		probes map[image.Point]color.RGBA
+	}{
+		{FitStretch, image.Point{40, 40}, map[image.Point]color.RGBA{{0, 0}: red, {39, 39}: red}},
+		{FitContain, image.Point{40, 40}, map[image.Point]color.RGBA{{0, 0}: transparent, {20, 20}: red, {39, 39}: transparent}},
+		{FitCover, image.Point{40, 40}, map[image.Point]color.RGBA{{0, 0}: red, {39, 39}: red}},
+		{FitNone, image.Point{40, 40}, map[image.Point]color.RGBA{{0, 0}: red, {39, 39}: transparent}},
+	}
+
+	for i, v := range cases {
+		out := scaleImage(src, v.fit, v.size)
+		if got := out.Bounds().Size(); got != v.size {
+			t.Errorf("Case %d:  Incorrect size, got %v, want %v", i, got, v.size)
+		}
+		for pt, clr := range v.probes {
+			if got := out.RGBAAt(pt.X, pt.Y); got != clr {
+				t.Errorf("Case %d:  Incorrect pixel at %v, got %v, want %v", i, pt, got, clr)
+			}
+		}
+	}
+}
+
+func TestImgAnimationFrameAt(t *testing.T) {
+	bounds := image.Rect(0, 0, 4, 4)
+	palette := color.Palette{color.Black, color.White}
+	g := &gif.GIF{
+		Image: []*image.Paletted{
+			image.NewPaletted(bounds, palette),
+			image.NewPaletted(bounds, palette),
+			image.NewPaletted(bounds, palette),
+		},
+		Delay: []int{10, 20, 0},
+	}
+
+	cases := []struct {
+		loopCount int
+		elapsed   animate.Time
+		index     int
+		ok        bool
+	}{
+		{0, 0, 0, true},
+		{0, 99, 0, true},
+		{0, 100, 1, true},
+		{0, 299, 1, true},
+		{0, 300, 2, true},
+		{0, 400, 0, true},
+		{0, 4000, 0, true},
+		{-1, 350, 2, true},
+		{-1, 400, 2, false},
+		{1, 500, 1, true},
+		{1, 800, 2, false},
+	}
+
+	for i, v := range cases {
+		g.LoopCount = v.loopCount
+		anim := newImgAnimation(g)
+
+		index, ok := anim.frameAt(anim.start + v.elapsed)
+		if index != v.index || ok != v.ok {
+			t.Errorf("Case %d:  Incorrect frame, got %d (%v), want %d (%v)", i, index, ok, v.index, v.ok)
+		}
+	}
+}
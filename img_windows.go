@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package goey
 
 import (
@@ -10,8 +13,11 @@ import (
 )
 
 func (w *Img) mount(parent base.Control) (base.Element, error) {
+	// Select the source for the bitmap.
+	content := newImgContent(w)
+
 	// Create the bitmap
-	hbitmap, err := win2.CreateBitmapFromImage(w.Image)
+	hbitmap, err := win2.CreateBitmapFromImage(content.currentImage())
 	if err != nil {
 		return nil, err
 	}
@@ -25,10 +31,11 @@ func (w *Img) mount(parent base.Control) (base.Element, error) {
 	win.SendMessage(hwnd, win2.STM_SETIMAGE, win.IMAGE_BITMAP, uintptr(hbitmap))
 
 	retval := &imgElement{
-		Control: Control{hwnd},
-		hbitmap: hbitmap,
-		width:   w.Width,
-		height:  w.Height,
+		Control:    Control{hwnd},
+		imgContent: content,
+		hbitmap:    hbitmap,
+		width:      w.Width,
+		height:     w.Height,
 	}
 	win.SetWindowLongPtr(hwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(retval)))
 
@@ -37,12 +44,24 @@ func (w *Img) mount(parent base.Control) (base.Element, error) {
 
 type imgElement struct {
 	Control
+	imgContent
 	hbitmap win.HBITMAP
 	width   base.Length
 	height  base.Length
 }
 
+func (w *imgElement) Close() {
+	w.closed = true
+	w.Control.Close()
+}
+
 func (w *imgElement) Props() base.Widget {
+	// If the bitmap has been scaled, or otherwise modified, we can't recover
+	// the properties from the control.
+	if w.derived() {
+		return w.propsDerived()
+	}
+
 	// Need to recreate the image from the HBITMAP
 	hbitmap := win.HBITMAP(win.SendMessage(w.Hwnd, win2.STM_GETIMAGE, win.IMAGE_BITMAP, 0))
 	if hbitmap == 0 {
@@ -65,6 +84,7 @@ func (w *imgElement) Props() base.Widget {
 
 func (w *imgElement) SetBounds(bounds base.Rectangle) {
 	w.Control.SetBounds(bounds)
+	w.rescale(bounds)
 
 	// Not certain why this is required.  However, static controls don't
 	// repaint when resized.  This forces a repaint.
@@ -91,7 +111,7 @@ func (w *imgElement) updateImage(img image.Image) error {
 }
 
 func (w *imgElement) updateProps(data *Img) error {
-	err := w.updateImage(data.Image)
+	err := w.updateImage(w.currentImage())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,41 @@
+package cocoa
+
+/*
+#include "cocoa.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// ColorPanel shows the shared color panel, and returns the color selected.
+// The panel does not have a cancel button, so the selection is always
+// returned.
+func ColorPanel(title string, rgba [4]float64, alpha bool) [4]float64 {
+	ctitle := C.CString(title)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+	}()
+
+	crgba := [4]C.double{C.double(rgba[0]), C.double(rgba[1]), C.double(rgba[2]), C.double(rgba[3])}
+	C.colorPanel(ctitle, &crgba[0], toBool(alpha))
+	return [4]float64{float64(crgba[0]), float64(crgba[1]), float64(crgba[2]), float64(crgba[3])}
+}
+
+// FontPanel shows the shared font panel, and returns the font selected.
+// The panel does not have a cancel button, so the selection is always
+// returned.
+func FontPanel(title string, family string, size float64, bold, italic bool) (string, float64, bool, bool) {
+	ctitle := C.CString(title)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+	}()
+	cfamily := C.CString(family)
+	defer func() {
+		C.free(unsafe.Pointer(cfamily))
+	}()
+
+	csize, cbold, citalic := C.double(size), toBool(bold), toBool(italic)
+	buffer := C.fontPanel(ctitle, cfamily, &csize, &cbold, &citalic)
+	defer C.free(unsafe.Pointer(buffer))
+	return C.GoString(buffer), float64(csize), cbold != 0, citalic != 0
+}
@@ -0,0 +1,88 @@
+#include "cocoa.h"
+#import <Cocoa/Cocoa.h>
+#include <stdlib.h>
+#include <string.h>
+
+// The color and font panels are not modal.  This delegate stops the modal
+// loop when the panel is closed.
+@interface GPanelDelegate : NSObject <NSWindowDelegate>
+- (void)windowWillClose:(NSNotification*)notification;
+@end
+
+@implementation GPanelDelegate
+
+- (void)windowWillClose:(NSNotification*)notification {
+	[NSApp stopModal];
+}
+
+@end
+
+static void runPanelModal( NSPanel* panel ) {
+	GPanelDelegate* delegate = [[GPanelDelegate alloc] init];
+	id prev = [panel delegate];
+	[panel setDelegate:delegate];
+	[NSApp runModalForWindow:panel];
+	[panel setDelegate:prev];
+	[delegate release];
+}
+
+void colorPanel( char const* title, double* rgba, bool_t alpha ) {
+	assert( title );
+	assert( rgba );
+
+	NSColorPanel* panel = [NSColorPanel sharedColorPanel];
+	NSString* tmp = [[NSString alloc] initWithUTF8String:title];
+	[panel setTitle:tmp];
+	[tmp release];
+	[panel setShowsAlpha:( alpha ? YES : NO )];
+	[panel setColor:[NSColor colorWithSRGBRed:rgba[0]
+	                                    green:rgba[1]
+	                                     blue:rgba[2]
+	                                    alpha:rgba[3]]];
+
+	runPanelModal( panel );
+
+	NSColor* color =
+	    [[panel color] colorUsingColorSpace:[NSColorSpace sRGBColorSpace]];
+	rgba[0] = [color redComponent];
+	rgba[1] = [color greenComponent];
+	rgba[2] = [color blueComponent];
+	rgba[3] = [color alphaComponent];
+}
+
+char* fontPanel( char const* title, char const* family, double* size,
+                 bool_t* bold, bool_t* italic ) {
+	assert( title );
+	assert( family );
+
+	NSFontManager* manager = [NSFontManager sharedFontManager];
+	NSFont* font = nil;
+	if ( *family ) {
+		NSString* tmp = [[NSString alloc] initWithUTF8String:family];
+		NSFontTraitMask traits = ( *bold ? NSBoldFontMask : 0 ) |
+		                         ( *italic ? NSItalicFontMask : 0 );
+		font = [manager fontWithFamily:tmp
+		                        traits:traits
+		                        weight:5
+		                          size:( *size > 0 ? *size : 0 )];
+		[tmp release];
+	}
+	if ( !font ) {
+		font = [NSFont systemFontOfSize:0];
+	}
+	[manager setSelectedFont:font isMultiple:NO];
+
+	NSFontPanel* panel = [manager fontPanel:YES];
+	NSString* tmp = [[NSString alloc] initWithUTF8String:title];
+	[panel setTitle:tmp];
+	[tmp release];
+
+	runPanelModal( panel );
+
+	font = [manager convertFont:font];
+	NSFontTraitMask traits = [manager traitsOfFont:font];
+	*size = [font pointSize];
+	*bold = ( traits & NSBoldFontMask ) != 0;
+	*italic = ( traits & NSItalicFontMask ) != 0;
+	return strdup( [[font familyName] UTF8String] );
+}
@@ -13,13 +13,24 @@ typedef struct nssize_tag {
 	int32_t height;
 } nssize_t;
 
+typedef struct nspoint_tag {
+	int32_t x;
+	int32_t y;
+} nspoint_t;
+
 typedef struct nscolor_tag {
 	uint8_t r, g, b, a;
 } nscolor_t;
 
 /* Message dialog */
 extern void messageDialog( void* window, char const* text, char const* title, char icon );
+extern int questionDialog( void* window, char const* text, char const* title, char const* labels, int def, int cancel );
+extern char* promptDialog( void* window, char const* text, char const* title, char const* value, char const* error, bool_t password );
+extern void colorPanel( char const* title, double* rgba, bool_t alpha );
+extern char* fontPanel( char const* title, char const* family, double* size, bool_t* bold, bool_t* italic );
+extern void notifySend( char const* title, char const* body );
 extern char const* openPanel( void* window, char const* dir, char const* base );
+extern char* openPanelFiles( void* window, char const* dir, char const* base, bool_t multiple, bool_t folders );
 extern char const* savePanel( void* window, char const* dir, char const* base );
 extern void dialogSendKey( unsigned key );
 
@@ -40,9 +51,17 @@ extern void windowMakeFirstResponder( void* handle, void* control );
 extern void windowScreenshot( void* handle, void* data, int32_t width, int32_t height );
 extern void windowSetContentSize( void* handle, int width, int height );
 extern void windowSetMinSize( void* handle, int width, int height );
+extern void windowSetMaxSize( void* handle, int width, int height );
+extern void windowResize( void* handle, int width, int height );
+extern nspoint_t windowPosition( void* handle );
+extern void windowMove( void* handle, int x, int y );
+extern void windowSetState( void* handle, int state );
+extern int windowState( void* handle );
 extern void windowSetIconImage( void* handle, void* nsimage );
 extern void windowSetScrollVisible( void* handle, bool_t horz, bool_t vert );
 extern void windowSetTitle( void* handle, char const* title );
+extern void windowSetOptions( void* handle, void* owner, bool_t toolWindow,
+                              bool_t alwaysOnTop );
 extern char const* windowTitle( void* handle );
 
 /* View */
@@ -170,4 +189,23 @@ extern int imageviewImageDepth( void* control );
 extern void imageviewImageData( void* control, void* data );
 extern void imageviewSetImage( void* control, void* image );
 
+/* Status item */
+extern void* statusItemNew( void* image, char const* tooltip );
+extern void statusItemClose( void* handle );
+extern void statusItemSetImage( void* handle, void* image );
+extern void statusItemSetTooltip( void* handle, char const* tooltip );
+extern void statusItemSetMenu( void* handle, char const* items, bool_t const* disabled, unsigned count );
+
+/* Progress panel */
+extern void* progressPanelNew( char const* title, char const* text, double min, double max );
+extern void progressPanelClose( void* handle );
+extern void progressPanelSetText( void* handle, char const* text );
+extern void progressPanelSetValue( void* handle, double value );
+
+/* Toast */
+extern void* toastNew( void* window, char const* text, char const* label );
+extern void toastClose( void* handle );
+extern nssize_t toastIntrinsicSize( void* handle );
+extern void toastSetFrame( void* handle, int x, int y, int width, int height );
+
 #endif
@@ -23,6 +23,59 @@ func MessageDialog(handle *Window, text string, title string, icon byte) {
 	C.messageDialog(unsafe.Pointer(handle), ctext, ctitle, C.char(icon))
 }
 
+// QuestionDialog shows a modal alert with the specified buttons.  The
+// parameter labels should contain all of the button labels, each terminated
+// by a nul character, with an additional nul character at the end.  The
+// return value is the index of the chosen button, or -1.
+func QuestionDialog(handle *Window, text string, title string, labels string, def int, cancel int) int {
+	ctext := C.CString(text)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+	}()
+	ctitle := C.CString(title)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+	}()
+	clabels := C.CString(labels)
+	defer func() {
+		C.free(unsafe.Pointer(clabels))
+	}()
+
+	return int(C.questionDialog(unsafe.Pointer(handle), ctext, ctitle, clabels, C.int(def), C.int(cancel)))
+}
+
+// PromptDialog shows a modal alert with a text field.  The error message is
+// optional.  The return value is the text entered by the user, and a flag
+// indicating whether the user accepted the alert.
+func PromptDialog(handle *Window, text string, title string, value string, errmsg string, password bool) (string, bool) {
+	ctext := C.CString(text)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+	}()
+	ctitle := C.CString(title)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+	}()
+	cvalue := C.CString(value)
+	defer func() {
+		C.free(unsafe.Pointer(cvalue))
+	}()
+	var cerror *C.char
+	if errmsg != "" {
+		cerror = C.CString(errmsg)
+		defer func() {
+			C.free(unsafe.Pointer(cerror))
+		}()
+	}
+
+	buffer := C.promptDialog(unsafe.Pointer(handle), ctext, ctitle, cvalue, cerror, toBool(password))
+	if buffer == nil {
+		return "", false
+	}
+	defer C.free(unsafe.Pointer(buffer))
+	return C.GoString(buffer), true
+}
+
 func OpenPanel(handle *Window, filename string) string {
 	var dir, base *C.char
 	if filename != "" {
@@ -38,6 +91,35 @@ func OpenPanel(handle *Window, filename string) string {
 	return C.GoString(retval)
 }
 
+// OpenPanelFiles shows an open panel, and returns all of the selected paths.
+// The initial directory and filename are optional.  If folders is true, the
+// user selects directories instead of files.
+func OpenPanelFiles(handle *Window, dir string, base string, multiple bool, folders bool) []string {
+	var cdir, cbase *C.char
+	if dir != "" {
+		cdir = C.CString(dir)
+		cbase = C.CString(base)
+		defer func() {
+			C.free(unsafe.Pointer(cdir))
+			C.free(unsafe.Pointer(cbase))
+		}()
+	}
+
+	buffer := C.openPanelFiles(unsafe.Pointer(handle), cdir, cbase, toBool(multiple), toBool(folders))
+	if buffer == nil {
+		return nil
+	}
+	defer C.free(unsafe.Pointer(buffer))
+
+	retval := []string(nil)
+	for p := buffer; *p != 0; {
+		path := C.GoString(p)
+		retval = append(retval, path)
+		p = (*C.char)(unsafe.Pointer(uintptr(unsafe.Pointer(p)) + uintptr(len(path)+1)))
+	}
+	return retval
+}
+
 func SavePanel(handle *Window, filename string) string {
 	var dir, base *C.char
 	if filename != "" {
@@ -1,6 +1,8 @@
 #include "cocoa.h"
 #import <Cocoa/Cocoa.h>
 #include <ctype.h>
+#include <stdlib.h>
+#include <string.h>
 
 void messageDialog( void* window, char const* text, char const* title,
                     char icon ) {
@@ -35,6 +37,97 @@ void messageDialog( void* window, char const* text, char const* title,
 	[alert release];
 }
 
+int questionDialog( void* window, char const* text, char const* title,
+                    char const* labels, int def, int cancel ) {
+	assert( !window || [(id)window isKindOfClass:[NSWindow class]] );
+	assert( text );
+	assert( title );
+	assert( labels );
+
+	NSAlert* alert = [[NSAlert alloc] init];
+
+	NSString* tmp = [[NSString alloc] initWithUTF8String:title];
+	[alert setMessageText:tmp];
+	[tmp release];
+	tmp = [[NSString alloc] initWithUTF8String:text];
+	[alert setInformativeText:tmp];
+	[tmp release];
+
+	// The labels are terminated by a nul character, with an additional nul
+	// character at the end of the list.
+	int count = 0;
+	while ( *labels ) {
+		tmp = [[NSString alloc] initWithUTF8String:labels];
+		NSButton* button = [alert addButtonWithTitle:tmp];
+		[tmp release];
+
+		if ( count == def ) {
+			[button setKeyEquivalent:@"\r"];
+		} else if ( count == cancel ) {
+			[button setKeyEquivalent:@"\033"];
+		} else {
+			[button setKeyEquivalent:@""];
+		}
+
+		labels += strlen( labels ) + 1;
+		++count;
+	}
+
+	NSModalResponse rc = [alert runModal];
+	[alert release];
+
+	if ( rc >= NSAlertFirstButtonReturn && rc < NSAlertFirstButtonReturn + count ) {
+		return rc - NSAlertFirstButtonReturn;
+	}
+	return -1;
+}
+
+char* promptDialog( void* window, char const* text, char const* title,
+                    char const* value, char const* error, bool_t password ) {
+	assert( !window || [(id)window isKindOfClass:[NSWindow class]] );
+	assert( text );
+	assert( title );
+	assert( value );
+
+	NSAlert* alert = [[NSAlert alloc] init];
+
+	NSString* tmp = [[NSString alloc] initWithUTF8String:title];
+	[alert setMessageText:tmp];
+	[tmp release];
+	tmp = [[NSString alloc] initWithUTF8String:text];
+	if ( error ) {
+		NSString* tmp2 = [[NSString alloc] initWithUTF8String:error];
+		NSString* tmp3 = [[NSString alloc] initWithFormat:@"%@\n\n%@", tmp, tmp2];
+		[tmp release];
+		[tmp2 release];
+		tmp = tmp3;
+	}
+	[alert setInformativeText:tmp];
+	[tmp release];
+	[alert addButtonWithTitle:@"OK"];
+	[alert addButtonWithTitle:@"Cancel"];
+
+	NSTextField* field =
+	    password ? [[NSSecureTextField alloc] initWithFrame:NSMakeRect( 0, 0, 240, 24 )]
+	             : [[NSTextField alloc] initWithFrame:NSMakeRect( 0, 0, 240, 24 )];
+	tmp = [[NSString alloc] initWithUTF8String:value];
+	[field setStringValue:tmp];
+	[tmp release];
+	[alert setAccessoryView:field];
+	[[alert window] setInitialFirstResponder:field];
+
+	NSModalResponse rc = [alert runModal];
+
+	char* retval = NULL;
+	if ( rc == NSAlertFirstButtonReturn ) {
+		retval = strdup( [[field stringValue] UTF8String] );
+		assert( retval );
+	}
+	[field release];
+	[alert release];
+	return retval;
+}
+
 static void setFilename( NSSavePanel* panel, char const* dir,
                          char const* base ) {
 	if ( dir ) {
@@ -59,6 +152,41 @@ char const* openPanel( void* window, char const* dir, char const* base ) {
 	return [[panel filename] cStringUsingEncoding:NSUTF8StringEncoding];
 }
 
+char* openPanelFiles( void* window, char const* dir, char const* base,
+                      bool_t multiple, bool_t folders ) {
+	assert( !window || [(id)window isKindOfClass:[NSWindow class]] );
+
+	NSOpenPanel* panel = [NSOpenPanel openPanel];
+	setFilename( panel, dir, base );
+	[panel setAllowsMultipleSelection:( multiple ? YES : NO )];
+	[panel setCanChooseFiles:( folders ? NO : YES )];
+	[panel setCanChooseDirectories:( folders ? YES : NO )];
+
+	if ( [panel runModal] != NSFileHandlingPanelOKButton ) {
+		return NULL;
+	}
+
+	// Build a list of the filenames, each terminated by a nul character,
+	// with an additional nul character at the end of the list.
+	NSArray* urls = [panel URLs];
+	size_t n = 1;
+	for ( NSURL* url in urls ) {
+		n += strlen( [[url path] UTF8String] ) + 1;
+	}
+
+	char* buffer = malloc( n );
+	assert( buffer );
+	char* p = buffer;
+	for ( NSURL* url in urls ) {
+		char const* path = [[url path] UTF8String];
+		size_t len = strlen( path ) + 1;
+		memcpy( p, path, len );
+		p += len;
+	}
+	*p = 0;
+	return buffer;
+}
+
 char const* savePanel( void* window, char const* dir, char const* base ) {
 	assert( !window || [(id)window isKindOfClass:[NSWindow class]] );
 
@@ -0,0 +1,22 @@
+package cocoa
+
+/*
+#include "cocoa.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// NotifySend delivers a user notification.
+func NotifySend(title string, body string) {
+	ctitle := C.CString(title)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+	}()
+	cbody := C.CString(body)
+	defer func() {
+		C.free(unsafe.Pointer(cbody))
+	}()
+
+	C.notifySend(ctitle, cbody)
+}
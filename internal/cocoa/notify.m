@@ -0,0 +1,19 @@
+#include "cocoa.h"
+#import <Cocoa/Cocoa.h>
+
+void notifySend( char const* title, char const* body ) {
+	assert( title );
+	assert( body );
+
+	NSUserNotification* notification = [[NSUserNotification alloc] init];
+	NSString* tmp = [[NSString alloc] initWithUTF8String:title];
+	[notification setTitle:tmp];
+	[tmp release];
+	tmp = [[NSString alloc] initWithUTF8String:body];
+	[notification setInformativeText:tmp];
+	[tmp release];
+
+	[[NSUserNotificationCenter defaultUserNotificationCenter]
+	    deliverNotification:notification];
+	[notification release];
+}
@@ -0,0 +1,57 @@
+package cocoa
+
+/*
+#include "cocoa.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// ProgressPanel is a wrapper for a panel showing a progress bar.
+type ProgressPanel struct {
+	private int
+}
+
+var (
+	progressPanelCallbacks = make(map[unsafe.Pointer]func())
+)
+
+func NewProgressPanel(title string, text string, min, max int, oncancel func()) *ProgressPanel {
+	ctitle := C.CString(title)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+	}()
+	ctext := C.CString(text)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+	}()
+
+	handle := C.progressPanelNew(ctitle, ctext, C.double(min), C.double(max))
+	progressPanelCallbacks[handle] = oncancel
+	return (*ProgressPanel)(handle)
+}
+
+func (w *ProgressPanel) Close() {
+	C.progressPanelClose(unsafe.Pointer(w))
+	delete(progressPanelCallbacks, unsafe.Pointer(w))
+}
+
+func (w *ProgressPanel) SetText(text string) {
+	ctext := C.CString(text)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+	}()
+
+	C.progressPanelSetText(unsafe.Pointer(w), ctext)
+}
+
+func (w *ProgressPanel) SetValue(value int) {
+	C.progressPanelSetValue(unsafe.Pointer(w), C.double(value))
+}
+
+//export progressPanelOnCancel
+func progressPanelOnCancel(handle unsafe.Pointer) {
+	if cb := progressPanelCallbacks[handle]; cb != nil {
+		cb()
+	}
+}
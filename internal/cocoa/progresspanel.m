@@ -0,0 +1,94 @@
+#include "_cgo_export.h"
+#include "cocoa.h"
+#import <Cocoa/Cocoa.h>
+
+@interface GProgressPanel : NSPanel
+@property( assign ) NSTextField* label;
+@property( assign ) NSProgressIndicator* bar;
+@property( assign ) NSButton* button;
+- (void)oncancel;
+@end
+
+@implementation GProgressPanel
+
+- (void)oncancel {
+	[self.button setEnabled:NO];
+	progressPanelOnCancel( self );
+}
+
+- (void)performClose:(id)sender {
+	// Closing the panel is treated as a request to cancel.
+	[self oncancel];
+}
+
+@end
+
+void* progressPanelNew( char const* title, char const* text, double min,
+                        double max ) {
+	assert( title );
+	assert( text );
+
+	GProgressPanel* panel = [[GProgressPanel alloc]
+	    initWithContentRect:NSMakeRect( 0, 0, 360, 96 )
+	              styleMask:NSTitledWindowMask
+	                backing:NSBackingStoreBuffered
+	                  defer:NO];
+	NSString* tmp = [[NSString alloc] initWithUTF8String:title];
+	[panel setTitle:tmp];
+	[tmp release];
+	[panel setLevel:NSModalPanelWindowLevel];
+
+	NSView* content = [panel contentView];
+
+	tmp = [[NSString alloc] initWithUTF8String:text];
+	panel.label =
+	    [[NSTextField alloc] initWithFrame:NSMakeRect( 20, 66, 320, 18 )];
+	[panel.label setStringValue:tmp];
+	[panel.label setEditable:NO];
+	[panel.label setBezeled:NO];
+	[panel.label setDrawsBackground:NO];
+	[tmp release];
+	[content addSubview:panel.label];
+	[panel.label release];
+
+	panel.bar = [[NSProgressIndicator alloc]
+	    initWithFrame:NSMakeRect( 20, 44, 320, 16 )];
+	[panel.bar setIndeterminate:NO];
+	[panel.bar setMinValue:min];
+	[panel.bar setMaxValue:max];
+	[panel.bar setDoubleValue:min];
+	[content addSubview:panel.bar];
+	[panel.bar release];
+
+	panel.button =
+	    [[NSButton alloc] initWithFrame:NSMakeRect( 250, 8, 90, 32 )];
+	[panel.button setTitle:@"Cancel"];
+	[panel.button setBezelStyle:NSRoundedBezelStyle];
+	[panel.button setTarget:panel];
+	[panel.button setAction:@selector( oncancel )];
+	[panel.button setKeyEquivalent:@"\033"];
+	[content addSubview:panel.button];
+	[panel.button release];
+
+	[panel center];
+	[panel makeKeyAndOrderFront:nil];
+	return panel;
+}
+
+void progressPanelClose( void* handle ) {
+	GProgressPanel* panel = handle;
+	[panel orderOut:nil];
+	[panel release];
+}
+
+void progressPanelSetText( void* handle, char const* text ) {
+	GProgressPanel* panel = handle;
+	NSString* tmp = [[NSString alloc] initWithUTF8String:text];
+	[panel.label setStringValue:tmp];
+	[tmp release];
+}
+
+void progressPanelSetValue( void* handle, double value ) {
+	GProgressPanel* panel = handle;
+	[panel.bar setDoubleValue:value];
+}
@@ -0,0 +1,106 @@
+package cocoa
+
+/*
+#include "cocoa.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"bytes"
+	"image"
+	"unsafe"
+)
+
+// StatusItem is a wrapper for a NSStatusItem.
+type StatusItem struct {
+	private int
+}
+
+type statusItemCallback struct {
+	onClick    func()
+	onMenuItem func(int)
+}
+
+var (
+	statusItemCallbacks = make(map[unsafe.Pointer]statusItemCallback)
+)
+
+func NewStatusItem(img image.Image, tooltip string) (*StatusItem, error) {
+	nsi, err := imageToNSImage(img)
+	if err != nil {
+		return nil, err
+	}
+	defer C.imageClose(nsi)
+
+	ctooltip := C.CString(tooltip)
+	defer func() {
+		C.free(unsafe.Pointer(ctooltip))
+	}()
+
+	handle := C.statusItemNew(nsi, ctooltip)
+	return (*StatusItem)(handle), nil
+}
+
+func (w *StatusItem) Close() {
+	C.statusItemClose(unsafe.Pointer(w))
+	delete(statusItemCallbacks, unsafe.Pointer(w))
+}
+
+func (w *StatusItem) SetCallbacks(onclick func(), onmenuitem func(int)) {
+	statusItemCallbacks[unsafe.Pointer(w)] = statusItemCallback{
+		onClick:    onclick,
+		onMenuItem: onmenuitem,
+	}
+}
+
+func (w *StatusItem) SetImage(img image.Image) error {
+	nsi, err := imageToNSImage(img)
+	if err != nil {
+		return err
+	}
+	defer C.imageClose(nsi)
+
+	C.statusItemSetImage(unsafe.Pointer(w), nsi)
+	return nil
+}
+
+// SetMenu changes the items of the menu.  Separators are represented by
+// empty strings.
+func (w *StatusItem) SetMenu(items []string, disabled []bool) {
+	buffer := bytes.Buffer{}
+	cdisabled := make([]C.bool_t, len(items)+1)
+	for i, v := range items {
+		buffer.WriteString(v)
+		buffer.WriteByte(0)
+		cdisabled[i] = toBool(disabled[i])
+	}
+	citems := C.CString(buffer.String())
+	defer func() {
+		C.free(unsafe.Pointer(citems))
+	}()
+
+	C.statusItemSetMenu(unsafe.Pointer(w), citems, &cdisabled[0], C.unsigned(len(items)))
+}
+
+func (w *StatusItem) SetTooltip(tooltip string) {
+	ctooltip := C.CString(tooltip)
+	defer func() {
+		C.free(unsafe.Pointer(ctooltip))
+	}()
+
+	C.statusItemSetTooltip(unsafe.Pointer(w), ctooltip)
+}
+
+//export statusItemOnClick
+func statusItemOnClick(handle unsafe.Pointer) {
+	if cb := statusItemCallbacks[handle]; cb.onClick != nil {
+		cb.onClick()
+	}
+}
+
+//export statusItemOnMenuItem
+func statusItemOnMenuItem(handle unsafe.Pointer, index C.long) {
+	if cb := statusItemCallbacks[handle]; cb.onMenuItem != nil {
+		cb.onMenuItem(int(index))
+	}
+}
@@ -0,0 +1,91 @@
+#include "_cgo_export.h"
+#include "cocoa.h"
+#import <Cocoa/Cocoa.h>
+
+@interface GStatusItem : NSObject
+@property( retain ) NSStatusItem* item;
+- (void)onclick:(id)sender;
+- (void)onmenuitem:(id)sender;
+@end
+
+@implementation GStatusItem
+
+- (void)onclick:(id)sender {
+	statusItemOnClick( self );
+}
+
+- (void)onmenuitem:(id)sender {
+	statusItemOnMenuItem( self, [sender tag] );
+}
+
+@end
+
+void* statusItemNew( void* image, char const* tooltip ) {
+	assert( image && [(id)image isKindOfClass:[NSImage class]] );
+	assert( tooltip );
+
+	GStatusItem* retval = [[GStatusItem alloc] init];
+	retval.item = [[NSStatusBar systemStatusBar]
+	    statusItemWithLength:NSSquareStatusItemLength];
+	[retval.item.button setTarget:retval];
+	[retval.item.button setAction:@selector( onclick: )];
+	statusItemSetImage( retval, image );
+	statusItemSetTooltip( retval, tooltip );
+	return retval;
+}
+
+void statusItemClose( void* handle ) {
+	GStatusItem* item = handle;
+	[[NSStatusBar systemStatusBar] removeStatusItem:item.item];
+	item.item = nil;
+	[item release];
+}
+
+void statusItemSetImage( void* handle, void* image ) {
+	GStatusItem* item = handle;
+	[(NSImage*)image
+	    setSize:NSMakeSize( [[NSStatusBar systemStatusBar] thickness],
+	                        [[NSStatusBar systemStatusBar] thickness] )];
+	[item.item.button setImage:(NSImage*)image];
+}
+
+void statusItemSetTooltip( void* handle, char const* tooltip ) {
+	GStatusItem* item = handle;
+	NSString* tmp = [[NSString alloc] initWithUTF8String:tooltip];
+	[item.item.button setToolTip:tmp];
+	[tmp release];
+}
+
+void statusItemSetMenu( void* handle, char const* items,
+                        bool_t const* disabled, unsigned count ) {
+	GStatusItem* item = handle;
+
+	if ( count == 0 ) {
+		[item.item setMenu:nil];
+		return;
+	}
+
+	// The items are terminated by a nul character.  Separators are
+	// represented by empty strings.
+	NSMenu* menu = [[NSMenu alloc] init];
+	[menu setAutoenablesItems:NO];
+	for ( unsigned i = 0; i < count; ++i ) {
+		if ( !*items ) {
+			[menu addItem:[NSMenuItem separatorItem]];
+		} else {
+			NSString* tmp = [[NSString alloc] initWithUTF8String:items];
+			NSMenuItem* mi = [[NSMenuItem alloc] initWithTitle:tmp
+			                                            action:@selector( onmenuitem: )
+			                                     keyEquivalent:@""];
+			[tmp release];
+			[mi setTarget:item];
+			[mi setTag:i];
+			[mi setEnabled:( disabled[i] ? NO : YES )];
+			[menu addItem:mi];
+			[mi release];
+		}
+		items += strlen( items ) + 1;
+	}
+	[item.item setMenu:menu];
+	[menu release];
+}
@@ -0,0 +1,54 @@
+package cocoa
+
+/*
+#include "cocoa.h"
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// Toast is a wrapper for a view showing a transient message above a
+// window's contents.
+type Toast struct {
+	private int
+}
+
+var (
+	toastCallbacks = make(map[unsafe.Pointer]func())
+)
+
+func NewToast(window *Window, text, label string, onclick func()) *Toast {
+	ctext := C.CString(text)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+	}()
+	clabel := C.CString(label)
+	defer func() {
+		C.free(unsafe.Pointer(clabel))
+	}()
+
+	handle := C.toastNew(unsafe.Pointer(window), ctext, clabel)
+	toastCallbacks[handle] = onclick
+	return (*Toast)(handle)
+}
+
+func (w *Toast) Close() {
+	C.toastClose(unsafe.Pointer(w))
+	delete(toastCallbacks, unsafe.Pointer(w))
+}
+
+func (w *Toast) IntrinsicSize() (int, int) {
+	size := C.toastIntrinsicSize(unsafe.Pointer(w))
+	return int(size.width), int(size.height)
+}
+
+func (w *Toast) SetFrame(x, y, dx, dy int) {
+	C.toastSetFrame(unsafe.Pointer(w), C.int(x), C.int(y), C.int(dx), C.int(dy))
+}
+
+//export toastOnClick
+func toastOnClick(handle unsafe.Pointer) {
+	if cb := toastCallbacks[handle]; cb != nil {
+		cb()
+	}
+}
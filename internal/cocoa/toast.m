@@ -0,0 +1,114 @@
+#include "_cgo_export.h"
+#include "cocoa.h"
+#import <Cocoa/Cocoa.h>
+
+@interface GToast : NSView
+@property( assign ) NSTextField* label;
+@property( assign ) NSButton* button;
+- (void)onclick;
+@end
+
+@implementation GToast
+
+- (void)onclick {
+	toastOnClick( self );
+}
+
+- (void)drawRect:(NSRect)dirtyRect {
+	[[NSColor colorWithCalibratedWhite:0.2 alpha:0.95] set];
+	NSRectFill( dirtyRect );
+}
+
+- (void)setFrameSize:(NSSize)size {
+	[super setFrameSize:size];
+
+	// Place the button along the right edge, and give the remaining space
+	// to the label.
+	CGFloat right = size.width - 12;
+	if ( self.button ) {
+		NSSize bsize = [self.button frame].size;
+		right -= bsize.width;
+		[self.button setFrameOrigin:NSMakePoint(
+		                                right, ( size.height - bsize.height ) / 2 )];
+		right -= 12;
+	}
+	NSSize lsize = [self.label frame].size;
+	[self.label setFrame:NSMakeRect( 12, ( size.height - lsize.height ) / 2,
+	                                 right - 12, lsize.height )];
+}
+
+@end
+
+void* toastNew( void* window, char const* text, char const* label ) {
+	assert( window && [(id)window isKindOfClass:[NSWindow class]] );
+	assert( text );
+
+	GToast* toast = [[GToast alloc] init];
+
+	NSString* tmp = [[NSString alloc] initWithUTF8String:text];
+	toast.label = [[NSTextField alloc] init];
+	[toast.label setStringValue:tmp];
+	[toast.label setEditable:NO];
+	[toast.label setBezeled:NO];
+	[toast.label setDrawsBackground:NO];
+	[toast.label setTextColor:[NSColor whiteColor]];
+	[toast.label sizeToFit];
+	[toast addSubview:toast.label];
+	[toast.label release];
+	[tmp release];
+
+	if ( label && *label ) {
+		tmp = [[NSString alloc] initWithUTF8String:label];
+		toast.button = [[NSButton alloc] init];
+		[toast.button setTitle:tmp];
+		[toast.button setBezelStyle:NSRoundedBezelStyle];
+		[toast.button setTarget:toast];
+		[toast.button setAction:@selector( onclick )];
+		[toast.button sizeToFit];
+		[toast addSubview:toast.button];
+		[toast.button release];
+		[tmp release];
+	}
+
+	// The toast is placed above the scroll view, so that it does not scroll
+	// with the window's contents.
+	NSView* sw = [(NSWindow*)window contentView];
+	[sw addSubview:toast positioned:NSWindowAbove relativeTo:nil];
+	[toast release];
+
+	return toast;
+}
+
+void toastClose( void* handle ) {
+	assert( handle && [(id)handle isKindOfClass:[GToast class]] );
+
+	[(NSView*)handle removeFromSuperview];
+}
+
+nssize_t toastIntrinsicSize( void* handle ) {
+	assert( handle && [(id)handle isKindOfClass:[GToast class]] );
+
+	GToast* toast = handle;
+	NSSize size = [toast.label frame].size;
+	if ( toast.button ) {
+		NSSize bsize = [toast.button frame].size;
+		size.width += 12 + bsize.width;
+		if ( bsize.height > size.height ) {
+			size.height = bsize.height;
+		}
+	}
+
+	nssize_t ret = { size.width + 24, size.height + 16 };
+	return ret;
+}
+
+void toastSetFrame( void* handle, int x, int y, int width, int height ) {
+	assert( handle && [(id)handle isKindOfClass:[GToast class]] );
+
+	// Coordinates are measured from the top of the superview.
+	NSView* superview = [(NSView*)handle superview];
+	if ( ![superview isFlipped] ) {
+		y = [superview frame].size.height - y - height;
+	}
+	[(NSView*)handle setFrame:NSMakeRect( x, y, width, height )];
+}
@@ -22,6 +22,9 @@ type WindowCallbacks interface {
 	OnShouldClose() bool
 	OnWillClose()
 	OnDidResize()
+	OnDidChangeKey(key bool)
+	OnDidChangeMiniaturized(miniaturized bool)
+	OnDidMove()
 }
 
 var (
@@ -104,6 +107,42 @@ func (w *Window) SetMinSize(width, height int) {
 	C.windowSetMinSize(unsafe.Pointer(w), C.int(width), C.int(height))
 }
 
+// SetMaxSize sets the maximum size of the window's content area.  A zero for
+// either dimension means that the size is not limited.
+func (w *Window) SetMaxSize(width, height int) {
+	C.windowSetMaxSize(unsafe.Pointer(w), C.int(width), C.int(height))
+}
+
+// Resize changes the size of the window's content area.
+func (w *Window) Resize(width, height int) {
+	C.windowResize(unsafe.Pointer(w), C.int(width), C.int(height))
+}
+
+// Position returns the location of the window's top-left corner, with the
+// origin at the top-left of the screen.
+func (w *Window) Position() (int, int) {
+	pt := C.windowPosition(unsafe.Pointer(w))
+	return int(pt.x), int(pt.y)
+}
+
+// Move changes the location of the window's top-left corner, with the origin
+// at the top-left of the screen.
+func (w *Window) Move(x, y int) {
+	C.windowMove(unsafe.Pointer(w), C.int(x), C.int(y))
+}
+
+// SetState changes whether the window is normal (0), minimized (1), zoomed (2),
+// or fullscreen (3).
+func (w *Window) SetState(state int) {
+	C.windowSetState(unsafe.Pointer(w), C.int(state))
+}
+
+// State returns whether the window is normal (0), minimized (1), zoomed (2),
+// or fullscreen (3).
+func (w *Window) State() int {
+	return int(C.windowState(unsafe.Pointer(w)))
+}
+
 func (w *Window) SetIcon(img image.Image) error {
 	nsi, err := imageToNSImage(img)
 	if err != nil {
@@ -119,6 +158,12 @@ func (w *Window) SetScrollVisible(horz, vert bool) {
 	C.windowSetScrollVisible(unsafe.Pointer(w), toBool(horz), toBool(vert))
 }
 
+// SetOptions sets the owner of the window, which may be nil, and how the
+// window is shown.
+func (w *Window) SetOptions(owner *Window, toolWindow, alwaysOnTop bool) {
+	C.windowSetOptions(unsafe.Pointer(w), unsafe.Pointer(owner), toBool(toolWindow), toBool(alwaysOnTop))
+}
+
 func (w *Window) SetTitle(title string) {
 	ctitle := C.CString(title)
 	defer func() {
@@ -155,3 +200,24 @@ func windowDidResize(handle unsafe.Pointer) {
 		cb.OnDidResize()
 	}
 }
+
+//export windowDidChangeKey
+func windowDidChangeKey(handle unsafe.Pointer, key bool) {
+	if cb := windowCallbacks[handle]; cb != nil {
+		cb.OnDidChangeKey(key)
+	}
+}
+
+//export windowDidChangeMiniaturized
+func windowDidChangeMiniaturized(handle unsafe.Pointer, miniaturized bool) {
+	if cb := windowCallbacks[handle]; cb != nil {
+		cb.OnDidChangeMiniaturized(miniaturized)
+	}
+}
+
+//export windowDidMove
+func windowDidMove(handle unsafe.Pointer) {
+	if cb := windowCallbacks[handle]; cb != nil {
+		cb.OnDidMove()
+	}
+}
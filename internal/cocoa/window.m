@@ -20,6 +20,11 @@
 @interface MyWindowDelegate : NSObject <NSWindowDelegate>
 - (void)windowWillClose:(NSNotification*)aNotification;
 - (void)windowDidResize:(NSNotification*)aNotification;
+- (void)windowDidBecomeKey:(NSNotification*)aNotification;
+- (void)windowDidResignKey:(NSNotification*)aNotification;
+- (void)windowDidMiniaturize:(NSNotification*)aNotification;
+- (void)windowDidDeminiaturize:(NSNotification*)aNotification;
+- (void)windowDidMove:(NSNotification*)aNotification;
 @end
 
 @implementation MyWindowDelegate
@@ -34,6 +39,31 @@
 	windowDidResize( window );
 }
 
+- (void)windowDidBecomeKey:(NSNotification*)notification {
+	NSWindow* window = [notification object];
+	windowDidChangeKey( window, 1 );
+}
+
+- (void)windowDidResignKey:(NSNotification*)notification {
+	NSWindow* window = [notification object];
+	windowDidChangeKey( window, 0 );
+}
+
+- (void)windowDidMiniaturize:(NSNotification*)notification {
+	NSWindow* window = [notification object];
+	windowDidChangeMiniaturized( window, 1 );
+}
+
+- (void)windowDidDeminiaturize:(NSNotification*)notification {
+	NSWindow* window = [notification object];
+	windowDidChangeMiniaturized( window, 0 );
+}
+
+- (void)windowDidMove:(NSNotification*)notification {
+	NSWindow* window = [notification object];
+	windowDidMove( window );
+}
+
 @end
 
 void* windowNew( char const* title, unsigned width, unsigned height ) {
@@ -148,6 +178,121 @@ void windowSetMinSize( void* handle, int width, int height ) {
 	[w setMinSize:NSMakeSize( NSWidth( frame ), NSHeight( frame ) )];
 }
 
+void windowSetMaxSize( void* handle, int width, int height ) {
+	assert( [NSThread isMainThread] );
+	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
+
+	NSWindow* w = (NSWindow*)handle;
+
+	// Adjust size from content to outer frame.  A zero for either dimension
+	// means that the size is not limited.
+	NSRect frame = NSMakeRect( 0, 0, width, height );
+	frame = [NSWindow frameRectForContentRect:frame styleMask:[w styleMask]];
+	[w setMaxSize:NSMakeSize( width > 0 ? NSWidth( frame ) : FLT_MAX,
+	                          height > 0 ? NSHeight( frame ) : FLT_MAX )];
+}
+
+void windowResize( void* handle, int width, int height ) {
+	assert( [NSThread isMainThread] );
+	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
+
+	[(NSWindow*)handle setContentSize:NSMakeSize( width, height )];
+}
+
+// Convert between the screen coordinates used by AppKit, where the origin is
+// at the bottom-left, and those used by the package, where the origin is at
+// the top-left.
+static CGFloat screenTop( NSWindow* w ) {
+	NSScreen* screen = [w screen];
+	if ( !screen ) {
+		screen = [NSScreen mainScreen];
+	}
+	return NSMaxY( [screen frame] );
+}
+
+nspoint_t windowPosition( void* handle ) {
+	assert( [NSThread isMainThread] );
+	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
+
+	NSWindow* w = (NSWindow*)handle;
+	NSRect frame = [w frame];
+	nspoint_t ret = { NSMinX( frame ), screenTop( w ) - NSMaxY( frame ) };
+	return ret;
+}
+
+void windowMove( void* handle, int x, int y ) {
+	assert( [NSThread isMainThread] );
+	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
+
+	NSWindow* w = (NSWindow*)handle;
+	[w setFrameTopLeftPoint:NSMakePoint( x, screenTop( w ) - y )];
+}
+
+// The style mask bit for fullscreen windows.  The named constant is not
+// available with all versions of AppKit.
+static const NSUInteger fullscreenMask = 1 << 14;
+
+static bool_t windowIsFullscreen( NSWindow* w ) {
+	return ( [w styleMask] & fullscreenMask ) != 0;
+}
+
+static void windowToggleFullscreen( NSWindow* w ) {
+	if ( [w respondsToSelector:@selector( toggleFullScreen: )] ) {
+		[w toggleFullScreen:nil];
+	}
+}
+
+void windowSetState( void* handle, int state ) {
+	assert( [NSThread isMainThread] );
+	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
+
+	NSWindow* w = (NSWindow*)handle;
+
+	// Leave the current state, if necessary.
+	if ( state != 3 && windowIsFullscreen( w ) ) {
+		windowToggleFullscreen( w );
+	}
+	if ( state != 1 && [w isMiniaturized] ) {
+		[w deminiaturize:nil];
+	}
+	if ( state != 2 && [w isZoomed] ) {
+		[w zoom:nil];
+	}
+
+	switch ( state ) {
+	case 1:
+		[w miniaturize:nil];
+		break;
+	case 2:
+		if ( ![w isZoomed] ) {
+			[w zoom:nil];
+		}
+		break;
+	case 3:
+		if ( !windowIsFullscreen( w ) ) {
+			windowToggleFullscreen( w );
+		}
+		break;
+	}
+}
+
+int windowState( void* handle ) {
+	assert( [NSThread isMainThread] );
+	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
+
+	NSWindow* w = (NSWindow*)handle;
+	if ( [w isMiniaturized] ) {
+		return 1;
+	}
+	if ( windowIsFullscreen( w ) ) {
+		return 3;
+	}
+	if ( [w isZoomed] ) {
+		return 2;
+	}
+	return 0;
+}
+
 void windowSetIconImage( void* handle, void* nsimage ) {
 	assert( [NSThread isMainThread] );
 	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
@@ -175,6 +320,24 @@ void windowSetTitle( void* handle, char const* title ) {
 	[wtitle release];
 }
 
+void windowSetOptions( void* handle, void* owner, bool_t toolWindow,
+                       bool_t alwaysOnTop ) {
+	assert( [NSThread isMainThread] );
+	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
+
+	NSWindow* window = handle;
+	if ( owner ) {
+		assert( [(id)owner isKindOfClass:[NSWindow class]] );
+		[(NSWindow*)owner addChildWindow:window ordered:NSWindowAbove];
+	}
+	if ( toolWindow ) {
+		[window setHidesOnDeactivate:YES];
+	}
+	if ( toolWindow || alwaysOnTop ) {
+		[window setLevel:NSFloatingWindowLevel];
+	}
+}
+
 char const* windowTitle( void* handle ) {
 	assert( [NSThread isMainThread] );
 	assert( handle && [(id)handle isKindOfClass:[NSWindow class]] );
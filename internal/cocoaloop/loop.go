@@ -21,6 +21,11 @@ func Run() {
 	C.run()
 }
 
+// RunOnce processes a single event, blocking if no events are pending.
+func RunOnce() {
+	C.runOnce()
+}
+
 var (
 	thunkAction func() error
 	thunkErr    error
@@ -44,6 +49,33 @@ func callbackDo() {
 	thunkErr = nopanic.Wrap(thunkAction)
 }
 
+var (
+	postActions []func()
+	postMutex   sync.Mutex
+)
+
+// PostOnMainThread schedules the action to run on the main thread, but does
+// not wait for the action to complete.  Unlike PerformOnMainThread, this
+// function may be called from the main thread.
+func PostOnMainThread(action func()) {
+	postMutex.Lock()
+	postActions = append(postActions, action)
+	postMutex.Unlock()
+
+	C.postOnMainThread()
+}
+
+//export callbackPost
+func callbackPost() {
+	postMutex.Lock()
+	action := postActions[0]
+	postActions[0] = nil
+	postActions = postActions[1:]
+	postMutex.Unlock()
+
+	action()
+}
+
 func Stop() {
 	C.stop()
 }
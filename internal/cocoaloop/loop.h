@@ -7,7 +7,9 @@ typedef unsigned bool_t;
 
 extern void init( void );
 extern void run( void );
+extern void runOnce( void );
 extern void performOnMainThread( void );
+extern void postOnMainThread( void );
 extern void stop( void );
 extern bool_t isMainThread( void );
 
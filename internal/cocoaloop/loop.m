@@ -156,6 +156,23 @@ void run() {
 
 @end
 
+void runOnce() {
+	TRACE();
+
+	assert( [NSThread isMainThread] );
+	assert( NSApp );
+
+	NSAutoreleasePool* localPool = [[NSAutoreleasePool alloc] init];
+	NSEvent* event = [NSApp nextEventMatchingMask:NSAnyEventMask
+	                                    untilDate:[NSDate distantFuture]
+	                                       inMode:NSDefaultRunLoopMode
+	                                      dequeue:YES];
+	if ( event ) {
+		[NSApp sendEvent:event];
+	}
+	[localPool release];
+}
+
 void stop() {
 	TRACE();
 
@@ -219,6 +236,40 @@ void performOnMainThread() {
 	[pool release];
 }
 
+@interface PostThunk : NSObject
+- (void)main;
+@end
+
+@implementation PostThunk
+
+- (void)main {
+	TRACE();
+
+	assert( [NSThread isMainThread] );
+
+	callbackPost();
+}
+
+@end
+
+void postOnMainThread() {
+	TRACE();
+
+	// Even though we don't use autorelease, apparently a autorelease pool
+	// is requred by the call to performSelectorOnMainThread.
+	NSAutoreleasePool* pool = [[NSAutoreleasePool alloc] init];
+	assert( pool );
+
+	// Without waiting, the selector is queued on the main thread's run loop,
+	// even if the caller is on the main thread.
+	id thunk = [[PostThunk alloc] init];
+	[thunk performSelectorOnMainThread:@selector( main )
+	                        withObject:nil
+	                     waitUntilDone:NO];
+	[thunk release];
+	[pool release];
+}
+
 bool_t isMainThread( void ) {
 	TRACE();
 
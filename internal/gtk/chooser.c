@@ -0,0 +1,87 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include <stdlib.h>  // for malloc
+#include <string.h>  // for strlen
+#include "thunks.h"
+
+void *mountColorChooserDialog( void *window, char const *title, double red,
+                               double green, double blue, double alpha,
+                               bool useAlpha )
+{
+    GtkWidget *dialog =
+        gtk_color_chooser_dialog_new( title, GTK_WINDOW( window ) );
+    assert( dialog );
+
+    GdkRGBA rgba = { red, green, blue, alpha };
+    gtk_color_chooser_set_use_alpha( GTK_COLOR_CHOOSER( dialog ), useAlpha );
+    gtk_color_chooser_set_rgba( GTK_COLOR_CHOOSER( dialog ), &rgba );
+
+    return dialog;
+}
+
+void colorChooserDialogRGBA( void *dialog, double *rgba )
+{
+    GdkRGBA tmp;
+    gtk_color_chooser_get_rgba( GTK_COLOR_CHOOSER( dialog ), &tmp );
+    rgba[0] = tmp.red;
+    rgba[1] = tmp.green;
+    rgba[2] = tmp.blue;
+    rgba[3] = tmp.alpha;
+}
+
+void *mountFontChooserDialog( void *window, char const *title,
+                              char const *family, double size, bool bold,
+                              bool italic )
+{
+    GtkWidget *dialog =
+        gtk_font_chooser_dialog_new( title, GTK_WINDOW( window ) );
+    assert( dialog );
+
+    if ( *family ) {
+        PangoFontDescription *desc = pango_font_description_new();
+        pango_font_description_set_family( desc, family );
+        if ( size > 0 ) {
+            pango_font_description_set_size( desc, size * PANGO_SCALE );
+        }
+        pango_font_description_set_weight(
+            desc, bold ? PANGO_WEIGHT_BOLD : PANGO_WEIGHT_NORMAL );
+        pango_font_description_set_style(
+            desc, italic ? PANGO_STYLE_ITALIC : PANGO_STYLE_NORMAL );
+        gtk_font_chooser_set_font_desc( GTK_FONT_CHOOSER( dialog ), desc );
+        pango_font_description_free( desc );
+    }
+
+    return dialog;
+}
+
+char *fontChooserDialogFont( void *dialog, double *size, bool *bold,
+                             bool *italic )
+{
+    PangoFontDescription *desc =
+        gtk_font_chooser_get_font_desc( GTK_FONT_CHOOSER( dialog ) );
+    if ( !desc ) {
+        return NULL;
+    }
+
+    // Sizes are in points, unless the description uses absolute units.
+    *size = (double)pango_font_description_get_size( desc ) / PANGO_SCALE;
+    if ( pango_font_description_get_size_is_absolute( desc ) ) {
+        *size = *size * 72 / 96;
+    }
+    *bold = pango_font_description_get_weight( desc ) >= PANGO_WEIGHT_BOLD;
+    *italic = pango_font_description_get_style( desc ) != PANGO_STYLE_NORMAL;
+
+    // Copy the family name into a buffer allocated with malloc, so that the
+    // caller can free the buffer.
+    char const *family = pango_font_description_get_family( desc );
+    if ( !family ) {
+        family = "";
+    }
+    size_t len = strlen( family ) + 1;
+    char *retval = malloc( len );
+    assert( retval );
+    memcpy( retval, family, len );
+
+    pango_font_description_free( desc );
+    return retval;
+}
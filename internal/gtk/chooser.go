@@ -0,0 +1,44 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+func MountColorChooserDialog(parent uintptr, title string, rgba [4]float64, useAlpha bool) uintptr {
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+
+	return uintptr(C.mountColorChooserDialog(unsafe.Pointer(parent), ctitle,
+		C.double(rgba[0]), C.double(rgba[1]), C.double(rgba[2]), C.double(rgba[3]), C.bool(useAlpha)))
+}
+
+func ColorChooserDialogRGBA(dialog uintptr) [4]float64 {
+	var rgba [4]C.double
+	C.colorChooserDialogRGBA(unsafe.Pointer(dialog), &rgba[0])
+	return [4]float64{float64(rgba[0]), float64(rgba[1]), float64(rgba[2]), float64(rgba[3])}
+}
+
+func MountFontChooserDialog(parent uintptr, title string, family string, size float64, bold, italic bool) uintptr {
+	ctitle, cfamily := C.CString(title), C.CString(family)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+		C.free(unsafe.Pointer(cfamily))
+	}()
+
+	return uintptr(C.mountFontChooserDialog(unsafe.Pointer(parent), ctitle, cfamily, C.double(size), C.bool(bold), C.bool(italic)))
+}
+
+// FontChooserDialogFont returns the family, size, weight, and style of the
+// font selected in the dialog.
+func FontChooserDialogFont(dialog uintptr) (string, float64, bool, bool) {
+	var size C.double
+	var bold, italic C.bool
+
+	family := C.fontChooserDialogFont(unsafe.Pointer(dialog), &size, &bold, &italic)
+	if family == nil {
+		return "", 0, false, false
+	}
+	defer C.free(unsafe.Pointer(family))
+	return C.GoString(family), float64(size), bool(bold), bool(italic)
+}
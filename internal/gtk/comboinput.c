@@ -0,0 +1,218 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include <string.h>  // for strlen
+#include "_cgo_export.h"
+#include "callback.h"
+#include "thunks.h"
+
+static GtkEntry *getEntry( void *widget )
+{
+    GtkWidget *child = gtk_bin_get_child( GTK_BIN( widget ) );
+    assert( child && GTK_IS_ENTRY( child ) );
+    return GTK_ENTRY( child );
+}
+
+static void onchange_cb( GtkEntry *entry, gpointer data )
+{
+    assert( entry );
+    assert( data );
+
+    // If an item is active, then the text was changed by selecting an item
+    // from the list, and the suggestions should not be updated.
+    bool selected = gtk_combo_box_get_active( GTK_COMBO_BOX( data ) ) >= 0;
+    onComboChange( data, (char *)gtk_entry_get_text( entry ), selected );
+}
+
+static void onselect_combobox_cb( GtkComboBox *widget, gpointer data )
+{
+    assert( widget );
+
+    // The signal is also emitted when the user edits the text, in which case
+    // no item will be active.
+    if ( gtk_combo_box_get_active( widget ) >= 0 ) {
+        onComboSelect( widget,
+                       (char *)gtk_entry_get_text( getEntry( widget ) ) );
+    }
+}
+
+static gboolean onselect_completion_cb( GtkEntryCompletion *completion,
+                                        GtkTreeModel *model, GtkTreeIter *iter,
+                                        gpointer data )
+{
+    assert( model );
+    assert( data );
+
+    gchar *text = NULL;
+    gtk_tree_model_get( model, iter, 0, &text, -1 );
+    // Update the entry before reporting the selection.  The change is
+    // reported directly, so that the suggestions are not updated while the
+    // completion is still using the model.
+    GtkEntry *entry = getEntry( data );
+    g_signal_handlers_block_by_func( entry, G_CALLBACK( onchange_cb ), data );
+    gtk_entry_set_text( entry, text );
+    g_signal_handlers_unblock_by_func( entry, G_CALLBACK( onchange_cb ),
+                                       data );
+    onComboChange( data, text, true );
+    onComboSelect( data, text );
+    g_free( text );
+    return TRUE;
+}
+
+static gboolean match_cb( GtkEntryCompletion *completion, gchar const *key,
+                          GtkTreeIter *iter, gpointer data )
+{
+    // The suggestions are already filtered by Go.
+    return TRUE;
+}
+
+static gboolean onfocus_comboinput_cb( GtkWidget *widget, GdkEvent *event,
+                                       gpointer data )
+{
+    assert( widget );
+    assert( data );
+
+    onFocus( data );
+    return FALSE;
+}
+
+static gboolean onblur_comboinput_cb( GtkWidget *widget, GdkEvent *event,
+                                      gpointer data )
+{
+    assert( widget );
+    assert( data );
+
+    onBlur( data );
+    return FALSE;
+}
+
+static void setSignals( void *widget, bool onfocus, bool onblur )
+{
+    GtkEntry *entry = getEntry( widget );
+
+    g_signal_handlers_disconnect_by_func(
+        entry, G_CALLBACK( onfocus_comboinput_cb ), widget );
+    g_signal_handlers_disconnect_by_func(
+        entry, G_CALLBACK( onblur_comboinput_cb ), widget );
+
+    if ( onfocus ) {
+        g_signal_connect( entry, "focus-in-event",
+                          G_CALLBACK( onfocus_comboinput_cb ), widget );
+    }
+    if ( onblur ) {
+        g_signal_connect( entry, "focus-out-event",
+                          G_CALLBACK( onblur_comboinput_cb ), widget );
+    }
+}
+
+void comboInputSetSuggestions( void *widget, char const *items )
+{
+    assert( widget );
+    assert( items );
+
+    // Block the signal so that clearing the list is not reported as a
+    // selection.
+    g_signal_handlers_block_by_func( widget,
+                                     G_CALLBACK( onselect_combobox_cb ), NULL );
+    gtk_combo_box_text_remove_all( GTK_COMBO_BOX_TEXT( widget ) );
+
+    GtkEntryCompletion *completion =
+        gtk_entry_get_completion( getEntry( widget ) );
+    assert( completion );
+    GtkListStore *store =
+        GTK_LIST_STORE( gtk_entry_completion_get_model( completion ) );
+    assert( store );
+    gtk_list_store_clear( store );
+
+    char const *i;
+    for ( i = items; *i; i += strlen( i ) + 1 ) {
+        gtk_combo_box_text_append_text( GTK_COMBO_BOX_TEXT( widget ), i );
+
+        GtkTreeIter iter;
+        gtk_list_store_append( store, &iter );
+        gtk_list_store_set( store, &iter, 0, i, -1 );
+    }
+    g_signal_handlers_unblock_by_func(
+        widget, G_CALLBACK( onselect_combobox_cb ), NULL );
+}
+
+static void setText( void *widget, char const *text, char const *items )
+{
+    GtkEntry *entry = getEntry( widget );
+
+    g_signal_handlers_block_by_func( entry, G_CALLBACK( onchange_cb ),
+                                     widget );
+    if ( strcmp( text, gtk_entry_get_text( entry ) ) != 0 ) {
+        gtk_entry_set_text( entry, text );
+    }
+    comboInputSetSuggestions( widget, items );
+    g_signal_handlers_unblock_by_func( entry, G_CALLBACK( onchange_cb ),
+                                       widget );
+}
+
+void *mountComboInput( void *parent, char const *text, char const *items,
+                       char const *placeholder, bool disabled, bool onfocus,
+                       bool onblur )
+{
+    assert( parent );
+    assert( text );
+    assert( items );
+
+    GtkWidget *widget = gtk_combo_box_text_new_with_entry();
+    assert( widget );
+    GtkEntry *entry = getEntry( widget );
+
+    GtkListStore *store = gtk_list_store_new( 1, G_TYPE_STRING );
+    GtkEntryCompletion *completion = gtk_entry_completion_new();
+    gtk_entry_completion_set_model( completion, GTK_TREE_MODEL( store ) );
+    gtk_entry_completion_set_text_column( completion, 0 );
+    gtk_entry_completion_set_match_func( completion, match_cb, NULL, NULL );
+    gtk_entry_set_completion( entry, completion );
+    g_object_unref( store );
+    g_object_unref( completion );
+
+    // The entry needs to report changes, even if there is no callback for
+    // OnChange, so that the suggestions can be updated.
+    g_signal_connect( entry, "changed", G_CALLBACK( onchange_cb ), widget );
+    g_signal_connect( widget, "changed", G_CALLBACK( onselect_combobox_cb ),
+                      NULL );
+    g_signal_connect( completion, "match-selected",
+                      G_CALLBACK( onselect_completion_cb ), widget );
+    g_signal_connect( widget, "destroy", G_CALLBACK( ondestroy_cb ), NULL );
+
+    setText( widget, text, items );
+    gtk_entry_set_placeholder_text( entry, placeholder );
+    gtk_widget_set_sensitive( widget, !disabled );
+    setSignals( widget, onfocus, onblur );
+
+    gtk_container_add( GTK_CONTAINER( parent ), widget );
+    gtk_widget_show( widget );
+
+    return widget;
+}
+
+void comboInputUpdate( void *widget, char const *text, char const *items,
+                       char const *placeholder, bool disabled, bool onfocus,
+                       bool onblur )
+{
+    assert( widget );
+    assert( text );
+    assert( items );
+
+    setText( widget, text, items );
+    gtk_entry_set_placeholder_text( getEntry( widget ), placeholder );
+    gtk_widget_set_sensitive( widget, !disabled );
+    setSignals( widget, onfocus, onblur );
+}
+
+char const *comboInputText( void *widget )
+{
+    assert( widget );
+    return gtk_entry_get_text( getEntry( widget ) );
+}
+
+char const *comboInputPlaceholder( void *widget )
+{
+    assert( widget );
+    char const *text = gtk_entry_get_placeholder_text( getEntry( widget ) );
+    return text ? text : "";
+}
@@ -0,0 +1,65 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+// ComboInput is implemented by elements using an editable combobox.
+type ComboInput interface {
+	WidgetWithFocus
+	OnChange(value string, selected bool)
+	OnSelect(value string)
+}
+
+//export onComboChange
+func onComboChange(handle unsafe.Pointer, text *C.char, selected bool) {
+	widgets[uintptr(handle)].(ComboInput).OnChange(C.GoString(text), selected)
+}
+
+//export onComboSelect
+func onComboSelect(handle unsafe.Pointer, text *C.char) {
+	widgets[uintptr(handle)].(ComboInput).OnSelect(C.GoString(text))
+}
+
+// The parameter items should contain all of the items, each terminated by a
+// nul character, with an additional nul character at the end.
+
+func MountComboInput(parent uintptr, text, items, placeholder string, disabled, onfocus, onblur bool) uintptr {
+	ctext, citems, cplaceholder := C.CString(text), C.CString(items), C.CString(placeholder)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+		C.free(unsafe.Pointer(citems))
+		C.free(unsafe.Pointer(cplaceholder))
+	}()
+
+	return uintptr(C.mountComboInput(unsafe.Pointer(parent), ctext, citems, cplaceholder,
+		C.bool(disabled), C.bool(onfocus), C.bool(onblur)))
+}
+
+func ComboInputUpdate(handle uintptr, text, items, placeholder string, disabled, onfocus, onblur bool) {
+	ctext, citems, cplaceholder := C.CString(text), C.CString(items), C.CString(placeholder)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+		C.free(unsafe.Pointer(citems))
+		C.free(unsafe.Pointer(cplaceholder))
+	}()
+
+	C.comboInputUpdate(unsafe.Pointer(handle), ctext, citems, cplaceholder,
+		C.bool(disabled), C.bool(onfocus), C.bool(onblur))
+}
+
+func ComboInputSetSuggestions(handle uintptr, items string) {
+	citems := C.CString(items)
+	defer C.free(unsafe.Pointer(citems))
+
+	C.comboInputSetSuggestions(unsafe.Pointer(handle), citems)
+}
+
+func ComboInputText(handle uintptr) string {
+	return C.GoString(C.comboInputText(unsafe.Pointer(handle)))
+}
+
+func ComboInputPlaceholder(handle uintptr) string {
+	return C.GoString(C.comboInputPlaceholder(unsafe.Pointer(handle)))
+}
@@ -94,3 +94,13 @@ unsigned dateInputDay( void *widget )
     gtk_calendar_get_date( GTK_CALENDAR( widget ), &year, &month, &day );
     return day;
 }
+
+void dateInputSelect( void *widget, int year, unsigned month, unsigned day )
+{
+    assert( widget );
+    assert( month >= 1 && month <= 12 );
+    assert( day >= 1 && day <= 31 );
+
+    gtk_calendar_select_month( GTK_CALENDAR( widget ), month - 1, year );
+    gtk_calendar_select_day( GTK_CALENDAR( widget ), day );
+}
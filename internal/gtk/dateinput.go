@@ -1,5 +1,6 @@
 package gtk
 
+// #include "thunks.h"
 import "C"
 import "unsafe"
 import "time"
@@ -14,3 +15,7 @@ func onChangeTime(handle unsafe.Pointer, year, month, day uint) {
 	value := time.Date(int(year), time.Month(month), int(day), 0, 0, 0, 0, time.Local)
 	widgets[uintptr(handle)].(DateInput).OnChange(value)
 }
+
+func DateInputSelect(handle uintptr, year int, month, day uint) {
+	C.dateInputSelect(unsafe.Pointer(handle), C.int(year), C.unsigned(month), C.unsigned(day))
+}
@@ -0,0 +1,67 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include <stdlib.h>  // for malloc
+#include <string.h>  // for strlen
+#include "thunks.h"
+
+void *mountOpenFolderDialog( void *window, char const *title )
+{
+    GtkWidget *dialog = gtk_file_chooser_dialog_new(
+        title, GTK_WINDOW( window ), GTK_FILE_CHOOSER_ACTION_SELECT_FOLDER,
+        "_Open", GTK_RESPONSE_ACCEPT, "_Cancel", GTK_RESPONSE_CANCEL, NULL );
+    assert( dialog );
+    gtk_dialog_set_default_response( GTK_DIALOG( dialog ),
+                                     GTK_RESPONSE_ACCEPT );
+    return dialog;
+}
+
+void dialogSetFolder( void *dialog, char const *folder )
+{
+    gtk_file_chooser_set_current_folder( GTK_FILE_CHOOSER( dialog ), folder );
+}
+
+void dialogSetMultiple( void *dialog, bool multiple )
+{
+    gtk_file_chooser_set_select_multiple( GTK_FILE_CHOOSER( dialog ),
+                                          multiple );
+}
+
+char *dialogGetFilenames( void *dialog, size_t *length )
+{
+    assert( length );
+
+    GSList *list = gtk_file_chooser_get_filenames( GTK_FILE_CHOOSER( dialog ) );
+
+    // Each filename is terminated by a nul character.
+    size_t n = 0;
+    for ( GSList *i = list; i; i = i->next ) {
+        n += strlen( i->data ) + 1;
+    }
+
+    char *buffer = malloc( n ? n : 1 );
+    assert( buffer );
+    char *p = buffer;
+    for ( GSList *i = list; i; i = i->next ) {
+        size_t len = strlen( i->data ) + 1;
+        memcpy( p, i->data, len );
+        p += len;
+    }
+    g_slist_free_full( list, g_free );
+
+    *length = n;
+    return buffer;
+}
+
+int dialogFilterIndex( void *dialog )
+{
+    GtkFileFilter *filter =
+        gtk_file_chooser_get_filter( GTK_FILE_CHOOSER( dialog ) );
+    if ( !filter ) {
+        return -1;
+    }
+
+    GSList *list = gtk_file_chooser_list_filters( GTK_FILE_CHOOSER( dialog ) );
+    int retval = g_slist_index( list, filter );
+    g_slist_free( list );
+    return retval;
+}
@@ -0,0 +1,44 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import (
+	"strings"
+	"unsafe"
+)
+
+func MountOpenFolderDialog(parent uintptr, title string) uintptr {
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+
+	return uintptr(C.mountOpenFolderDialog(unsafe.Pointer(parent), ctitle))
+}
+
+func DialogSetFolder(dialog uintptr, folder string) {
+	cfolder := C.CString(folder)
+	defer C.free(unsafe.Pointer(cfolder))
+
+	C.dialogSetFolder(unsafe.Pointer(dialog), cfolder)
+}
+
+func DialogSetMultiple(dialog uintptr, multiple bool) {
+	C.dialogSetMultiple(unsafe.Pointer(dialog), C.bool(multiple))
+}
+
+// DialogGetFilenames returns all of the filenames selected in a file chooser.
+func DialogGetFilenames(dialog uintptr) []string {
+	length := C.size_t(0)
+	buffer := C.dialogGetFilenames(unsafe.Pointer(dialog), &length)
+	defer C.free(unsafe.Pointer(buffer))
+
+	if length == 0 {
+		return nil
+	}
+	text := C.GoStringN(buffer, C.int(length))
+	return strings.Split(strings.TrimSuffix(text, "\x00"), "\x00")
+}
+
+func DialogFilterIndex(dialog uintptr) int {
+	return int(C.dialogFilterIndex(unsafe.Pointer(dialog)))
+}
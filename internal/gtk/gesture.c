@@ -0,0 +1,131 @@
+#include <assert.h>
+#include <gtk/gtk.h>
+#include "_cgo_export.h"
+#include "callback.h"
+#include "thunks.h"
+
+// eventPosition returns the position of the event relative to the parent.
+// The event may have been propagated from a window belonging to a control
+// within the parent, so the position is calculated from the root coordinates.
+static void eventPosition( GtkWidget *parent, GdkEvent *event, double *x,
+                           double *y )
+{
+    double xroot = 0, yroot = 0;
+    gdk_event_get_root_coords( event, &xroot, &yroot );
+
+    GdkWindow *window = GTK_IS_LAYOUT( parent )
+                            ? gtk_layout_get_bin_window( GTK_LAYOUT( parent ) )
+                            : gtk_widget_get_window( parent );
+    int ox = 0, oy = 0;
+    if ( window ) {
+        gdk_window_get_origin( window, &ox, &oy );
+    }
+    *x = xroot - ox;
+    *y = yroot - oy;
+}
+
+static gboolean onbutton_cb( GtkWidget *parent, GdkEventButton *event,
+                             gpointer user_data )
+{
+    double x, y;
+
+    // Double clicks are recognized in Go, so the extra events for double and
+    // triple clicks are ignored.
+    if ( event->type == GDK_BUTTON_PRESS ) {
+        eventPosition( parent, (GdkEvent *)event, &x, &y );
+        onGestureButton( user_data, x, y, event->button, true );
+    } else if ( event->type == GDK_BUTTON_RELEASE ) {
+        eventPosition( parent, (GdkEvent *)event, &x, &y );
+        onGestureButton( user_data, x, y, event->button, false );
+    }
+    return FALSE;
+}
+
+static gboolean onmotion_cb( GtkWidget *parent, GdkEventMotion *event,
+                             gpointer user_data )
+{
+    double x, y;
+    eventPosition( parent, (GdkEvent *)event, &x, &y );
+    onGestureMotion( user_data, x, y );
+    return FALSE;
+}
+
+static gboolean onleave_cb( GtkWidget *parent, GdkEventCrossing *event,
+                            gpointer user_data )
+{
+    // Moving over a control within the parent is not leaving the parent.
+    if ( event->detail != GDK_NOTIFY_INFERIOR ) {
+        onGestureLeave( user_data );
+    }
+    return FALSE;
+}
+
+static gboolean onscroll_cb( GtkWidget *parent, GdkEventScroll *event,
+                             gpointer user_data )
+{
+    double x, y, dx = 0, dy = 0;
+
+    switch ( event->direction ) {
+    case GDK_SCROLL_UP:
+        dy = -1;
+        break;
+    case GDK_SCROLL_DOWN:
+        dy = 1;
+        break;
+    case GDK_SCROLL_LEFT:
+        dx = -1;
+        break;
+    case GDK_SCROLL_RIGHT:
+        dx = 1;
+        break;
+    case GDK_SCROLL_SMOOTH:
+        gdk_event_get_scroll_deltas( (GdkEvent *)event, &dx, &dy );
+        break;
+    }
+    eventPosition( parent, (GdkEvent *)event, &x, &y );
+    onGestureScroll( user_data, x, y, dx, dy );
+    return FALSE;
+}
+
+static void ongesturedestroy_cb( GtkWidget *widget, gpointer user_data )
+{
+    // Release the reference taken when the gesture was mounted.  Once the
+    // widget is finalized, the handlers on the parent are disconnected.
+    g_object_unref( widget );
+}
+
+void *mountGestureArea( void *parent )
+{
+    assert( parent && GTK_IS_WIDGET( parent ) );
+
+    // A window placed over the child would capture the pointer, so that
+    // controls within the child would not receive clicks.  Instead, the
+    // events are received from the parent, and are filtered using the bounds
+    // of the child.  The widget is never shown.  It identifies the gesture,
+    // and disconnects the handlers from the parent when it is destroyed.
+    GtkWidget *widget = gtk_event_box_new();
+    assert( widget );
+    g_object_ref_sink( widget );
+
+    gtk_widget_add_events( GTK_WIDGET( parent ),
+                           GDK_BUTTON_PRESS_MASK | GDK_BUTTON_RELEASE_MASK |
+                               GDK_POINTER_MOTION_MASK |
+                               GDK_LEAVE_NOTIFY_MASK | GDK_SCROLL_MASK |
+                               GDK_SMOOTH_SCROLL_MASK );
+    g_signal_connect_object( parent, "button-press-event",
+                             G_CALLBACK( onbutton_cb ), widget, 0 );
+    g_signal_connect_object( parent, "button-release-event",
+                             G_CALLBACK( onbutton_cb ), widget, 0 );
+    g_signal_connect_object( parent, "motion-notify-event",
+                             G_CALLBACK( onmotion_cb ), widget, 0 );
+    g_signal_connect_object( parent, "leave-notify-event",
+                             G_CALLBACK( onleave_cb ), widget, 0 );
+    g_signal_connect_object( parent, "scroll-event",
+                             G_CALLBACK( onscroll_cb ), widget, 0 );
+
+    g_signal_connect( widget, "destroy", G_CALLBACK( ondestroy_cb ), NULL );
+    g_signal_connect( widget, "destroy", G_CALLBACK( ongesturedestroy_cb ),
+                      NULL );
+
+    return widget;
+}
@@ -0,0 +1,42 @@
+package gtk
+
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+// GestureArea is implemented by elements receiving pointer events from a
+// parent.  Positions are relative to the parent.
+type GestureArea interface {
+	Widget
+	OnGestureButton(x, y float64, button int, pressed bool)
+	OnGestureMotion(x, y float64)
+	OnGestureLeave()
+	OnGestureScroll(x, y, dx, dy float64)
+}
+
+// MountGestureArea starts listening for pointer events over the parent.  The
+// returned widget is never shown, but identifies the listener.  The listener
+// is removed when the widget is closed.
+func MountGestureArea(parent uintptr) uintptr {
+	return uintptr(C.mountGestureArea(unsafe.Pointer(parent)))
+}
+
+//export onGestureButton
+func onGestureButton(handle unsafe.Pointer, x, y float64, button uint, pressed bool) {
+	widgets[uintptr(handle)].(GestureArea).OnGestureButton(x, y, int(button), pressed)
+}
+
+//export onGestureMotion
+func onGestureMotion(handle unsafe.Pointer, x, y float64) {
+	widgets[uintptr(handle)].(GestureArea).OnGestureMotion(x, y)
+}
+
+//export onGestureLeave
+func onGestureLeave(handle unsafe.Pointer) {
+	widgets[uintptr(handle)].(GestureArea).OnGestureLeave()
+}
+
+//export onGestureScroll
+func onGestureScroll(handle unsafe.Pointer, x, y, dx, dy float64) {
+	widgets[uintptr(handle)].(GestureArea).OnGestureScroll(x, y, dx, dy)
+}
@@ -0,0 +1,211 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include <string.h>  // for strlen
+#include "_cgo_export.h"
+#include "callback.h"
+#include "thunks.h"
+
+static GtkListBox *getList( void *widget )
+{
+    // The scrolled window contains a viewport, which contains the list.
+    GtkWidget *child = gtk_bin_get_child( GTK_BIN( widget ) );
+    assert( child );
+    if ( GTK_IS_VIEWPORT( child ) ) {
+        child = gtk_bin_get_child( GTK_BIN( child ) );
+    }
+    assert( child && GTK_IS_LIST_BOX( child ) );
+    return GTK_LIST_BOX( child );
+}
+
+static void onchange_cb( GtkListBox *list, gpointer data )
+{
+    assert( list );
+    assert( data );
+    onListboxChange( data );
+}
+
+static void onactivate_cb( GtkListBox *list, GtkListBoxRow *row, gpointer data )
+{
+    assert( row );
+    assert( data );
+    onListboxActivate( data, gtk_list_box_row_get_index( row ) );
+}
+
+static gboolean onfocus_listbox_cb( GtkWidget *widget, GdkEvent *event,
+                                    gpointer data )
+{
+    assert( data );
+    onFocus( data );
+    return FALSE;
+}
+
+static gboolean onblur_listbox_cb( GtkWidget *widget, GdkEvent *event,
+                                   gpointer data )
+{
+    assert( data );
+    onBlur( data );
+    return FALSE;
+}
+
+static void setSignals( void *widget, bool onchange, bool onactivate,
+                        bool onfocus, bool onblur )
+{
+    GtkListBox *list = getList( widget );
+
+    g_signal_handlers_disconnect_by_data( list, widget );
+
+    if ( onchange ) {
+        g_signal_connect( list, "selected-rows-changed",
+                          G_CALLBACK( onchange_cb ), widget );
+    }
+    if ( onactivate ) {
+        g_signal_connect( list, "row-activated", G_CALLBACK( onactivate_cb ),
+                          widget );
+    }
+    if ( onfocus ) {
+        g_signal_connect( list, "focus-in-event",
+                          G_CALLBACK( onfocus_listbox_cb ), widget );
+    }
+    if ( onblur ) {
+        g_signal_connect( list, "focus-out-event",
+                          G_CALLBACK( onblur_listbox_cb ), widget );
+    }
+}
+
+static void setItems( GtkListBox *list, char const *items )
+{
+    // Remove the existing rows.
+    GList *children = gtk_container_get_children( GTK_CONTAINER( list ) );
+    GList *i;
+    for ( i = children; i; i = i->next ) {
+        gtk_widget_destroy( GTK_WIDGET( i->data ) );
+    }
+    g_list_free( children );
+
+    char const *text;
+    for ( text = items; *text; text += strlen( text ) + 1 ) {
+        GtkWidget *label = gtk_label_new( text );
+        gtk_label_set_xalign( GTK_LABEL( label ), 0 );
+        gtk_widget_show( label );
+        gtk_list_box_insert( list, label, -1 );
+    }
+}
+
+static void setSelected( GtkListBox *list, int const *selected,
+                         unsigned count )
+{
+    gtk_list_box_unselect_all( list );
+
+    unsigned i;
+    for ( i = 0; i < count; ++i ) {
+        GtkListBoxRow *row = gtk_list_box_get_row_at_index( list, selected[i] );
+        if ( row ) {
+            gtk_list_box_select_row( list, row );
+        }
+    }
+}
+
+static void setProperties( void *widget, char const *items, int const *selected,
+                           unsigned count, bool multiple, bool disabled )
+{
+    GtkListBox *list = getList( widget );
+
+    gtk_list_box_set_selection_mode(
+        list, multiple ? GTK_SELECTION_MULTIPLE : GTK_SELECTION_SINGLE );
+    setItems( list, items );
+    setSelected( list, selected, count );
+    gtk_widget_set_sensitive( GTK_WIDGET( widget ), !disabled );
+}
+
+void *mountListbox( void *parent, char const *items, int const *selected,
+                    unsigned count, bool multiple, bool disabled,
+                    bool onchange, bool onactivate, bool onfocus, bool onblur )
+{
+    assert( parent );
+    assert( items );
+
+    GtkWidget *widget = gtk_scrolled_window_new( NULL, NULL );
+    assert( widget );
+    gtk_scrolled_window_set_policy( GTK_SCROLLED_WINDOW( widget ),
+                                    GTK_POLICY_NEVER, GTK_POLICY_AUTOMATIC );
+    gtk_scrolled_window_set_shadow_type( GTK_SCROLLED_WINDOW( widget ),
+                                         GTK_SHADOW_IN );
+
+    GtkWidget *list = gtk_list_box_new();
+    assert( list );
+    gtk_list_box_set_activate_on_single_click( GTK_LIST_BOX( list ), FALSE );
+    gtk_widget_add_events( list, GDK_FOCUS_CHANGE_MASK );
+    gtk_container_add( GTK_CONTAINER( widget ), list );
+    gtk_widget_show( list );
+
+    setProperties( widget, items, selected, count, multiple, disabled );
+
+    g_signal_connect( widget, "destroy", G_CALLBACK( ondestroy_cb ), NULL );
+    setSignals( widget, onchange, onactivate, onfocus, onblur );
+
+    gtk_container_add( GTK_CONTAINER( parent ), widget );
+    gtk_widget_show( widget );
+
+    return widget;
+}
+
+void listboxUpdate( void *widget, char const *items, int const *selected,
+                    unsigned count, bool multiple, bool disabled,
+                    bool onchange, bool onactivate, bool onfocus, bool onblur )
+{
+    assert( widget );
+    assert( items );
+
+    // Disconnect the signals before updating, so that changes are not
+    // reported.
+    g_signal_handlers_disconnect_by_data( getList( widget ), widget );
+    setProperties( widget, items, selected, count, multiple, disabled );
+    setSignals( widget, onchange, onactivate, onfocus, onblur );
+}
+
+void *listboxList( void *widget )
+{
+    assert( widget );
+    return getList( widget );
+}
+
+unsigned listboxItemCount( void *widget )
+{
+    assert( widget );
+
+    GList *children = gtk_container_get_children(
+        GTK_CONTAINER( getList( widget ) ) );
+    unsigned count = g_list_length( children );
+    g_list_free( children );
+    return count;
+}
+
+char const *listboxItem( void *widget, unsigned index )
+{
+    assert( widget );
+
+    GtkListBoxRow *row =
+        gtk_list_box_get_row_at_index( getList( widget ), index );
+    if ( !row ) {
+        return "";
+    }
+    GtkWidget *label = gtk_bin_get_child( GTK_BIN( row ) );
+    assert( label && GTK_IS_LABEL( label ) );
+    return gtk_label_get_text( GTK_LABEL( label ) );
+}
+
+bool listboxMultiple( void *widget )
+{
+    assert( widget );
+    return gtk_list_box_get_selection_mode( getList( widget ) ) ==
+           GTK_SELECTION_MULTIPLE;
+}
+
+bool listboxIsSelected( void *widget, unsigned index )
+{
+    assert( widget );
+
+    GtkListBoxRow *row =
+        gtk_list_box_get_row_at_index( getList( widget ), index );
+    return row && gtk_list_box_row_is_selected( row );
+}
@@ -0,0 +1,82 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+// Listbox is implemented by elements using a list box.
+type Listbox interface {
+	WidgetWithFocus
+	OnChange()
+	OnActivate(index int)
+}
+
+//export onListboxChange
+func onListboxChange(handle unsafe.Pointer) {
+	widgets[uintptr(handle)].(Listbox).OnChange()
+}
+
+//export onListboxActivate
+func onListboxActivate(handle unsafe.Pointer, index C.int) {
+	widgets[uintptr(handle)].(Listbox).OnActivate(int(index))
+}
+
+func toCInts(values []int) (*C.int, C.unsigned) {
+	if len(values) == 0 {
+		return nil, 0
+	}
+
+	// Use memory allocated by C, so that it is safe to pass the pointer.
+	ptr := (*C.int)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof(C.int(0)))))
+	slice := (*[1 << 28]C.int)(unsafe.Pointer(ptr))[:len(values):len(values)]
+	for i, v := range values {
+		slice[i] = C.int(v)
+	}
+	return ptr, C.unsigned(len(values))
+}
+
+// The parameter items should contain all of the items, each terminated by a
+// nul character, with an additional nul character at the end.
+
+func MountListbox(parent uintptr, items string, selected []int, multiple, disabled, onchange, onactivate, onfocus, onblur bool) uintptr {
+	citems := C.CString(items)
+	defer C.free(unsafe.Pointer(citems))
+	cselected, count := toCInts(selected)
+	defer C.free(unsafe.Pointer(cselected))
+
+	return uintptr(C.mountListbox(unsafe.Pointer(parent), citems, cselected, count,
+		C.bool(multiple), C.bool(disabled),
+		C.bool(onchange), C.bool(onactivate), C.bool(onfocus), C.bool(onblur)))
+}
+
+func ListboxUpdate(handle uintptr, items string, selected []int, multiple, disabled, onchange, onactivate, onfocus, onblur bool) {
+	citems := C.CString(items)
+	defer C.free(unsafe.Pointer(citems))
+	cselected, count := toCInts(selected)
+	defer C.free(unsafe.Pointer(cselected))
+
+	C.listboxUpdate(unsafe.Pointer(handle), citems, cselected, count,
+		C.bool(multiple), C.bool(disabled),
+		C.bool(onchange), C.bool(onactivate), C.bool(onfocus), C.bool(onblur))
+}
+
+func ListboxList(handle uintptr) uintptr {
+	return uintptr(C.listboxList(unsafe.Pointer(handle)))
+}
+
+func ListboxItemCount(handle uintptr) int {
+	return int(C.listboxItemCount(unsafe.Pointer(handle)))
+}
+
+func ListboxItem(handle uintptr, index int) string {
+	return C.GoString(C.listboxItem(unsafe.Pointer(handle), C.unsigned(index)))
+}
+
+func ListboxMultiple(handle uintptr) bool {
+	return bool(C.listboxMultiple(unsafe.Pointer(handle)))
+}
+
+func ListboxIsSelected(handle uintptr, index int) bool {
+	return bool(C.listboxIsSelected(unsafe.Pointer(handle), C.unsigned(index)))
+}
@@ -0,0 +1,111 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include <stdlib.h>  // for malloc
+#include <string.h>  // for strlen
+#include "_cgo_export.h"
+#include "thunks.h"
+
+#define NOTIFY_NAME "org.freedesktop.Notifications"
+#define NOTIFY_PATH "/org/freedesktop/Notifications"
+
+static GDBusConnection *notifyConnection = NULL;
+
+static void onnotifysignal_cb( GDBusConnection *connection,
+                               gchar const *sender, gchar const *path,
+                               gchar const *interface, gchar const *signal,
+                               GVariant *parameters, gpointer data )
+{
+    guint32 id;
+
+    if ( strcmp( signal, "ActionInvoked" ) == 0 ) {
+        gchar const *key;
+        g_variant_get( parameters, "(u&s)", &id, &key );
+        onNotifyAction( id, (char *)key );
+    } else if ( strcmp( signal, "NotificationClosed" ) == 0 ) {
+        guint32 reason;
+        g_variant_get( parameters, "(uu)", &id, &reason );
+        onNotifyClosed( id );
+    }
+}
+
+static char *copyError( GError *err )
+{
+    size_t len = strlen( err->message ) + 1;
+    char *retval = malloc( len );
+    assert( retval );
+    memcpy( retval, err->message, len );
+    g_error_free( err );
+    return retval;
+}
+
+static GDBusConnection *getNotifyConnection( char **error )
+{
+    if ( notifyConnection ) {
+        return notifyConnection;
+    }
+
+    GError *err = NULL;
+    notifyConnection = g_bus_get_sync( G_BUS_TYPE_SESSION, NULL, &err );
+    if ( !notifyConnection ) {
+        *error = copyError( err );
+        return NULL;
+    }
+
+    // Signals will be delivered using the main context of the GUI thread.
+    g_dbus_connection_signal_subscribe(
+        notifyConnection, NOTIFY_NAME, NOTIFY_NAME, NULL, NOTIFY_PATH, NULL,
+        G_DBUS_SIGNAL_FLAGS_NONE, onnotifysignal_cb, NULL, NULL );
+    return notifyConnection;
+}
+
+unsigned notifySend( char const *title, char const *body,
+                     char const *actions, unsigned char const *data,
+                     int width, int height, int rowStride, char **error )
+{
+    assert( title );
+    assert( body );
+    assert( actions );
+    assert( error );
+
+    GDBusConnection *connection = getNotifyConnection( error );
+    if ( !connection ) {
+        return 0;
+    }
+
+    // The actions are pairs of keys and labels, each terminated by a nul
+    // character, with an additional nul character at the end of the list.
+    GVariantBuilder actionsBuilder;
+    g_variant_builder_init( &actionsBuilder, G_VARIANT_TYPE( "as" ) );
+    while ( *actions ) {
+        g_variant_builder_add( &actionsBuilder, "s", actions );
+        actions += strlen( actions ) + 1;
+    }
+
+    GVariantBuilder hints;
+    g_variant_builder_init( &hints, G_VARIANT_TYPE( "a{sv}" ) );
+    if ( data ) {
+        GVariant *pixels = g_variant_new_fixed_array(
+            G_VARIANT_TYPE_BYTE, data, rowStride * height, 1 );
+        g_variant_builder_add( &hints, "{sv}", "image-data",
+                               g_variant_new( "(iiibii@ay)", width, height,
+                                              rowStride, TRUE, 8, 4,
+                                              pixels ) );
+    }
+
+    gchar const *appName = g_get_prgname();
+    GError *err = NULL;
+    GVariant *rc = g_dbus_connection_call_sync(
+        connection, NOTIFY_NAME, NOTIFY_PATH, NOTIFY_NAME, "Notify",
+        g_variant_new( "(susssasa{sv}i)", appName ? appName : "", 0, "",
+                       title, body, &actionsBuilder, &hints, -1 ),
+        G_VARIANT_TYPE( "(u)" ), G_DBUS_CALL_FLAGS_NONE, -1, NULL, &err );
+    if ( !rc ) {
+        *error = copyError( err );
+        return 0;
+    }
+
+    guint32 id;
+    g_variant_get( rc, "(u)", &id );
+    g_variant_unref( rc );
+    return id;
+}
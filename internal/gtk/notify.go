@@ -0,0 +1,61 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import (
+	"errors"
+	"image"
+	"unsafe"
+)
+
+// Callbacks for signals from the notification service.  The callbacks are
+// called on the GUI thread.
+var (
+	OnNotifyAction func(id uint32, key string)
+	OnNotifyClosed func(id uint32)
+)
+
+//export onNotifyAction
+func onNotifyAction(id C.uint, key *C.char) {
+	if OnNotifyAction != nil {
+		OnNotifyAction(uint32(id), C.GoString(key))
+	}
+}
+
+//export onNotifyClosed
+func onNotifyClosed(id C.uint) {
+	if OnNotifyClosed != nil {
+		OnNotifyClosed(uint32(id))
+	}
+}
+
+// NotifySend sends a notification to the freedesktop notification service.
+// The parameter actions should contain pairs of keys and labels, each
+// terminated by a nul character, with an additional nul character at the
+// end.  The icon is optional.  The return value is the ID of the
+// notification.
+func NotifySend(title, body, actions string, icon *image.RGBA) (uint32, error) {
+	ctitle, cbody, cactions := C.CString(title), C.CString(body), C.CString(actions)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+		C.free(unsafe.Pointer(cbody))
+		C.free(unsafe.Pointer(cactions))
+	}()
+
+	var data *C.uchar
+	var width, height, stride C.int
+	if icon != nil {
+		data = (*C.uchar)(unsafe.Pointer(&icon.Pix[0]))
+		width, height = C.int(icon.Rect.Dx()), C.int(icon.Rect.Dy())
+		stride = C.int(icon.Stride)
+	}
+
+	var cerr *C.char
+	id := C.notifySend(ctitle, cbody, cactions, data, width, height, stride, &cerr)
+	if cerr != nil {
+		defer C.free(unsafe.Pointer(cerr))
+		return 0, errors.New(C.GoString(cerr))
+	}
+	return uint32(id), nil
+}
@@ -0,0 +1,215 @@
+#include <assert.h>
+#include <float.h>   // for DBL_MAX_10_EXP
+#include <gtk/gtk.h>
+#include <string.h>  // for strlen, strncmp
+#include "_cgo_export.h"
+#include "callback.h"
+#include "thunks.h"
+
+#define PREFIX_KEY "goey-prefix"
+#define SUFFIX_KEY "goey-suffix"
+
+static char const *getString( GtkSpinButton *widget, char const *key )
+{
+    char const *text = g_object_get_data( G_OBJECT( widget ), key );
+    return text ? text : "";
+}
+
+static gboolean output_cb( GtkSpinButton *widget, gpointer user_data )
+{
+    assert( widget );
+
+    // The number is formatted independently of the locale, so that it can be
+    // parsed by input_cb.  The buffer is large enough for any double printed
+    // with the maximum of 20 digits supported by the spin button.
+    gchar format[16];
+    g_snprintf( format, sizeof( format ), "%%.%uf",
+                gtk_spin_button_get_digits( widget ) );
+    gchar number[G_ASCII_DTOSTR_BUF_SIZE + DBL_MAX_10_EXP];
+    g_ascii_formatd( number, sizeof( number ), format,
+                     gtk_spin_button_get_value( widget ) );
+
+    gchar *text = g_strconcat( getString( widget, PREFIX_KEY ), number,
+                               getString( widget, SUFFIX_KEY ), NULL );
+    if ( strcmp( text, gtk_entry_get_text( GTK_ENTRY( widget ) ) ) != 0 ) {
+        gtk_entry_set_text( GTK_ENTRY( widget ), text );
+    }
+    g_free( text );
+    return TRUE;
+}
+
+static gint input_cb( GtkSpinButton *widget, gdouble *new_value,
+                      gpointer user_data )
+{
+    assert( widget );
+    assert( new_value );
+
+    gchar *text = g_strdup( gtk_entry_get_text( GTK_ENTRY( widget ) ) );
+    gchar *start = g_strstrip( text );
+
+    // Users do not need to type the prefix or suffix.
+    gchar *prefix = g_strstrip( g_strdup( getString( widget, PREFIX_KEY ) ) );
+    size_t len = strlen( prefix );
+    if ( len > 0 && strncmp( start, prefix, len ) == 0 ) {
+        start += len;
+    }
+    g_free( prefix );
+    gchar *suffix = g_strstrip( g_strdup( getString( widget, SUFFIX_KEY ) ) );
+    len = strlen( suffix );
+    if ( len > 0 && g_str_has_suffix( start, suffix ) ) {
+        start[strlen( start ) - len] = 0;
+    }
+    g_free( suffix );
+
+    gchar *end = NULL;
+    double value = g_ascii_strtod( start, &end );
+    gint retval = GTK_INPUT_ERROR;
+    if ( end != start ) {
+        while ( g_ascii_isspace( *end ) ) {
+            ++end;
+        }
+        if ( *end == 0 ) {
+            *new_value = value;
+            retval = TRUE;
+        }
+    }
+
+    g_free( text );
+    return retval;
+}
+
+static void onchange_cb( GtkSpinButton *widget, gpointer user_data )
+{
+    assert( widget );
+    onChangeFloat64( widget, gtk_spin_button_get_value( widget ) );
+}
+
+static void onactivate_cb( GtkEntry *entry, gpointer user_data )
+{
+    assert( entry );
+    gtk_spin_button_update( GTK_SPIN_BUTTON( entry ) );
+    onEnterKeyFloat64( entry,
+                       gtk_spin_button_get_value( GTK_SPIN_BUTTON( entry ) ) );
+}
+
+static void setSignals( GtkSpinButton *widget, bool onchange, bool onfocus,
+                        bool onblur, bool onenterkey )
+{
+    if ( onchange ) {
+        g_signal_connect( widget, "value-changed", G_CALLBACK( onchange_cb ),
+                          NULL );
+    }
+    if ( onfocus ) {
+        g_signal_connect( widget, "focus-in-event", G_CALLBACK( onfocus_cb ),
+                          NULL );
+    }
+    if ( onblur ) {
+        g_signal_connect( widget, "focus-out-event", G_CALLBACK( onblur_cb ),
+                          NULL );
+    }
+    if ( onenterkey ) {
+        g_signal_connect( widget, "activate", G_CALLBACK( onactivate_cb ),
+                          NULL );
+    }
+}
+
+static void setProperties( GtkSpinButton *widget, double value,
+                           char const *placeholder, bool disabled, double min,
+                           double max, double step, unsigned precision,
+                           char const *prefix, char const *suffix )
+{
+    g_object_set_data_full( G_OBJECT( widget ), PREFIX_KEY, g_strdup( prefix ),
+                            g_free );
+    g_object_set_data_full( G_OBJECT( widget ), SUFFIX_KEY, g_strdup( suffix ),
+                            g_free );
+    gtk_spin_button_set_digits( widget, precision );
+    gtk_spin_button_set_range( widget, min, max );
+    gtk_spin_button_set_increments( widget, step, step * 10 );
+    gtk_spin_button_set_value( widget, value );
+    gtk_entry_set_placeholder_text( GTK_ENTRY( widget ), placeholder );
+    gtk_widget_set_sensitive( GTK_WIDGET( widget ), !disabled );
+
+    // The text is only updated by the spin button when the value changes.
+    output_cb( widget, NULL );
+}
+
+void *mountNumberInput( void *parent, double value, char const *placeholder,
+                        bool disabled, double min, double max, double step,
+                        unsigned precision, char const *prefix,
+                        char const *suffix, bool onchange, bool onfocus,
+                        bool onblur, bool onenterkey )
+{
+    assert( parent );
+
+    GtkWidget *widget = gtk_spin_button_new_with_range( min, max, step );
+    assert( widget );
+    gtk_spin_button_set_numeric( GTK_SPIN_BUTTON( widget ), FALSE );
+    g_signal_connect( widget, "output", G_CALLBACK( output_cb ), NULL );
+    g_signal_connect( widget, "input", G_CALLBACK( input_cb ), NULL );
+    setProperties( GTK_SPIN_BUTTON( widget ), value, placeholder, disabled,
+                   min, max, step, precision, prefix, suffix );
+
+    g_signal_connect( widget, "destroy", G_CALLBACK( ondestroy_cb ), NULL );
+    setSignals( GTK_SPIN_BUTTON( widget ), onchange, onfocus, onblur,
+                onenterkey );
+
+    gtk_container_add( GTK_CONTAINER( parent ), widget );
+    gtk_widget_show( widget );
+
+    return widget;
+}
+
+void numberInputUpdate( void *widget, double value, char const *placeholder,
+                        bool disabled, double min, double max, double step,
+                        unsigned precision, char const *prefix,
+                        char const *suffix, bool onchange, bool onfocus,
+                        bool onblur, bool onenterkey )
+{
+    assert( widget );
+
+    g_signal_handlers_disconnect_by_func( widget, G_CALLBACK( onchange_cb ),
+                                          NULL );
+    g_signal_handlers_disconnect_by_func( widget, G_CALLBACK( onfocus_cb ),
+                                          NULL );
+    g_signal_handlers_disconnect_by_func( widget, G_CALLBACK( onblur_cb ),
+                                          NULL );
+    g_signal_handlers_disconnect_by_func( widget, G_CALLBACK( onactivate_cb ),
+                                          NULL );
+
+    setProperties( GTK_SPIN_BUTTON( widget ), value, placeholder, disabled,
+                   min, max, step, precision, prefix, suffix );
+    setSignals( GTK_SPIN_BUTTON( widget ), onchange, onfocus, onblur,
+                onenterkey );
+}
+
+double numberInputValue( void *widget )
+{
+    assert( widget );
+    return gtk_spin_button_get_value( GTK_SPIN_BUTTON( widget ) );
+}
+
+double numberInputStep( void *widget )
+{
+    assert( widget );
+    double step, page;
+    gtk_spin_button_get_increments( GTK_SPIN_BUTTON( widget ), &step, &page );
+    return step;
+}
+
+unsigned numberInputPrecision( void *widget )
+{
+    assert( widget );
+    return gtk_spin_button_get_digits( GTK_SPIN_BUTTON( widget ) );
+}
+
+char const *numberInputPrefix( void *widget )
+{
+    assert( widget );
+    return getString( GTK_SPIN_BUTTON( widget ), PREFIX_KEY );
+}
+
+char const *numberInputSuffix( void *widget )
+{
+    assert( widget );
+    return getString( GTK_SPIN_BUTTON( widget ), SUFFIX_KEY );
+}
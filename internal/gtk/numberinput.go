@@ -0,0 +1,68 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+type NumberInput interface {
+	WidgetWithFocus
+	OnChange(value float64)
+	OnEnterKey(value float64)
+}
+
+//export onChangeFloat64
+func onChangeFloat64(handle unsafe.Pointer, value float64) {
+	widgets[uintptr(handle)].(NumberInput).OnChange(value)
+}
+
+//export onEnterKeyFloat64
+func onEnterKeyFloat64(handle unsafe.Pointer, value float64) {
+	widgets[uintptr(handle)].(NumberInput).OnEnterKey(value)
+}
+
+func MountNumberInput(parent uintptr, value float64, placeholder string, disabled bool, min, max, step float64, precision uint, prefix, suffix string, onchange, onfocus, onblur, onenterkey bool) uintptr {
+	cplaceholder, cprefix, csuffix := C.CString(placeholder), C.CString(prefix), C.CString(suffix)
+	defer func() {
+		C.free(unsafe.Pointer(cplaceholder))
+		C.free(unsafe.Pointer(cprefix))
+		C.free(unsafe.Pointer(csuffix))
+	}()
+
+	return uintptr(C.mountNumberInput(unsafe.Pointer(parent), C.double(value), cplaceholder, C.bool(disabled),
+		C.double(min), C.double(max), C.double(step), C.unsigned(precision), cprefix, csuffix,
+		C.bool(onchange), C.bool(onfocus), C.bool(onblur), C.bool(onenterkey)))
+}
+
+func NumberInputUpdate(handle uintptr, value float64, placeholder string, disabled bool, min, max, step float64, precision uint, prefix, suffix string, onchange, onfocus, onblur, onenterkey bool) {
+	cplaceholder, cprefix, csuffix := C.CString(placeholder), C.CString(prefix), C.CString(suffix)
+	defer func() {
+		C.free(unsafe.Pointer(cplaceholder))
+		C.free(unsafe.Pointer(cprefix))
+		C.free(unsafe.Pointer(csuffix))
+	}()
+
+	C.numberInputUpdate(unsafe.Pointer(handle), C.double(value), cplaceholder, C.bool(disabled),
+		C.double(min), C.double(max), C.double(step), C.unsigned(precision), cprefix, csuffix,
+		C.bool(onchange), C.bool(onfocus), C.bool(onblur), C.bool(onenterkey))
+}
+
+func NumberInputValue(handle uintptr) float64 {
+	return float64(C.numberInputValue(unsafe.Pointer(handle)))
+}
+
+func NumberInputStep(handle uintptr) float64 {
+	return float64(C.numberInputStep(unsafe.Pointer(handle)))
+}
+
+func NumberInputPrecision(handle uintptr) uint {
+	return uint(C.numberInputPrecision(unsafe.Pointer(handle)))
+}
+
+func NumberInputPrefix(handle uintptr) string {
+	return C.GoString(C.numberInputPrefix(unsafe.Pointer(handle)))
+}
+
+func NumberInputSuffix(handle uintptr) string {
+	return C.GoString(C.numberInputSuffix(unsafe.Pointer(handle)))
+}
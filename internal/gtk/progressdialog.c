@@ -0,0 +1,71 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include "_cgo_export.h"
+#include "thunks.h"
+
+static void onresponse_progress_cb( GtkDialog *dialog, gint response,
+                                    gpointer data )
+{
+    // The only button is Cancel.  Disable the button, as the dialog remains
+    // open until the operation completes.
+    GtkWidget *button = gtk_dialog_get_widget_for_response( dialog, 0 );
+    if ( button ) {
+        gtk_widget_set_sensitive( button, FALSE );
+    }
+    onProgressCancel( dialog );
+}
+
+static gboolean ondelete_progress_cb( GtkWidget *widget, GdkEvent *event,
+                                      gpointer data )
+{
+    // Closing the dialog is treated as a request to cancel.
+    onresponse_progress_cb( GTK_DIALOG( widget ), 0, data );
+    return TRUE;
+}
+
+void *mountProgressDialog( void *window, char const *title, char const *text )
+{
+    GtkWidget *dialog = gtk_dialog_new_with_buttons(
+        title, GTK_WINDOW( window ),
+        GTK_DIALOG_MODAL | GTK_DIALOG_DESTROY_WITH_PARENT, "_Cancel", 0,
+        NULL );
+    assert( dialog );
+    gtk_window_set_default_size( GTK_WINDOW( dialog ), 360, -1 );
+
+    GtkWidget *content = gtk_dialog_get_content_area( GTK_DIALOG( dialog ) );
+    gtk_container_set_border_width( GTK_CONTAINER( content ), 11 );
+    gtk_box_set_spacing( GTK_BOX( content ), 6 );
+
+    GtkWidget *label = gtk_label_new( text );
+    gtk_label_set_ellipsize( GTK_LABEL( label ), PANGO_ELLIPSIZE_END );
+    gtk_label_set_xalign( GTK_LABEL( label ), 0 );
+    gtk_container_add( GTK_CONTAINER( content ), label );
+
+    GtkWidget *bar = gtk_progress_bar_new();
+    gtk_container_add( GTK_CONTAINER( content ), bar );
+
+    g_object_set_data( G_OBJECT( dialog ), "goey-label", label );
+    g_object_set_data( G_OBJECT( dialog ), "goey-bar", bar );
+
+    g_signal_connect( dialog, "response", G_CALLBACK( onresponse_progress_cb ),
+                      NULL );
+    g_signal_connect( dialog, "delete-event",
+                      G_CALLBACK( ondelete_progress_cb ), NULL );
+
+    gtk_widget_show_all( dialog );
+    return dialog;
+}
+
+void progressDialogSetText( void *dialog, char const *text )
+{
+    GtkWidget *label = g_object_get_data( G_OBJECT( dialog ), "goey-label" );
+    assert( label );
+    gtk_label_set_text( GTK_LABEL( label ), text );
+}
+
+void progressDialogSetFraction( void *dialog, double fraction )
+{
+    GtkWidget *bar = g_object_get_data( G_OBJECT( dialog ), "goey-bar" );
+    assert( bar );
+    gtk_progress_bar_set_fraction( GTK_PROGRESS_BAR( bar ), fraction );
+}
@@ -0,0 +1,48 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+var (
+	progressDialogs = make(map[uintptr]func())
+)
+
+//export onProgressCancel
+func onProgressCancel(handle unsafe.Pointer) {
+	if cb, ok := progressDialogs[uintptr(handle)]; ok {
+		cb()
+	}
+}
+
+// MountProgressDialog creates and shows a progress dialog.  The callback
+// oncancel is called when the user selects the Cancel button, or tries to
+// close the dialog.
+func MountProgressDialog(parent uintptr, title, text string, oncancel func()) uintptr {
+	ctitle, ctext := C.CString(title), C.CString(text)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+		C.free(unsafe.Pointer(ctext))
+	}()
+
+	handle := uintptr(C.mountProgressDialog(unsafe.Pointer(parent), ctitle, ctext))
+	progressDialogs[handle] = oncancel
+	return handle
+}
+
+func ProgressDialogClose(handle uintptr) {
+	delete(progressDialogs, handle)
+	WidgetClose(handle)
+}
+
+func ProgressDialogSetText(handle uintptr, text string) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	C.progressDialogSetText(unsafe.Pointer(handle), ctext)
+}
+
+func ProgressDialogSetFraction(handle uintptr, fraction float64) {
+	C.progressDialogSetFraction(unsafe.Pointer(handle), C.double(fraction))
+}
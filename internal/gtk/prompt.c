@@ -0,0 +1,58 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include "thunks.h"
+
+void *mountPromptDialog( void *window, char const *title, char const *text,
+                         char const *value, bool password )
+{
+    GtkWidget *dialog = gtk_dialog_new_with_buttons(
+        title, GTK_WINDOW( window ), GTK_DIALOG_MODAL, "_Cancel",
+        GTK_RESPONSE_CANCEL, "_OK", GTK_RESPONSE_ACCEPT, NULL );
+    assert( dialog );
+    gtk_dialog_set_default_response( GTK_DIALOG( dialog ),
+                                     GTK_RESPONSE_ACCEPT );
+
+    GtkWidget *content = gtk_dialog_get_content_area( GTK_DIALOG( dialog ) );
+    gtk_container_set_border_width( GTK_CONTAINER( content ), 11 );
+    gtk_box_set_spacing( GTK_BOX( content ), 6 );
+
+    GtkWidget *label = gtk_label_new( text );
+    gtk_label_set_line_wrap( GTK_LABEL( label ), TRUE );
+    gtk_label_set_xalign( GTK_LABEL( label ), 0 );
+    gtk_container_add( GTK_CONTAINER( content ), label );
+
+    GtkWidget *entry = gtk_entry_new();
+    gtk_entry_set_text( GTK_ENTRY( entry ), value );
+    gtk_entry_set_visibility( GTK_ENTRY( entry ), !password );
+    gtk_entry_set_activates_default( GTK_ENTRY( entry ), TRUE );
+    gtk_container_add( GTK_CONTAINER( content ), entry );
+
+    GtkWidget *error = gtk_label_new( NULL );
+    gtk_label_set_line_wrap( GTK_LABEL( error ), TRUE );
+    gtk_label_set_xalign( GTK_LABEL( error ), 0 );
+    gtk_container_add( GTK_CONTAINER( content ), error );
+
+    g_object_set_data( G_OBJECT( dialog ), "goey-entry", entry );
+    g_object_set_data( G_OBJECT( dialog ), "goey-error", error );
+
+    gtk_widget_show_all( content );
+    gtk_widget_hide( error );
+    gtk_widget_grab_focus( entry );
+
+    return dialog;
+}
+
+char const *promptDialogText( void *dialog )
+{
+    GtkEntry *entry = g_object_get_data( G_OBJECT( dialog ), "goey-entry" );
+    assert( entry );
+    return gtk_entry_get_text( entry );
+}
+
+void promptDialogSetError( void *dialog, char const *text )
+{
+    GtkWidget *error = g_object_get_data( G_OBJECT( dialog ), "goey-error" );
+    assert( error );
+    gtk_label_set_text( GTK_LABEL( error ), text );
+    gtk_widget_show( error );
+}
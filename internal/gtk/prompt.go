@@ -0,0 +1,28 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+func MountPromptDialog(parent uintptr, title, text, value string, password bool) uintptr {
+	ctitle, ctext, cvalue := C.CString(title), C.CString(text), C.CString(value)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+		C.free(unsafe.Pointer(ctext))
+		C.free(unsafe.Pointer(cvalue))
+	}()
+
+	return uintptr(C.mountPromptDialog(unsafe.Pointer(parent), ctitle, ctext, cvalue, C.bool(password)))
+}
+
+func PromptDialogText(dialog uintptr) string {
+	return C.GoString(C.promptDialogText(unsafe.Pointer(dialog)))
+}
+
+func PromptDialogSetError(dialog uintptr, text string) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	C.promptDialogSetError(unsafe.Pointer(dialog), ctext)
+}
@@ -0,0 +1,36 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include <string.h>  // for strlen
+#include "thunks.h"
+
+void *mountQuestionDialog( void *window, char const *title, char const *text,
+                           char const *labels, int defaultButton )
+{
+    GtkWidget *dialog =
+        gtk_message_dialog_new( GTK_WINDOW( window ), GTK_DIALOG_MODAL,
+                                GTK_MESSAGE_QUESTION, GTK_BUTTONS_NONE, "%s",
+                                text );
+    assert( dialog );
+    gtk_window_set_title( GTK_WINDOW( dialog ), title );
+
+    // Each button uses its index as the response ID.  The labels are
+    // terminated by a nul character, with an additional nul character at
+    // the end of the list.
+    int index = 0;
+    while ( *labels ) {
+        gtk_dialog_add_button( GTK_DIALOG( dialog ), labels, index );
+        labels += strlen( labels ) + 1;
+        ++index;
+    }
+    if ( defaultButton >= 0 ) {
+        gtk_dialog_set_default_response( GTK_DIALOG( dialog ),
+                                         defaultButton );
+    }
+
+    return dialog;
+}
+
+void dialogResponse( void *dialog, int response )
+{
+    gtk_dialog_response( GTK_DIALOG( dialog ), response );
+}
@@ -0,0 +1,25 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+// The parameter labels should contain all of the button labels, each
+// terminated by a nul character, with an additional nul character at the end.
+// Each button uses its index as its response ID.
+
+func MountQuestionDialog(parent uintptr, title, text, labels string, defaultButton int) uintptr {
+	ctitle, ctext, clabels := C.CString(title), C.CString(text), C.CString(labels)
+	defer func() {
+		C.free(unsafe.Pointer(ctitle))
+		C.free(unsafe.Pointer(ctext))
+		C.free(unsafe.Pointer(clabels))
+	}()
+
+	return uintptr(C.mountQuestionDialog(unsafe.Pointer(parent), ctitle, ctext, clabels, C.int(defaultButton)))
+}
+
+func DialogResponse(dialog uintptr, response int) {
+	C.dialogResponse(unsafe.Pointer(dialog), C.int(response))
+}
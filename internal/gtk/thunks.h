@@ -8,8 +8,10 @@ extern void widgetClose( void *widget );
 extern bool widgetCanFocus( void *widget );
 extern void widgetGrabFocus( void *widget );
 extern bool widgetIsFocus( void *widget );
+extern bool widgetIsWithin( void *widget, void *ancestor );
 extern void widgetSendKey( void *widget, unsigned key, bool release );
 extern void widgetNaturalSize( void *widget, int *width, int *height );
+extern void widgetBounds( void *widget, int *x, int *y, int *width, int *height );
 extern int widgetMinHeight( void *widget );
 extern int widgetMinHeightForWidth( void *widget, int width );
 extern int widgetNaturalHeight( void *widget );
@@ -30,6 +32,7 @@ typedef struct {
 
 extern void *mountWindow( char const *text );
 extern windowsize_t windowSize( void *window );
+extern void windowDPI( void *window, double *resolution, int *scale );
 extern void *windowScrolledWindow( void *window );
 extern void *windowLayout( void *window );
 extern void windowSetLayoutSize( void *window, unsigned width,
@@ -40,7 +43,20 @@ extern unsigned windowVScrollbarWidth( void *window );
 extern unsigned windowHScrollbarHeight( void *window );
 extern void windowShowScrollbars( void *window, bool horz, bool vert );
 extern void windowShow( void *window );
+extern void windowSetDialog( void *window, void *owner );
+extern void windowSetOptions( void *window, void *owner, bool modal,
+                              bool toolWindow, bool alwaysOnTop );
 extern void windowSetDefaultSize( void *window, int width, int height );
+extern void windowResize( void *window, int width, int height );
+extern void windowPosition( void *window, int *x, int *y );
+extern void windowMove( void *window, int x, int y );
+extern bool windowIsOnScreen( void *window, int x, int y );
+extern void windowSetTabOrder( void *window, void **widgets, size_t n );
+extern void windowSetMaxSize( void *window, int width, int height );
+extern void windowSetState( void *window, int state );
+extern void windowSetCursor( void *window, char const *name );
+extern void windowSetBusy( void *window, bool busy );
+extern int windowState( void *window );
 extern void windowSetIcon( void *window, unsigned char const *data, int width,
                            int height, int rowStride );
 extern void *windowScreenshot( void *window, void **data, size_t *datLen,
@@ -59,11 +75,23 @@ extern unsigned messageDialogWithError( void );
 extern unsigned messageDialogWithWarn( void );
 extern unsigned messageDialogWithInfo( void );
 
+extern void *mountQuestionDialog( void *window, char const *title,
+                                  char const *text, char const *labels,
+                                  int defaultButton );
+extern void dialogResponse( void *dialog, int response );
+
 extern void *mountOpenDialog( void *dialog, char const *title,
                               char const *filename );
 extern void *mountSaveDialog( void *dialog, char const *title,
                               char const *filename );
 extern char const *dialogGetFilename( void *dialog );
+extern void *mountOpenFolderDialog( void *window, char const *title );
+extern void dialogSetFolder( void *dialog, char const *folder );
+extern void dialogSetMultiple( void *dialog, bool multiple );
+extern char *dialogGetFilenames( void *dialog, size_t *length );
+extern int dialogFilterIndex( void *dialog );
+
+extern void *mountGestureArea( void *parent );
 
 extern void *mountButton( void *container, char const *text, bool disabled,
                           bool def, bool onclick, bool onfocus, bool onblur );
@@ -186,5 +214,104 @@ extern void dateInputUpdate( void *widget, int year, unsigned month,
 extern int dateInputYear( void *widget );
 extern unsigned dateInputMonth( void *widget );
 extern unsigned dateInputDay( void *widget );
+extern void dateInputSelect( void *widget, int year, unsigned month,
+                             unsigned day );
+
+#define TIME_SECONDS 1
+#define TIME_HOUR12 2
+#define TIME_DATE 4
+
+extern void *mountTimeInput( void *parent, double value, double min,
+                             double max, unsigned flags, bool disabled,
+                             bool onchange, bool onfocus, bool onblur );
+extern void timeInputUpdate( void *widget, double value, double min,
+                             double max, unsigned flags, bool disabled,
+                             bool onchange, bool onfocus, bool onblur );
+extern double timeInputValue( void *widget );
+extern unsigned timeInputFlags( void *widget );
+
+extern void *mountNumberInput( void *parent, double value,
+                               char const *placeholder, bool disabled,
+                               double min, double max, double step,
+                               unsigned precision, char const *prefix,
+                               char const *suffix, bool onchange, bool onfocus,
+                               bool onblur, bool onenterkey );
+extern void numberInputUpdate( void *widget, double value,
+                               char const *placeholder, bool disabled,
+                               double min, double max, double step,
+                               unsigned precision, char const *prefix,
+                               char const *suffix, bool onchange, bool onfocus,
+                               bool onblur, bool onenterkey );
+extern double numberInputValue( void *widget );
+extern double numberInputStep( void *widget );
+extern unsigned numberInputPrecision( void *widget );
+extern char const *numberInputPrefix( void *widget );
+extern char const *numberInputSuffix( void *widget );
+
+extern void *mountComboInput( void *parent, char const *text,
+                              char const *items, char const *placeholder,
+                              bool disabled, bool onfocus, bool onblur );
+extern void comboInputUpdate( void *widget, char const *text,
+                              char const *items, char const *placeholder,
+                              bool disabled, bool onfocus, bool onblur );
+extern void comboInputSetSuggestions( void *widget, char const *items );
+extern char const *comboInputText( void *widget );
+extern char const *comboInputPlaceholder( void *widget );
+
+extern void *mountListbox( void *parent, char const *items,
+                           int const *selected, unsigned count, bool multiple,
+                           bool disabled, bool onchange, bool onactivate,
+                           bool onfocus, bool onblur );
+extern void listboxUpdate( void *widget, char const *items,
+                           int const *selected, unsigned count, bool multiple,
+                           bool disabled, bool onchange, bool onactivate,
+                           bool onfocus, bool onblur );
+extern void *listboxList( void *widget );
+extern unsigned listboxItemCount( void *widget );
+extern char const *listboxItem( void *widget, unsigned index );
+extern bool listboxMultiple( void *widget );
+extern bool listboxIsSelected( void *widget, unsigned index );
+
+extern void *mountPromptDialog( void *window, char const *title,
+                                char const *text, char const *value,
+                                bool password );
+extern char const *promptDialogText( void *dialog );
+extern void promptDialogSetError( void *dialog, char const *text );
+
+extern void *mountColorChooserDialog( void *window, char const *title,
+                                      double red, double green, double blue,
+                                      double alpha, bool useAlpha );
+extern void colorChooserDialogRGBA( void *dialog, double *rgba );
+extern void *mountFontChooserDialog( void *window, char const *title,
+                                     char const *family, double size,
+                                     bool bold, bool italic );
+extern char *fontChooserDialogFont( void *dialog, double *size, bool *bold,
+                                    bool *italic );
+
+extern unsigned notifySend( char const *title, char const *body,
+                            char const *actions, unsigned char const *data,
+                            int width, int height, int rowStride,
+                            char **error );
+
+extern void *mountTray( unsigned char const *data, int width, int height,
+                        char const *tooltip, char **error );
+extern void trayClose( void *tray );
+extern void traySetIcon( void *tray, unsigned char const *data, int width,
+                         int height );
+extern void traySetTooltip( void *tray, char const *tooltip );
+extern void traySetMenuCount( void *tray, unsigned count );
+extern void traySetMenuItem( void *tray, unsigned index, char const *label,
+                             bool separator, bool disabled );
+extern void trayMenuUpdated( void *tray );
+extern char *trayActivateForTesting( void *tray );
+extern char *trayMenuItemForTesting( void *tray, unsigned index );
+
+extern void *mountProgressDialog( void *window, char const *title,
+                                  char const *text );
+extern void progressDialogSetText( void *dialog, char const *text );
+extern void progressDialogSetFraction( void *dialog, double fraction );
+
+extern void *mountToast( void *layout, char const *text, char const *label );
+extern void toastSetBounds( void *toast, int x, int y, int width, int height );
 
 #endif
\ No newline at end of file
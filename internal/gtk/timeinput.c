@@ -0,0 +1,313 @@
+#include <assert.h>
+#include <gtk/gtk.h>
+#include <stdio.h>   // for sscanf
+#include <string.h>  // for strchr
+#include "_cgo_export.h"
+#include "callback.h"
+#include "thunks.h"
+
+#define TIME_FLAGS_KEY "goey-time-flags"
+#define TIME_POPOVER_KEY "goey-time-popover"
+#define TIME_CALENDAR_ICON "x-office-calendar"
+
+static unsigned getFlags( GtkSpinButton *widget )
+{
+    return GPOINTER_TO_UINT(
+        g_object_get_data( G_OBJECT( widget ), TIME_FLAGS_KEY ) );
+}
+
+static void formatTime( char *buffer, size_t len, gint64 value,
+                        unsigned flags )
+{
+    int year = 0, month = 0, day = 0, hour, minute, second;
+
+    if ( flags & TIME_DATE ) {
+        GDateTime *dt = g_date_time_new_from_unix_local( value );
+        if ( !dt ) {
+            // Value is outside of the range of dates supported by GLib.
+            g_snprintf( buffer, len, "%s", "" );
+            return;
+        }
+        year = g_date_time_get_year( dt );
+        month = g_date_time_get_month( dt );
+        day = g_date_time_get_day_of_month( dt );
+        hour = g_date_time_get_hour( dt );
+        minute = g_date_time_get_minute( dt );
+        second = g_date_time_get_second( dt );
+        g_date_time_unref( dt );
+    } else {
+        hour = value / 3600;
+        minute = ( value / 60 ) % 60;
+        second = value % 60;
+    }
+
+    char const *suffix = "";
+    if ( flags & TIME_HOUR12 ) {
+        suffix = hour < 12 ? " AM" : " PM";
+        hour = hour % 12;
+        if ( hour == 0 ) {
+            hour = 12;
+        }
+    }
+
+    int n = 0;
+    if ( flags & TIME_DATE ) {
+        n = g_snprintf( buffer, len, "%04d-%02d-%02d ", year, month, day );
+    }
+    if ( flags & TIME_SECONDS ) {
+        g_snprintf( buffer + n, len - n, "%02d:%02d:%02d%s", hour, minute,
+                    second, suffix );
+    } else {
+        g_snprintf( buffer + n, len - n, "%02d:%02d%s", hour, minute, suffix );
+    }
+}
+
+static gboolean output_cb( GtkSpinButton *widget, gpointer user_data )
+{
+    assert( widget );
+
+    char buffer[64];
+    formatTime( buffer, sizeof( buffer ),
+                (gint64)gtk_spin_button_get_value( widget ),
+                getFlags( widget ) );
+    if ( strcmp( buffer, gtk_entry_get_text( GTK_ENTRY( widget ) ) ) != 0 ) {
+        gtk_entry_set_text( GTK_ENTRY( widget ), buffer );
+    }
+    return TRUE;
+}
+
+static gint input_cb( GtkSpinButton *widget, gdouble *new_value,
+                      gpointer user_data )
+{
+    assert( widget );
+    assert( new_value );
+
+    unsigned flags = getFlags( widget );
+    char const *text = gtk_entry_get_text( GTK_ENTRY( widget ) );
+    int year = 0, month = 0, day = 0, hour = 0, minute = 0, second = 0;
+
+    if ( flags & TIME_DATE ) {
+        if ( sscanf( text, "%d-%d-%d %d:%d:%d", &year, &month, &day, &hour,
+                     &minute, &second ) < 5 ) {
+            return GTK_INPUT_ERROR;
+        }
+    } else {
+        if ( sscanf( text, "%d:%d:%d", &hour, &minute, &second ) < 2 ) {
+            return GTK_INPUT_ERROR;
+        }
+    }
+    if ( flags & TIME_HOUR12 ) {
+        if ( hour < 1 || hour > 12 ) {
+            return GTK_INPUT_ERROR;
+        }
+        hour = hour % 12;
+        if ( strchr( text, 'P' ) || strchr( text, 'p' ) ) {
+            hour += 12;
+        }
+    }
+    if ( hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 ||
+         second > 59 ) {
+        return GTK_INPUT_ERROR;
+    }
+
+    if ( flags & TIME_DATE ) {
+        GDateTime *dt =
+            g_date_time_new_local( year, month, day, hour, minute, second );
+        if ( !dt ) {
+            return GTK_INPUT_ERROR;
+        }
+        *new_value = g_date_time_to_unix( dt );
+        g_date_time_unref( dt );
+    } else {
+        *new_value = hour * 3600 + minute * 60 + second;
+    }
+    return TRUE;
+}
+
+static void calendar_cb( GtkCalendar *calendar, gpointer user_data )
+{
+    assert( calendar );
+    assert( user_data );
+
+    GtkSpinButton *widget = GTK_SPIN_BUTTON( user_data );
+    guint year, month, day;
+    gtk_calendar_get_date( calendar, &year, &month, &day );
+
+    // Keep the time of day from the current value.
+    GDateTime *dt = g_date_time_new_from_unix_local(
+        (gint64)gtk_spin_button_get_value( widget ) );
+    if ( !dt ) {
+        return;
+    }
+    GDateTime *newdt = g_date_time_new_local(
+        year, month + 1, day, g_date_time_get_hour( dt ),
+        g_date_time_get_minute( dt ), g_date_time_get_second( dt ) );
+    g_date_time_unref( dt );
+    if ( newdt ) {
+        gtk_spin_button_set_value( widget, g_date_time_to_unix( newdt ) );
+        g_date_time_unref( newdt );
+    }
+}
+
+static void calendar_close_cb( GtkCalendar *calendar, gpointer user_data )
+{
+    assert( calendar );
+
+    GtkWidget *popover =
+        gtk_widget_get_ancestor( GTK_WIDGET( calendar ), GTK_TYPE_POPOVER );
+    if ( popover ) {
+        gtk_widget_hide( popover );
+    }
+}
+
+static void icon_press_cb( GtkEntry *entry, GtkEntryIconPosition pos,
+                           GdkEvent *event, gpointer user_data )
+{
+    assert( entry );
+
+    GtkSpinButton *widget = GTK_SPIN_BUTTON( entry );
+    if ( !( getFlags( widget ) & TIME_DATE ) ) {
+        return;
+    }
+
+    // The popover is created on first use, and is destroyed along with the
+    // spin button.
+    GtkWidget *popover = g_object_get_data( G_OBJECT( widget ),
+                                            TIME_POPOVER_KEY );
+    GtkWidget *calendar;
+    if ( !popover ) {
+        popover = gtk_popover_new( GTK_WIDGET( widget ) );
+        calendar = gtk_calendar_new();
+        g_signal_connect( calendar, "day-selected",
+                          G_CALLBACK( calendar_cb ), widget );
+        g_signal_connect( calendar, "day-selected-double-click",
+                          G_CALLBACK( calendar_close_cb ), NULL );
+        gtk_container_add( GTK_CONTAINER( popover ), calendar );
+        gtk_widget_show( calendar );
+        g_object_set_data( G_OBJECT( widget ), TIME_POPOVER_KEY, popover );
+    } else {
+        calendar = gtk_bin_get_child( GTK_BIN( popover ) );
+    }
+
+    // Select the current date, without changing the value.
+    GDateTime *dt = g_date_time_new_from_unix_local(
+        (gint64)gtk_spin_button_get_value( widget ) );
+    if ( dt ) {
+        g_signal_handlers_block_by_func( calendar, G_CALLBACK( calendar_cb ),
+                                         widget );
+        gtk_calendar_select_month( GTK_CALENDAR( calendar ),
+                                   g_date_time_get_month( dt ) - 1,
+                                   g_date_time_get_year( dt ) );
+        gtk_calendar_select_day( GTK_CALENDAR( calendar ),
+                                 g_date_time_get_day_of_month( dt ) );
+        g_signal_handlers_unblock_by_func(
+            calendar, G_CALLBACK( calendar_cb ), widget );
+        g_date_time_unref( dt );
+    }
+
+    GdkRectangle rect;
+    gtk_entry_get_icon_area( entry, pos, &rect );
+    gtk_popover_set_pointing_to( GTK_POPOVER( popover ), &rect );
+    gtk_widget_show( popover );
+}
+
+static void onchange_cb( GtkSpinButton *widget, gpointer user_data )
+{
+    assert( widget );
+    onChangeTimeInput( widget, gtk_spin_button_get_value( widget ) );
+}
+
+static void setSignals( GtkSpinButton *widget, bool onchange, bool onfocus,
+                        bool onblur )
+{
+    if ( onchange ) {
+        g_signal_connect( widget, "value-changed", G_CALLBACK( onchange_cb ),
+                          NULL );
+    }
+    if ( onfocus ) {
+        g_signal_connect( widget, "focus-in-event", G_CALLBACK( onfocus_cb ),
+                          NULL );
+    }
+    if ( onblur ) {
+        g_signal_connect( widget, "focus-out-event", G_CALLBACK( onblur_cb ),
+                          NULL );
+    }
+}
+
+static void setProperties( GtkSpinButton *widget, double value, double min,
+                           double max, unsigned flags, bool disabled )
+{
+    g_object_set_data( G_OBJECT( widget ), TIME_FLAGS_KEY,
+                       GUINT_TO_POINTER( flags ) );
+    gtk_spin_button_set_range( widget, min, max );
+    gtk_spin_button_set_increments( widget, ( flags & TIME_SECONDS ) ? 1 : 60,
+                                    3600 );
+    gtk_spin_button_set_value( widget, value );
+    gtk_entry_set_width_chars( GTK_ENTRY( widget ),
+                               ( ( flags & TIME_DATE ) ? 11 : 0 ) +
+                                   ( ( flags & TIME_SECONDS ) ? 8 : 5 ) +
+                                   ( ( flags & TIME_HOUR12 ) ? 3 : 0 ) );
+    gtk_widget_set_sensitive( GTK_WIDGET( widget ), !disabled );
+    // Dates can be picked from a calendar, opened using an icon.
+    gtk_entry_set_icon_from_icon_name(
+        GTK_ENTRY( widget ), GTK_ENTRY_ICON_PRIMARY,
+        ( flags & TIME_DATE ) ? TIME_CALENDAR_ICON : NULL );
+
+    // The text is only updated by the spin button when the value changes.
+    output_cb( widget, NULL );
+}
+
+void *mountTimeInput( void *parent, double value, double min, double max,
+                      unsigned flags, bool disabled, bool onchange,
+                      bool onfocus, bool onblur )
+{
+    assert( parent );
+
+    GtkWidget *widget = gtk_spin_button_new_with_range( min, max, 1 );
+    assert( widget );
+    gtk_spin_button_set_numeric( GTK_SPIN_BUTTON( widget ), FALSE );
+    g_signal_connect( widget, "output", G_CALLBACK( output_cb ), NULL );
+    g_signal_connect( widget, "input", G_CALLBACK( input_cb ), NULL );
+    g_signal_connect( widget, "icon-press", G_CALLBACK( icon_press_cb ),
+                      NULL );
+    setProperties( GTK_SPIN_BUTTON( widget ), value, min, max, flags,
+                   disabled );
+
+    g_signal_connect( widget, "destroy", G_CALLBACK( ondestroy_cb ), NULL );
+    setSignals( GTK_SPIN_BUTTON( widget ), onchange, onfocus, onblur );
+
+    gtk_container_add( GTK_CONTAINER( parent ), widget );
+    gtk_widget_show( widget );
+
+    return widget;
+}
+
+void timeInputUpdate( void *widget, double value, double min, double max,
+                      unsigned flags, bool disabled, bool onchange,
+                      bool onfocus, bool onblur )
+{
+    assert( widget );
+
+    g_signal_handlers_disconnect_by_func( widget, G_CALLBACK( onchange_cb ),
+                                          NULL );
+    g_signal_handlers_disconnect_by_func( widget, G_CALLBACK( onfocus_cb ),
+                                          NULL );
+    g_signal_handlers_disconnect_by_func( widget, G_CALLBACK( onblur_cb ),
+                                          NULL );
+
+    setProperties( GTK_SPIN_BUTTON( widget ), value, min, max, flags,
+                   disabled );
+    setSignals( GTK_SPIN_BUTTON( widget ), onchange, onfocus, onblur );
+}
+
+double timeInputValue( void *widget )
+{
+    assert( widget );
+    return gtk_spin_button_get_value( GTK_SPIN_BUTTON( widget ) );
+}
+
+unsigned timeInputFlags( void *widget )
+{
+    assert( widget );
+    return getFlags( GTK_SPIN_BUTTON( widget ) );
+}
@@ -0,0 +1,43 @@
+package gtk
+
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+// Flags to control the format of a time input.
+const (
+	TimeSeconds = C.TIME_SECONDS
+	TimeHour12  = C.TIME_HOUR12
+	TimeDate    = C.TIME_DATE
+)
+
+// TimeInput is implemented by elements using a time input.  The value is the
+// number of seconds since midnight or, if the flag TimeDate is set, since the
+// Unix epoch.
+type TimeInput interface {
+	WidgetWithFocus
+	OnChange(value int64)
+}
+
+//export onChangeTimeInput
+func onChangeTimeInput(handle unsafe.Pointer, value float64) {
+	widgets[uintptr(handle)].(TimeInput).OnChange(int64(value))
+}
+
+func MountTimeInput(parent uintptr, value, min, max float64, flags uint, disabled, onchange, onfocus, onblur bool) uintptr {
+	return uintptr(C.mountTimeInput(unsafe.Pointer(parent), C.double(value), C.double(min), C.double(max),
+		C.unsigned(flags), C.bool(disabled), C.bool(onchange), C.bool(onfocus), C.bool(onblur)))
+}
+
+func TimeInputUpdate(handle uintptr, value, min, max float64, flags uint, disabled, onchange, onfocus, onblur bool) {
+	C.timeInputUpdate(unsafe.Pointer(handle), C.double(value), C.double(min), C.double(max),
+		C.unsigned(flags), C.bool(disabled), C.bool(onchange), C.bool(onfocus), C.bool(onblur))
+}
+
+func TimeInputValue(handle uintptr) int64 {
+	return int64(C.timeInputValue(unsafe.Pointer(handle)))
+}
+
+func TimeInputFlags(handle uintptr) uint {
+	return uint(C.timeInputFlags(unsafe.Pointer(handle)))
+}
@@ -0,0 +1,60 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include "_cgo_export.h"
+#include "thunks.h"
+
+static void onclicked_toast_cb( GtkButton *button, gpointer toast )
+{
+    onToastClick( toast );
+}
+
+void *mountToast( void *layout, char const *text, char const *label )
+{
+    assert( layout && GTK_IS_LAYOUT( layout ) );
+    assert( text );
+
+    // The style class app-notification is provided by the theme for
+    // in-app notifications.
+    GtkWidget *toast = gtk_frame_new( NULL );
+    gtk_style_context_add_class( gtk_widget_get_style_context( toast ),
+                                 "app-notification" );
+
+    GtkWidget *box = gtk_box_new( GTK_ORIENTATION_HORIZONTAL, 12 );
+    gtk_container_set_border_width( GTK_CONTAINER( box ), 6 );
+    gtk_container_add( GTK_CONTAINER( toast ), box );
+
+    GtkWidget *widget = gtk_label_new( text );
+    gtk_label_set_ellipsize( GTK_LABEL( widget ), PANGO_ELLIPSIZE_END );
+    gtk_label_set_xalign( GTK_LABEL( widget ), 0 );
+    gtk_box_pack_start( GTK_BOX( box ), widget, TRUE, TRUE, 0 );
+
+    if ( label && *label ) {
+        widget = gtk_button_new_with_label( label );
+        gtk_box_pack_end( GTK_BOX( box ), widget, FALSE, FALSE, 0 );
+        g_signal_connect( widget, "clicked", G_CALLBACK( onclicked_toast_cb ),
+                          toast );
+    }
+
+    gtk_layout_put( GTK_LAYOUT( layout ), toast, 0, 0 );
+    gtk_widget_show_all( toast );
+    return toast;
+}
+
+void toastSetBounds( void *toast, int x, int y, int width, int height )
+{
+    assert( toast && GTK_IS_FRAME( toast ) );
+
+    // The bounds are relative to the visible area of the layout, but the
+    // position of children depends on the scroll position.
+    GtkWidget *layout = gtk_widget_get_parent( toast );
+    GtkAdjustment *adj = gtk_scrollable_get_hadjustment( GTK_SCROLLABLE( layout ) );
+    if ( adj ) {
+        x += (int)gtk_adjustment_get_value( adj );
+    }
+    adj = gtk_scrollable_get_vadjustment( GTK_SCROLLABLE( layout ) );
+    if ( adj ) {
+        y += (int)gtk_adjustment_get_value( adj );
+    }
+
+    widgetSetBounds( toast, x, y, width, height );
+}
@@ -0,0 +1,43 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import "unsafe"
+
+var (
+	toasts = make(map[uintptr]func())
+)
+
+//export onToastClick
+func onToastClick(handle unsafe.Pointer) {
+	if cb, ok := toasts[uintptr(handle)]; ok {
+		cb()
+	}
+}
+
+// MountToast creates a toast as a child of the layout.  If label is not
+// empty, the toast includes a button, and the callback onclick is called when
+// the button is clicked.
+func MountToast(layout uintptr, text, label string, onclick func()) uintptr {
+	ctext, clabel := C.CString(text), C.CString(label)
+	defer func() {
+		C.free(unsafe.Pointer(ctext))
+		C.free(unsafe.Pointer(clabel))
+	}()
+
+	handle := uintptr(C.mountToast(unsafe.Pointer(layout), ctext, clabel))
+	toasts[handle] = onclick
+	return handle
+}
+
+func ToastClose(handle uintptr) {
+	delete(toasts, handle)
+	WidgetClose(handle)
+}
+
+// ToastSetBounds positions the toast.  The bounds are relative to the visible
+// area of the layout, and not to the layout's scrolled contents.
+func ToastSetBounds(handle uintptr, x, y, width, height int) {
+	C.toastSetBounds(unsafe.Pointer(handle), C.int(x), C.int(y), C.int(width), C.int(height))
+}
@@ -0,0 +1,502 @@
+#include <assert.h>  // for assert
+#include <gtk/gtk.h>
+#include <stdio.h>   // for snprintf
+#include <string.h>  // for strcmp
+#include "_cgo_export.h"
+#include "thunks.h"
+
+#define WATCHER_NAME "org.kde.StatusNotifierWatcher"
+#define WATCHER_PATH "/StatusNotifierWatcher"
+#define ITEM_INTERFACE "org.kde.StatusNotifierItem"
+#define MENU_INTERFACE "com.canonical.dbusmenu"
+
+static char const trayXML[] =
+    "<node>"
+    " <interface name='org.kde.StatusNotifierItem'>"
+    "  <property name='Category' type='s' access='read'/>"
+    "  <property name='Id' type='s' access='read'/>"
+    "  <property name='Title' type='s' access='read'/>"
+    "  <property name='Status' type='s' access='read'/>"
+    "  <property name='IconName' type='s' access='read'/>"
+    "  <property name='IconPixmap' type='a(iiay)' access='read'/>"
+    "  <property name='ToolTip' type='(sa(iiay)ss)' access='read'/>"
+    "  <property name='ItemIsMenu' type='b' access='read'/>"
+    "  <property name='Menu' type='o' access='read'/>"
+    "  <method name='ContextMenu'>"
+    "   <arg name='x' type='i' direction='in'/>"
+    "   <arg name='y' type='i' direction='in'/>"
+    "  </method>"
+    "  <method name='Activate'>"
+    "   <arg name='x' type='i' direction='in'/>"
+    "   <arg name='y' type='i' direction='in'/>"
+    "  </method>"
+    "  <method name='SecondaryActivate'>"
+    "   <arg name='x' type='i' direction='in'/>"
+    "   <arg name='y' type='i' direction='in'/>"
+    "  </method>"
+    "  <method name='Scroll'>"
+    "   <arg name='delta' type='i' direction='in'/>"
+    "   <arg name='orientation' type='s' direction='in'/>"
+    "  </method>"
+    "  <signal name='NewIcon'/>"
+    "  <signal name='NewToolTip'/>"
+    " </interface>"
+    " <interface name='com.canonical.dbusmenu'>"
+    "  <property name='Version' type='u' access='read'/>"
+    "  <property name='TextDirection' type='s' access='read'/>"
+    "  <property name='Status' type='s' access='read'/>"
+    "  <method name='GetLayout'>"
+    "   <arg name='parentId' type='i' direction='in'/>"
+    "   <arg name='recursionDepth' type='i' direction='in'/>"
+    "   <arg name='propertyNames' type='as' direction='in'/>"
+    "   <arg name='revision' type='u' direction='out'/>"
+    "   <arg name='layout' type='(ia{sv}av)' direction='out'/>"
+    "  </method>"
+    "  <method name='GetGroupProperties'>"
+    "   <arg name='ids' type='ai' direction='in'/>"
+    "   <arg name='propertyNames' type='as' direction='in'/>"
+    "   <arg name='properties' type='a(ia{sv})' direction='out'/>"
+    "  </method>"
+    "  <method name='GetProperty'>"
+    "   <arg name='id' type='i' direction='in'/>"
+    "   <arg name='name' type='s' direction='in'/>"
+    "   <arg name='value' type='v' direction='out'/>"
+    "  </method>"
+    "  <method name='Event'>"
+    "   <arg name='id' type='i' direction='in'/>"
+    "   <arg name='eventId' type='s' direction='in'/>"
+    "   <arg name='data' type='v' direction='in'/>"
+    "   <arg name='timestamp' type='u' direction='in'/>"
+    "  </method>"
+    "  <method name='EventGroup'>"
+    "   <arg name='events' type='a(isvu)' direction='in'/>"
+    "   <arg name='idErrors' type='ai' direction='out'/>"
+    "  </method>"
+    "  <method name='AboutToShow'>"
+    "   <arg name='id' type='i' direction='in'/>"
+    "   <arg name='needUpdate' type='b' direction='out'/>"
+    "  </method>"
+    "  <method name='AboutToShowGroup'>"
+    "   <arg name='ids' type='ai' direction='in'/>"
+    "   <arg name='updatesNeeded' type='ai' direction='out'/>"
+    "   <arg name='idErrors' type='ai' direction='out'/>"
+    "  </method>"
+    "  <signal name='ItemsPropertiesUpdated'>"
+    "   <arg name='updatedProps' type='a(ia{sv})'/>"
+    "   <arg name='removedProps' type='a(ias)'/>"
+    "  </signal>"
+    "  <signal name='LayoutUpdated'>"
+    "   <arg name='revision' type='u'/>"
+    "   <arg name='parent' type='i'/>"
+    "  </signal>"
+    " </interface>"
+    "</node>";
+
+typedef struct {
+    char *label;
+    bool separator;
+    bool disabled;
+} TrayMenuItem;
+
+typedef struct {
+    GDBusConnection *connection;
+    char path[64];
+    char menuPath[64];
+    guint itemRegistration;
+    guint menuRegistration;
+    guint watch;
+    GVariant *pixmap;
+    char *tooltip;
+    TrayMenuItem *items;
+    unsigned count;
+    guint32 revision;
+} Tray;
+
+static GDBusNodeInfo *trayNodeInfo = NULL;
+static unsigned trayCounter = 0;
+
+static GVariant *newPixmap( unsigned char const *data, int width, int height )
+{
+    GVariantBuilder builder;
+    g_variant_builder_init( &builder, G_VARIANT_TYPE( "a(iiay)" ) );
+    g_variant_builder_add(
+        &builder, "(ii@ay)", width, height,
+        g_variant_new_fixed_array( G_VARIANT_TYPE_BYTE, data,
+                                   (gsize)width * height * 4, 1 ) );
+    return g_variant_ref_sink( g_variant_builder_end( &builder ) );
+}
+
+static GVariant *menuItemProperties( Tray *tray, gint32 id )
+{
+    GVariantBuilder builder;
+    g_variant_builder_init( &builder, G_VARIANT_TYPE( "a{sv}" ) );
+
+    if ( id == 0 ) {
+        g_variant_builder_add( &builder, "{sv}", "children-display",
+                               g_variant_new_string( "submenu" ) );
+    } else if ( id > 0 && (unsigned)id <= tray->count ) {
+        TrayMenuItem *item = &tray->items[id - 1];
+        if ( item->separator ) {
+            g_variant_builder_add( &builder, "{sv}", "type",
+                                   g_variant_new_string( "separator" ) );
+        } else {
+            g_variant_builder_add( &builder, "{sv}", "label",
+                                   g_variant_new_string( item->label ) );
+        }
+        g_variant_builder_add( &builder, "{sv}", "enabled",
+                               g_variant_new_boolean( !item->disabled ) );
+    }
+
+    return g_variant_builder_end( &builder );
+}
+
+static GVariant *menuLayout( Tray *tray, gint32 id )
+{
+    GVariantBuilder children;
+    g_variant_builder_init( &children, G_VARIANT_TYPE( "av" ) );
+    if ( id == 0 ) {
+        for ( unsigned i = 0; i < tray->count; ++i ) {
+            g_variant_builder_add( &children, "v", menuLayout( tray, i + 1 ) );
+        }
+    }
+
+    return g_variant_new( "(i@a{sv}av)", id, menuItemProperties( tray, id ),
+                          &children );
+}
+
+static void menuEvent( Tray *tray, gint32 id, char const *eventId )
+{
+    if ( strcmp( eventId, "clicked" ) == 0 && id > 0 &&
+         (unsigned)id <= tray->count ) {
+        onTrayMenuItem( tray, id - 1 );
+    }
+}
+
+static void onmethodcall_cb( GDBusConnection *connection, gchar const *sender,
+                             gchar const *path, gchar const *interface,
+                             gchar const *method, GVariant *parameters,
+                             GDBusMethodInvocation *invocation,
+                             gpointer data )
+{
+    Tray *tray = data;
+
+    if ( strcmp( interface, ITEM_INTERFACE ) == 0 ) {
+        if ( strcmp( method, "Activate" ) == 0 ) {
+            onTrayActivate( tray );
+        }
+        g_dbus_method_invocation_return_value( invocation, NULL );
+        return;
+    }
+
+    if ( strcmp( method, "GetLayout" ) == 0 ) {
+        gint32 parent;
+        g_variant_get( parameters, "(ii@as)", &parent, NULL, NULL );
+        g_dbus_method_invocation_return_value(
+            invocation, g_variant_new( "(u@(ia{sv}av))", tray->revision,
+                                       menuLayout( tray, parent ) ) );
+    } else if ( strcmp( method, "GetGroupProperties" ) == 0 ) {
+        GVariantIter *ids;
+        gint32 id;
+        GVariantBuilder builder;
+        g_variant_builder_init( &builder, G_VARIANT_TYPE( "a(ia{sv})" ) );
+        g_variant_get( parameters, "(ai@as)", &ids, NULL );
+        while ( g_variant_iter_loop( ids, "i", &id ) ) {
+            g_variant_builder_add( &builder, "(i@a{sv})", id,
+                                   menuItemProperties( tray, id ) );
+        }
+        g_variant_iter_free( ids );
+        g_dbus_method_invocation_return_value(
+            invocation, g_variant_new( "(a(ia{sv}))", &builder ) );
+    } else if ( strcmp( method, "GetProperty" ) == 0 ) {
+        gint32 id;
+        gchar const *name;
+        g_variant_get( parameters, "(i&s)", &id, &name );
+        GVariant *props = menuItemProperties( tray, id );
+        GVariant *value = g_variant_lookup_value( props, name, NULL );
+        g_variant_unref( g_variant_ref_sink( props ) );
+        if ( value ) {
+            g_dbus_method_invocation_return_value(
+                invocation, g_variant_new( "(v)", value ) );
+            g_variant_unref( value );
+        } else {
+            g_dbus_method_invocation_return_error(
+                invocation, G_DBUS_ERROR, G_DBUS_ERROR_INVALID_ARGS,
+                "Unknown property" );
+        }
+    } else if ( strcmp( method, "Event" ) == 0 ) {
+        gint32 id;
+        gchar const *eventId;
+        g_variant_get( parameters, "(i&svu)", &id, &eventId, NULL, NULL );
+        g_dbus_method_invocation_return_value( invocation, NULL );
+        menuEvent( tray, id, eventId );
+    } else if ( strcmp( method, "EventGroup" ) == 0 ) {
+        GVariantIter *events;
+        gint32 id;
+        gchar const *eventId;
+        g_variant_get( parameters, "(a(isvu))", &events );
+        g_dbus_method_invocation_return_value(
+            invocation, g_variant_new( "(@ai)", g_variant_new_array(
+                                                    G_VARIANT_TYPE_INT32,
+                                                    NULL, 0 ) ) );
+        while ( g_variant_iter_loop( events, "(i&svu)", &id, &eventId, NULL,
+                                     NULL ) ) {
+            menuEvent( tray, id, eventId );
+        }
+        g_variant_iter_free( events );
+    } else if ( strcmp( method, "AboutToShow" ) == 0 ) {
+        g_dbus_method_invocation_return_value( invocation,
+                                               g_variant_new( "(b)", FALSE ) );
+    } else if ( strcmp( method, "AboutToShowGroup" ) == 0 ) {
+        GVariant *empty =
+            g_variant_new_array( G_VARIANT_TYPE_INT32, NULL, 0 );
+        g_dbus_method_invocation_return_value(
+            invocation, g_variant_new( "(@ai@ai)", empty,
+                                       g_variant_new_array(
+                                           G_VARIANT_TYPE_INT32, NULL, 0 ) ) );
+    } else {
+        g_dbus_method_invocation_return_error(
+            invocation, G_DBUS_ERROR, G_DBUS_ERROR_UNKNOWN_METHOD,
+            "Unknown method" );
+    }
+}
+
+static GVariant *ongetproperty_cb( GDBusConnection *connection,
+                                   gchar const *sender, gchar const *path,
+                                   gchar const *interface,
+                                   gchar const *property, GError **error,
+                                   gpointer data )
+{
+    Tray *tray = data;
+
+    if ( strcmp( interface, MENU_INTERFACE ) == 0 ) {
+        if ( strcmp( property, "Version" ) == 0 ) {
+            return g_variant_new_uint32( 3 );
+        } else if ( strcmp( property, "TextDirection" ) == 0 ) {
+            return g_variant_new_string(
+                gtk_widget_get_default_direction() == GTK_TEXT_DIR_RTL
+                    ? "rtl"
+                    : "ltr" );
+        } else if ( strcmp( property, "Status" ) == 0 ) {
+            return g_variant_new_string( "normal" );
+        }
+    } else if ( strcmp( property, "Category" ) == 0 ) {
+        return g_variant_new_string( "ApplicationStatus" );
+    } else if ( strcmp( property, "Id" ) == 0 ) {
+        gchar const *name = g_get_prgname();
+        return g_variant_new_string( name ? name : "goey" );
+    } else if ( strcmp( property, "Title" ) == 0 ) {
+        return g_variant_new_string( tray->tooltip );
+    } else if ( strcmp( property, "Status" ) == 0 ) {
+        return g_variant_new_string( "Active" );
+    } else if ( strcmp( property, "IconName" ) == 0 ) {
+        return g_variant_new_string( "" );
+    } else if ( strcmp( property, "IconPixmap" ) == 0 ) {
+        return g_variant_ref( tray->pixmap );
+    } else if ( strcmp( property, "ToolTip" ) == 0 ) {
+        return g_variant_new( "(s@a(iiay)ss)", "", tray->pixmap,
+                              tray->tooltip, "" );
+    } else if ( strcmp( property, "ItemIsMenu" ) == 0 ) {
+        return g_variant_new_boolean( FALSE );
+    } else if ( strcmp( property, "Menu" ) == 0 ) {
+        return g_variant_new_object_path( tray->menuPath );
+    }
+
+    g_set_error( error, G_DBUS_ERROR, G_DBUS_ERROR_UNKNOWN_PROPERTY,
+                 "Unknown property %s", property );
+    return NULL;
+}
+
+static GDBusInterfaceVTable const trayVTable = {
+    onmethodcall_cb, ongetproperty_cb, NULL};
+
+static void onwatcherappeared_cb( GDBusConnection *connection,
+                                  gchar const *name, gchar const *owner,
+                                  gpointer data )
+{
+    Tray *tray = data;
+
+    // Register the item using its object path.  The watcher will combine
+    // the path with the unique name of the connection.
+    g_dbus_connection_call( connection, WATCHER_NAME, WATCHER_PATH,
+                            WATCHER_NAME, "RegisterStatusNotifierItem",
+                            g_variant_new( "(s)", tray->path ), NULL,
+                            G_DBUS_CALL_FLAGS_NONE, -1, NULL, NULL, NULL );
+}
+
+static char *copyTrayError( GError *err )
+{
+    char *retval = strdup( err->message );
+    assert( retval );
+    g_error_free( err );
+    return retval;
+}
+
+void *mountTray( unsigned char const *data, int width, int height,
+                 char const *tooltip, char **error )
+{
+    assert( data );
+    assert( tooltip );
+    assert( error );
+
+    GError *err = NULL;
+    if ( !trayNodeInfo ) {
+        trayNodeInfo = g_dbus_node_info_new_for_xml( trayXML, &err );
+        if ( !trayNodeInfo ) {
+            *error = copyTrayError( err );
+            return NULL;
+        }
+    }
+
+    GDBusConnection *connection =
+        g_bus_get_sync( G_BUS_TYPE_SESSION, NULL, &err );
+    if ( !connection ) {
+        *error = copyTrayError( err );
+        return NULL;
+    }
+
+    Tray *tray = g_new0( Tray, 1 );
+    tray->connection = connection;
+    tray->pixmap = newPixmap( data, width, height );
+    tray->tooltip = g_strdup( tooltip );
+    tray->revision = 1;
+    ++trayCounter;
+    snprintf( tray->path, sizeof( tray->path ),
+              "/org/goey/StatusNotifierItem%u", trayCounter );
+    snprintf( tray->menuPath, sizeof( tray->menuPath ), "%s/Menu",
+              tray->path );
+
+    tray->itemRegistration = g_dbus_connection_register_object(
+        connection, tray->path, trayNodeInfo->interfaces[0], &trayVTable,
+        tray, NULL, &err );
+    if ( tray->itemRegistration ) {
+        tray->menuRegistration = g_dbus_connection_register_object(
+            connection, tray->menuPath, trayNodeInfo->interfaces[1],
+            &trayVTable, tray, NULL, &err );
+    }
+    if ( !tray->menuRegistration ) {
+        *error = copyTrayError( err );
+        trayClose( tray );
+        return NULL;
+    }
+
+    // The icon is registered whenever a watcher is available.
+    tray->watch = g_bus_watch_name_on_connection(
+        connection, WATCHER_NAME, G_BUS_NAME_WATCHER_FLAGS_NONE,
+        onwatcherappeared_cb, NULL, tray, NULL );
+
+    return tray;
+}
+
+void trayClose( void *handle )
+{
+    Tray *tray = handle;
+
+    if ( tray->watch ) {
+        g_bus_unwatch_name( tray->watch );
+    }
+    if ( tray->menuRegistration ) {
+        g_dbus_connection_unregister_object( tray->connection,
+                                             tray->menuRegistration );
+    }
+    if ( tray->itemRegistration ) {
+        g_dbus_connection_unregister_object( tray->connection,
+                                             tray->itemRegistration );
+    }
+    traySetMenuCount( tray, 0 );
+    g_variant_unref( tray->pixmap );
+    g_free( tray->tooltip );
+    g_object_unref( tray->connection );
+    g_free( tray );
+}
+
+static void emitSignal( Tray *tray, char const *path, char const *interface,
+                        char const *signal, GVariant *parameters )
+{
+    g_dbus_connection_emit_signal( tray->connection, NULL, path, interface,
+                                   signal, parameters, NULL );
+}
+
+void traySetIcon( void *handle, unsigned char const *data, int width,
+                  int height )
+{
+    Tray *tray = handle;
+
+    g_variant_unref( tray->pixmap );
+    tray->pixmap = newPixmap( data, width, height );
+    emitSignal( tray, tray->path, ITEM_INTERFACE, "NewIcon", NULL );
+}
+
+void traySetTooltip( void *handle, char const *tooltip )
+{
+    Tray *tray = handle;
+
+    g_free( tray->tooltip );
+    tray->tooltip = g_strdup( tooltip );
+    emitSignal( tray, tray->path, ITEM_INTERFACE, "NewToolTip", NULL );
+}
+
+void traySetMenuCount( void *handle, unsigned count )
+{
+    Tray *tray = handle;
+
+    for ( unsigned i = 0; i < tray->count; ++i ) {
+        g_free( tray->items[i].label );
+    }
+    g_free( tray->items );
+    tray->items = count > 0 ? g_new0( TrayMenuItem, count ) : NULL;
+    tray->count = count;
+}
+
+void traySetMenuItem( void *handle, unsigned index, char const *label,
+                      bool separator, bool disabled )
+{
+    Tray *tray = handle;
+    assert( index < tray->count );
+
+    TrayMenuItem *item = &tray->items[index];
+    g_free( item->label );
+    item->label = g_strdup( label );
+    item->separator = separator;
+    item->disabled = disabled;
+}
+
+void trayMenuUpdated( void *handle )
+{
+    Tray *tray = handle;
+
+    ++tray->revision;
+    emitSignal( tray, tray->menuPath, MENU_INTERFACE, "LayoutUpdated",
+                g_variant_new( "(ui)", tray->revision, 0 ) );
+}
+
+static char *trayCallForTesting( void *handle, bool menu, char const *method,
+                                 GVariant *parameters )
+{
+    Tray *tray = handle;
+
+    GError *err = NULL;
+    GVariant *rc = g_dbus_connection_call_sync(
+        tray->connection, g_dbus_connection_get_unique_name( tray->connection ),
+        menu ? tray->menuPath : tray->path,
+        menu ? MENU_INTERFACE : ITEM_INTERFACE, method, parameters, NULL,
+        G_DBUS_CALL_FLAGS_NONE, -1, NULL, &err );
+    if ( !rc ) {
+        return copyTrayError( err );
+    }
+    g_variant_unref( rc );
+    return NULL;
+}
+
+char *trayActivateForTesting( void *handle )
+{
+    return trayCallForTesting( handle, false, "Activate",
+                               g_variant_new( "(ii)", 0, 0 ) );
+}
+
+char *trayMenuItemForTesting( void *handle, unsigned index )
+{
+    return trayCallForTesting(
+        handle, true, "Event",
+        g_variant_new( "(isvu)", (gint32)index + 1, "clicked",
+                       g_variant_new_int32( 0 ), 0 ) );
+}
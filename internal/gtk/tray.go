@@ -0,0 +1,118 @@
+package gtk
+
+// #include <stdlib.h>
+// #include "thunks.h"
+import "C"
+import (
+	"errors"
+	"image"
+	"unsafe"
+)
+
+// Tray is implemented by icons in the system tray.
+type Tray interface {
+	OnActivate()
+	OnMenuItem(index int)
+}
+
+var (
+	trays = make(map[uintptr]Tray)
+)
+
+//export onTrayActivate
+func onTrayActivate(handle unsafe.Pointer) {
+	if cb, ok := trays[uintptr(handle)]; ok {
+		cb.OnActivate()
+	}
+}
+
+//export onTrayMenuItem
+func onTrayMenuItem(handle unsafe.Pointer, index C.int) {
+	if cb, ok := trays[uintptr(handle)]; ok {
+		cb.OnMenuItem(int(index))
+	}
+}
+
+// TrayPixmap converts the image to the format used by the StatusNotifierItem
+// protocol, which is ARGB32 in network byte order.
+func TrayPixmap(prop image.Image) ([]byte, int, int) {
+	img := ImageToRGBA(prop)
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+
+	pixmap := make([]byte, 0, width*height*4)
+	for y := 0; y < height; y++ {
+		row := img.Pix[y*img.Stride:]
+		for x := 0; x < width; x++ {
+			pixmap = append(pixmap, row[4*x+3], row[4*x], row[4*x+1], row[4*x+2])
+		}
+	}
+	return pixmap, width, height
+}
+
+func toError(cerr *C.char) error {
+	if cerr == nil {
+		return nil
+	}
+	defer C.free(unsafe.Pointer(cerr))
+	return errors.New(C.GoString(cerr))
+}
+
+func MountTray(cb Tray, prop image.Image, tooltip string) (uintptr, error) {
+	pixmap, width, height := TrayPixmap(prop)
+	ctooltip := C.CString(tooltip)
+	defer C.free(unsafe.Pointer(ctooltip))
+
+	var cerr *C.char
+	handle := C.mountTray((*C.uchar)(unsafe.Pointer(&pixmap[0])), C.int(width), C.int(height), ctooltip, &cerr)
+	if err := toError(cerr); err != nil {
+		return 0, err
+	}
+	trays[uintptr(handle)] = cb
+	return uintptr(handle), nil
+}
+
+func TrayClose(handle uintptr) {
+	C.trayClose(unsafe.Pointer(handle))
+	delete(trays, handle)
+}
+
+func TraySetIcon(handle uintptr, prop image.Image) {
+	pixmap, width, height := TrayPixmap(prop)
+	C.traySetIcon(unsafe.Pointer(handle), (*C.uchar)(unsafe.Pointer(&pixmap[0])), C.int(width), C.int(height))
+}
+
+func TraySetTooltip(handle uintptr, tooltip string) {
+	ctooltip := C.CString(tooltip)
+	defer C.free(unsafe.Pointer(ctooltip))
+
+	C.traySetTooltip(unsafe.Pointer(handle), ctooltip)
+}
+
+// TraySetMenuCount resizes the menu, and clears all of the items.  Once the
+// items have been set, call TrayMenuUpdated to notify the host.
+func TraySetMenuCount(handle uintptr, count int) {
+	C.traySetMenuCount(unsafe.Pointer(handle), C.uint(count))
+}
+
+func TraySetMenuItem(handle uintptr, index int, label string, separator, disabled bool) {
+	clabel := C.CString(label)
+	defer C.free(unsafe.Pointer(clabel))
+
+	C.traySetMenuItem(unsafe.Pointer(handle), C.uint(index), clabel, C.bool(separator), C.bool(disabled))
+}
+
+func TrayMenuUpdated(handle uintptr) {
+	C.trayMenuUpdated(unsafe.Pointer(handle))
+}
+
+// TrayActivateForTesting calls the method Activate for the icon over D-Bus.
+// This function must not be called on the GUI thread.
+func TrayActivateForTesting(handle uintptr) error {
+	return toError(C.trayActivateForTesting(unsafe.Pointer(handle)))
+}
+
+// TrayMenuItemForTesting sends a clicked event for a menu item over D-Bus.
+// This function must not be called on the GUI thread.
+func TrayMenuItemForTesting(handle uintptr, index int) error {
+	return toError(C.trayMenuItemForTesting(unsafe.Pointer(handle), C.uint(index)))
+}
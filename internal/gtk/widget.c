@@ -43,6 +43,13 @@ bool widgetIsFocus( void *widget )
     return gtk_widget_is_focus( widget );
 }
 
+bool widgetIsWithin( void *widget, void *ancestor )
+{
+    assert( widget && GTK_IS_WIDGET(widget) );
+    assert( ancestor && GTK_IS_WIDGET(ancestor) );
+    return widget == ancestor || gtk_widget_is_ancestor( widget, ancestor );
+}
+
 static void set_key_info( GdkEventKey *evt, GdkWindow *window, guint r )
 {
     assert( evt );
@@ -60,6 +67,10 @@ static void set_key_info( GdkEventKey *evt, GdkWindow *window, guint r )
             evt->keyval = GDK_KEY_Return;
             evt->hardware_keycode = 36;
             break;
+        case '\t':
+            evt->keyval = GDK_KEY_Tab;
+            evt->hardware_keycode = 23;
+            break;
         default:
             evt->keyval = r;
             break;
@@ -68,6 +79,12 @@ static void set_key_info( GdkEventKey *evt, GdkWindow *window, guint r )
 
 void widgetSendKey( void *widget, unsigned key, bool release )
 {
+    // Keyboard navigation is handled by the toplevel window, which forwards
+    // any other keys to the focused widget.
+    if ( key == '\t' ) {
+        widget = gtk_widget_get_toplevel( widget );
+    }
+
     GdkEvent *evt = gdk_event_new( release ? GDK_KEY_RELEASE : GDK_KEY_PRESS );
     set_key_info( (GdkEventKey *)evt, gtk_widget_get_window( widget ), key );
     gtk_widget_event( widget, evt );
@@ -145,6 +162,19 @@ void widgetNaturalSize( void *widget, int *width, int *height )
     gtk_widget_get_preferred_height( widget, &min, height );
 }
 
+void widgetBounds( void *widget, int *x, int *y, int *width, int *height )
+{
+    assert( widget && GTK_IS_WIDGET(widget) );
+
+    GtkWidget *toplevel = gtk_widget_get_toplevel( widget );
+    if ( !gtk_widget_translate_coordinates( widget, toplevel, 0, 0, x, y ) ) {
+        *x = 0;
+        *y = 0;
+    }
+    *width = gtk_widget_get_allocated_width( widget );
+    *height = gtk_widget_get_allocated_height( widget );
+}
+
 extern void widgetSetBounds( void *widget, int x, int y, int width, int height )
 {
     assert( widget && GTK_IS_WIDGET(widget) );
@@ -38,6 +38,21 @@ func onBlur(handle unsafe.Pointer) {
 	widgets[uintptr(handle)].(WidgetWithFocus).OnBlur()
 }
 
+// WidgetIsWithin returns true if the widget is ancestor, or is contained
+// within ancestor.
+func WidgetIsWithin(widget, ancestor uintptr) bool {
+	return bool(C.widgetIsWithin(unsafe.Pointer(widget), unsafe.Pointer(ancestor)))
+}
+
+// WidgetBounds returns the position and size of the widget, relative to its
+// toplevel window.
+func WidgetBounds(widget uintptr) (int, int, int, int) {
+	var x, y, width, height C.int
+
+	C.widgetBounds(unsafe.Pointer(widget), &x, &y, &width, &height)
+	return int(x), int(y), int(width), int(height)
+}
+
 func WidgetNaturalSize(widget uintptr) (int, int) {
 	var width, height C.int
 
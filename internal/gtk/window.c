@@ -19,6 +19,79 @@ static void onsizeallocate_cb( GtkWidget *widget, GdkRectangle *rectangle,
     onSizeAllocate( widget, rectangle->width, rectangle->height );
 }
 
+static void onscreenchanged_cb( GtkWidget *widget, GdkScreen *previous,
+                                gpointer user_data )
+{
+    onDPIChanged( widget );
+}
+
+static void onscalefactor_cb( GtkWidget *widget, GParamSpec *pspec,
+                              gpointer user_data )
+{
+    onDPIChanged( widget );
+}
+
+static void onxftdpi_cb( GtkWidget *widget, GParamSpec *pspec,
+                         gpointer settings )
+{
+    onDPIChanged( widget );
+}
+
+static void onisactive_cb( GtkWidget *widget, GParamSpec *pspec,
+                           gpointer user_data )
+{
+    onActiveChanged( widget, gtk_window_is_active( GTK_WINDOW( widget ) ) );
+}
+
+static gboolean onwindowstate_cb( GtkWidget *widget,
+                                  GdkEventWindowState *event,
+                                  gpointer user_data )
+{
+    if ( event->changed_mask & GDK_WINDOW_STATE_ICONIFIED ) {
+        onIconified( widget, ( event->new_window_state &
+                               GDK_WINDOW_STATE_ICONIFIED ) != 0 );
+    }
+    return FALSE;
+}
+
+static gboolean onconfigure_cb( GtkWidget *widget, GdkEventConfigure *event,
+                                gpointer user_data )
+{
+    int x, y;
+    gtk_window_get_position( GTK_WINDOW( widget ), &x, &y );
+    onMove( widget, x, y );
+    return FALSE;
+}
+
+static void onsetfocus_cb( GtkWidget *widget, GtkWidget *focus,
+                           gpointer user_data )
+{
+    onSetFocus( widget, focus );
+}
+
+static gboolean onmotion_cb( GtkWidget *widget, GdkEventMotion *event,
+                             gpointer user_data )
+{
+    // The event may be for a child's window, so find the position relative to
+    // the toplevel window.
+    int x, y;
+    gdk_window_get_device_position( gtk_widget_get_window( widget ),
+                                    event->device, &x, &y, NULL );
+    onMotion( widget, x, y );
+    return FALSE;
+}
+
+static gboolean onkeypressdialog_cb( GtkWidget *widget, GdkEventKey *event,
+                                    gpointer user_data )
+{
+    // Dialogs should close when the user presses escape.
+    if ( event->keyval == GDK_KEY_Escape ) {
+        gtk_window_close( GTK_WINDOW( widget ) );
+        return TRUE;
+    }
+    return FALSE;
+}
+
 void *mountWindow( char const *text )
 {
     assert( text );
@@ -40,6 +113,28 @@ void *mountWindow( char const *text )
                       NULL );
     g_signal_connect( window, "size-allocate", G_CALLBACK( onsizeallocate_cb ),
                       NULL );
+    g_signal_connect( window, "notify::is-active", G_CALLBACK( onisactive_cb ),
+                      NULL );
+    g_signal_connect( window, "window-state-event",
+                      G_CALLBACK( onwindowstate_cb ), NULL );
+    g_signal_connect( window, "configure-event", G_CALLBACK( onconfigure_cb ),
+                      NULL );
+    g_signal_connect_after( window, "set-focus", G_CALLBACK( onsetfocus_cb ),
+                            NULL );
+    gtk_widget_add_events( layout, GDK_POINTER_MOTION_MASK );
+    gtk_widget_add_events( window, GDK_POINTER_MOTION_MASK );
+    g_signal_connect( window, "motion-notify-event", G_CALLBACK( onmotion_cb ),
+                      NULL );
+
+    // The scale factor changes when the window is moved to a monitor with a
+    // different scale, and the Xft DPI changes with the user's settings.
+    g_signal_connect( window, "notify::scale-factor",
+                      G_CALLBACK( onscalefactor_cb ), NULL );
+    g_signal_connect( window, "screen-changed",
+                      G_CALLBACK( onscreenchanged_cb ), NULL );
+    g_signal_connect_object( gtk_settings_get_default(), "notify::gtk-xft-dpi",
+                             G_CALLBACK( onxftdpi_cb ), window,
+                             G_CONNECT_SWAPPED );
 
     return window;
 }
@@ -53,6 +148,18 @@ windowsize_t windowSize( void *window )
     return size;
 }
 
+void windowDPI( void *window, double *resolution, int *scale )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+    assert( resolution && scale );
+
+    *scale = gtk_widget_get_scale_factor( GTK_WIDGET( window ) );
+    // The resolution includes the Xft DPI, and is measured in application
+    // pixels, which have already been adjusted for the scale factor.
+    GdkScreen *screen = gtk_widget_get_screen( GTK_WIDGET( window ) );
+    *resolution = screen ? gdk_screen_get_resolution( screen ) : -1;
+}
+
 void *windowScrolledWindow( void *window )
 {
     assert( window && GTK_IS_WINDOW(window) );
@@ -158,12 +265,282 @@ void windowShow( void *window )
     gtk_widget_show_all( GTK_WIDGET( window ) );
 }
 
+void windowSetDialog( void *window, void *owner )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+
+    gtk_window_set_type_hint( GTK_WINDOW( window ),
+                              GDK_WINDOW_TYPE_HINT_DIALOG );
+    gtk_window_set_modal( GTK_WINDOW( window ), TRUE );
+    if ( owner ) {
+        gtk_window_set_transient_for( GTK_WINDOW( window ),
+                                      GTK_WINDOW( owner ) );
+        gtk_window_set_position( GTK_WINDOW( window ),
+                                 GTK_WIN_POS_CENTER_ON_PARENT );
+    } else {
+        gtk_window_set_position( GTK_WINDOW( window ), GTK_WIN_POS_CENTER );
+    }
+    g_signal_connect( window, "key-press-event",
+                      G_CALLBACK( onkeypressdialog_cb ), NULL );
+}
+
+void windowSetOptions( void *window, void *owner, bool modal,
+                       bool toolWindow, bool alwaysOnTop )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+
+    if ( owner ) {
+        gtk_window_set_transient_for( GTK_WINDOW( window ),
+                                      GTK_WINDOW( owner ) );
+        gtk_window_set_destroy_with_parent( GTK_WINDOW( window ), TRUE );
+    }
+    if ( modal ) {
+        gtk_window_set_modal( GTK_WINDOW( window ), TRUE );
+    }
+    if ( toolWindow ) {
+        gtk_window_set_type_hint( GTK_WINDOW( window ),
+                                  GDK_WINDOW_TYPE_HINT_UTILITY );
+        gtk_window_set_skip_taskbar_hint( GTK_WINDOW( window ), TRUE );
+    }
+    if ( alwaysOnTop ) {
+        gtk_window_set_keep_above( GTK_WINDOW( window ), TRUE );
+    }
+}
+
 void windowSetDefaultSize( void *window, int width, int height )
 {
     assert( window && GTK_IS_WINDOW(window) );
     gtk_window_set_default_size( GTK_WINDOW( window ), width, height );
 }
 
+void windowResize( void *window, int width, int height )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+    gtk_window_resize( GTK_WINDOW( window ), width, height );
+}
+
+void windowPosition( void *window, int *x, int *y )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+    gtk_window_get_position( GTK_WINDOW( window ), x, y );
+}
+
+void windowMove( void *window, int x, int y )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+    gtk_window_move( GTK_WINDOW( window ), x, y );
+}
+
+bool windowIsOnScreen( void *window, int x, int y )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+
+    GdkDisplay *display = gtk_widget_get_display( GTK_WIDGET( window ) );
+    int n = gdk_display_get_n_monitors( display );
+    for ( int i = 0; i < n; ++i ) {
+        GdkRectangle rect;
+        gdk_monitor_get_workarea( gdk_display_get_monitor( display, i ),
+                                  &rect );
+        if ( x >= rect.x && y >= rect.y && x < rect.x + rect.width &&
+             y < rect.y + rect.height ) {
+            return true;
+        }
+    }
+    return false;
+}
+
+#define TAB_ORDER_KEY "goey-tab-order"
+
+typedef struct {
+    GtkWidget **widgets;
+    size_t n;
+} tab_order;
+
+static gint tab_order_compare( gconstpointer lhs, gconstpointer rhs )
+{
+    GtkAllocation a, b;
+    gtk_widget_get_allocation( GTK_WIDGET( lhs ), &a );
+    gtk_widget_get_allocation( GTK_WIDGET( rhs ), &b );
+    if ( a.y != b.y ) {
+        return a.y < b.y ? -1 : 1;
+    }
+    return a.x < b.x ? -1 : a.x > b.x;
+}
+
+static void tab_order_add_cb( GtkContainer *container, GtkWidget *widget,
+                              gpointer user_data )
+{
+    // Widgets missing from the focus chain are skipped by keyboard
+    // navigation, so widgets added later are appended.
+    GList *chain = NULL;
+    G_GNUC_BEGIN_IGNORE_DEPRECATIONS
+    if ( gtk_container_get_focus_chain( container, &chain ) ) {
+        chain = g_list_append( chain, widget );
+        gtk_container_set_focus_chain( container, chain );
+    }
+    G_GNUC_END_IGNORE_DEPRECATIONS
+    g_list_free( chain );
+}
+
+static void set_tab_order( GtkWidget *widget, gpointer data )
+{
+    tab_order const *order = data;
+
+    if ( GTK_IS_LAYOUT( widget ) ) {
+        // The widgets within this layout are moved to the front of the
+        // chain, in order.  Widgets in nested containers are represented by
+        // the child of this layout that contains them.
+        GList *chain = NULL;
+        for ( size_t i = 0; i < order->n; ++i ) {
+            GtkWidget *child = order->widgets[i];
+            if ( !gtk_widget_is_ancestor( child, widget ) ) {
+                continue;
+            }
+            while ( gtk_widget_get_parent( child ) != widget ) {
+                child = gtk_widget_get_parent( child );
+            }
+            if ( !g_list_find( chain, child ) ) {
+                chain = g_list_append( chain, child );
+            }
+        }
+
+        G_GNUC_BEGIN_IGNORE_DEPRECATIONS
+        if ( chain ) {
+            // The remaining widgets follow in the default order for the tab
+            // key, which is by position.
+            GList *rest = NULL;
+            GList *children =
+                gtk_container_get_children( GTK_CONTAINER( widget ) );
+            for ( GList *i = children; i; i = i->next ) {
+                if ( !g_list_find( chain, i->data ) ) {
+                    rest = g_list_prepend( rest, i->data );
+                }
+            }
+            g_list_free( children );
+            chain = g_list_concat( chain,
+                                   g_list_sort( rest, tab_order_compare ) );
+            gtk_container_set_focus_chain( GTK_CONTAINER( widget ), chain );
+            g_list_free( chain );
+
+            if ( !g_object_get_data( G_OBJECT( widget ), TAB_ORDER_KEY ) ) {
+                g_signal_connect_after( widget, "add",
+                                        G_CALLBACK( tab_order_add_cb ), NULL );
+                g_object_set_data( G_OBJECT( widget ), TAB_ORDER_KEY,
+                                   GINT_TO_POINTER( 1 ) );
+            }
+        } else {
+            gtk_container_unset_focus_chain( GTK_CONTAINER( widget ) );
+        }
+        G_GNUC_END_IGNORE_DEPRECATIONS
+    }
+
+    if ( GTK_IS_CONTAINER( widget ) ) {
+        gtk_container_forall( GTK_CONTAINER( widget ), set_tab_order, data );
+    }
+}
+
+void windowSetTabOrder( void *window, void **widgets, size_t n )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+    assert( widgets || n == 0 );
+
+    tab_order order = {(GtkWidget **)widgets, n};
+    set_tab_order( windowLayout( window ), &order );
+}
+
+void windowSetMaxSize( void *window, int width, int height )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+
+    if ( width <= 0 && height <= 0 ) {
+        gtk_window_set_geometry_hints( GTK_WINDOW( window ), NULL, NULL, 0 );
+        return;
+    }
+
+    GdkGeometry geometry = {0};
+    geometry.max_width = width > 0 ? width : G_MAXSHORT;
+    geometry.max_height = height > 0 ? height : G_MAXSHORT;
+    gtk_window_set_geometry_hints( GTK_WINDOW( window ), NULL, &geometry,
+                                   GDK_HINT_MAX_SIZE );
+}
+
+// The states match the values of WindowState in the package windows.
+void windowSetState( void *window, int state )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+
+    GtkWindow *w = GTK_WINDOW( window );
+    switch ( state ) {
+    case 0:
+        gtk_window_unfullscreen( w );
+        gtk_window_unmaximize( w );
+        gtk_window_deiconify( w );
+        break;
+    case 1:
+        gtk_window_iconify( w );
+        break;
+    case 2:
+        gtk_window_unfullscreen( w );
+        gtk_window_deiconify( w );
+        gtk_window_maximize( w );
+        break;
+    case 3:
+        gtk_window_deiconify( w );
+        gtk_window_fullscreen( w );
+        break;
+    }
+}
+
+int windowState( void *window )
+{
+    assert( window && GTK_IS_WINDOW( window ) );
+
+    GdkWindow *gdkwindow = gtk_widget_get_window( GTK_WIDGET( window ) );
+    if ( !gdkwindow ) {
+        return 0;
+    }
+
+    GdkWindowState state = gdk_window_get_state( gdkwindow );
+    if ( state & GDK_WINDOW_STATE_ICONIFIED ) {
+        return 1;
+    }
+    if ( state & GDK_WINDOW_STATE_FULLSCREEN ) {
+        return 3;
+    }
+    if ( state & GDK_WINDOW_STATE_MAXIMIZED ) {
+        return 2;
+    }
+    return 0;
+}
+
+void windowSetCursor( void *window, char const *name )
+{
+    assert( window && GTK_IS_WINDOW(window) );
+
+    GdkWindow *gw = gtk_widget_get_window( GTK_WIDGET( window ) );
+    if ( !gw ) {
+        return;
+    }
+
+    // A NULL cursor restores the default.
+    GdkDisplay *display = gdk_window_get_display( gw );
+    GdkCursor *cursor = name ? gdk_cursor_new_from_name( display, name ) : NULL;
+    gdk_window_set_cursor( gw, cursor );
+    if ( cursor ) {
+        g_object_unref( cursor );
+    }
+
+    // Flush, so that the cursor changes even if the event loop is blocked.
+    gdk_display_flush( display );
+}
+
+void windowSetBusy( void *window, bool busy )
+{
+    assert( window && GTK_IS_WINDOW(window) );
+
+    gtk_widget_set_sensitive( windowScrolledWindow( window ), !busy );
+}
+
 void windowSetIcon( void *window, unsigned char const *data, int width,
                     int height, int rowStride )
 {
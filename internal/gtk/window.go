@@ -1,5 +1,6 @@
 package gtk
 
+// #include <stdlib.h>
 // #include "thunks.h"
 import "C"
 import "unsafe"
@@ -8,6 +9,12 @@ type Window interface {
 	Widget
 	OnDeleteEvent() bool
 	OnSizeAllocate(width, height int)
+	OnDPIChanged()
+	OnActiveChanged(active bool)
+	OnIconified(iconified bool)
+	OnMove(x, y int)
+	OnSetFocus(focus uintptr)
+	OnMotion(x, y int)
 }
 
 //export onDeleteEvent
@@ -20,6 +27,157 @@ func onSizeAllocate(handle unsafe.Pointer, width, height int) {
 	widgets[uintptr(handle)].(Window).OnSizeAllocate(width, height)
 }
 
+//export onDPIChanged
+func onDPIChanged(handle unsafe.Pointer) {
+	// The notification for the Xft DPI comes from the global settings, and
+	// may arrive while the window is being destroyed.
+	if w, ok := widgets[uintptr(handle)].(Window); ok {
+		w.OnDPIChanged()
+	}
+}
+
+//export onActiveChanged
+func onActiveChanged(handle unsafe.Pointer, active bool) {
+	if w, ok := widgets[uintptr(handle)].(Window); ok {
+		w.OnActiveChanged(active)
+	}
+}
+
+//export onIconified
+func onIconified(handle unsafe.Pointer, iconified bool) {
+	if w, ok := widgets[uintptr(handle)].(Window); ok {
+		w.OnIconified(iconified)
+	}
+}
+
+//export onMove
+func onMove(handle unsafe.Pointer, x, y int) {
+	if w, ok := widgets[uintptr(handle)].(Window); ok {
+		w.OnMove(x, y)
+	}
+}
+
+//export onSetFocus
+func onSetFocus(handle unsafe.Pointer, focus unsafe.Pointer) {
+	if w, ok := widgets[uintptr(handle)].(Window); ok {
+		w.OnSetFocus(uintptr(focus))
+	}
+}
+
+//export onMotion
+func onMotion(handle unsafe.Pointer, x, y int) {
+	if w, ok := widgets[uintptr(handle)].(Window); ok {
+		w.OnMotion(x, y)
+	}
+}
+
+// WindowDPI returns the resolution of the window's screen, in application
+// pixels per inch, and the scale factor between application pixels and
+// device pixels.  If the resolution is not known, the nominal 96 DPI is
+// returned.
+func WindowDPI(window uintptr) (float64, int) {
+	var resolution C.double
+	var scale C.int
+
+	C.windowDPI(unsafe.Pointer(window), &resolution, &scale)
+	if resolution <= 0 {
+		resolution = 96
+	}
+	if scale <= 0 {
+		scale = 1
+	}
+	return float64(resolution), int(scale)
+}
+
+// WindowSetDialog configures the window to act as a modal dialog for the
+// owner, which may be zero.
+func WindowSetDialog(window uintptr, owner uintptr) {
+	C.windowSetDialog(unsafe.Pointer(window), unsafe.Pointer(owner))
+}
+
+// WindowSetOptions configures the window's owner, which may be zero, and how
+// the window is shown.
+func WindowSetOptions(window uintptr, owner uintptr, modal, toolWindow, alwaysOnTop bool) {
+	C.windowSetOptions(unsafe.Pointer(window), unsafe.Pointer(owner), C.bool(modal), C.bool(toolWindow), C.bool(alwaysOnTop))
+}
+
+// WindowResize changes the size of the window.
+func WindowResize(window uintptr, width, height int) {
+	C.windowResize(unsafe.Pointer(window), C.int(width), C.int(height))
+}
+
+// WindowPosition returns the position of the window on the screen.
+func WindowPosition(window uintptr) (int, int) {
+	var x, y C.int
+
+	C.windowPosition(unsafe.Pointer(window), &x, &y)
+	return int(x), int(y)
+}
+
+// WindowMove changes the position of the window on the screen.
+func WindowMove(window uintptr, x, y int) {
+	C.windowMove(unsafe.Pointer(window), C.int(x), C.int(y))
+}
+
+// WindowIsOnScreen returns true if the position is within the work area of
+// a monitor on the window's display.
+func WindowIsOnScreen(window uintptr, x, y int) bool {
+	return bool(C.windowIsOnScreen(unsafe.Pointer(window), C.int(x), C.int(y)))
+}
+
+// WindowSetTabOrder overrides the order for keyboard navigation, so that the
+// widgets are visited first, in order.  The remaining widgets follow in their
+// default order.  An empty list restores the default order.
+func WindowSetTabOrder(window uintptr, widgets []uintptr) {
+	if len(widgets) == 0 {
+		C.windowSetTabOrder(unsafe.Pointer(window), nil, 0)
+		return
+	}
+
+	handles := make([]unsafe.Pointer, len(widgets))
+	for i, v := range widgets {
+		handles[i] = unsafe.Pointer(v)
+	}
+	C.windowSetTabOrder(unsafe.Pointer(window), &handles[0], C.size_t(len(handles)))
+}
+
+// WindowSetMaxSize limits the size of the window.  A zero for either
+// dimension means that the dimension is not limited.
+func WindowSetMaxSize(window uintptr, width, height int) {
+	C.windowSetMaxSize(unsafe.Pointer(window), C.int(width), C.int(height))
+}
+
+// WindowSetState changes whether the window is minimized, maximized, or
+// fullscreen.  The states are normal (0), minimized (1), maximized (2), and
+// fullscreen (3).
+func WindowSetState(window uintptr, state int) {
+	C.windowSetState(unsafe.Pointer(window), C.int(state))
+}
+
+// WindowSetCursor changes the window's cursor.  The name should be a CSS
+// cursor name.  An empty name restores the default cursor.
+func WindowSetCursor(window uintptr, name string) {
+	if name == "" {
+		C.windowSetCursor(unsafe.Pointer(window), nil)
+		return
+	}
+
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	C.windowSetCursor(unsafe.Pointer(window), cname)
+}
+
+// WindowSetBusy changes whether the window's contents accept input.
+func WindowSetBusy(window uintptr, busy bool) {
+	C.windowSetBusy(unsafe.Pointer(window), C.bool(busy))
+}
+
+// WindowState returns whether the window is minimized, maximized, or
+// fullscreen, using the same values as WindowSetState.
+func WindowState(window uintptr) int {
+	return int(C.windowState(unsafe.Pointer(window)))
+}
+
 func WindowScreenshot(handle uintptr) ([]byte, bool, int, int, int) {
 	var data unsafe.Pointer
 	var dataLen C.size_t
@@ -4,9 +4,18 @@ package gtkloop
 // #include <gtk/gtk.h>
 // #include "thunks.h"
 import "C"
+import (
+	"sync"
+	"unsafe"
+)
 
 var (
-	invokeFunction = make(chan func(), 1)
+	// Functions waiting for a callback from the main loop.  Every function
+	// added is matched by exactly one callback.  A mutex is used instead of
+	// a channel so that functions can be added from the GUI thread without
+	// blocking.
+	invokeFunctions []func()
+	invokeMutex     sync.Mutex
 )
 
 func Init() {
@@ -17,24 +26,45 @@ func Run() {
 	C.gtk_main()
 }
 
+// Iteration processes a single event, blocking if no events are pending.
+func Iteration() {
+	C.g_main_context_iteration(nil, C.TRUE)
+}
+
 func Stop() {
 	C.gtk_main_quit()
 }
 
+// ThreadID returns an identifier for the calling thread.
+func ThreadID() uintptr {
+	return uintptr(unsafe.Pointer(C.g_thread_self()))
+}
+
 // MainContextInvoke is a wrapper around g_main_context_invoke.
 func MainContextInvoke(function func()) {
-	invokeFunction <- function
+	pushFunction(function)
 	C.loopMainContextInvoke()
 }
 
 // IdleAdd is a wrapper around g_idle_add.
 func IdleAdd(function func()) {
-	invokeFunction <- function
+	pushFunction(function)
 	C.loopIdleAdd()
 }
 
+func pushFunction(function func()) {
+	invokeMutex.Lock()
+	invokeFunctions = append(invokeFunctions, function)
+	invokeMutex.Unlock()
+}
+
 //export mainContextInvokeCallback
 func mainContextInvokeCallback() {
-	fn := <-invokeFunction
+	invokeMutex.Lock()
+	fn := invokeFunctions[0]
+	invokeFunctions[0] = nil
+	invokeFunctions = invokeFunctions[1:]
+	invokeMutex.Unlock()
+
 	fn()
 }
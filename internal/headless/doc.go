@@ -0,0 +1,11 @@
+// Package headless provides a tree of simulated controls, which is used to
+// implement the GUI without a display.
+//
+// Each node in the tree records its bounds and minimum size, and can be
+// focused, sent key presses, and rendered into an image.  The package does
+// not know anything about the widgets, which are implemented by the callers
+// using the hooks provided on each Node.
+//
+// Like the rest of the GUI, the nodes are not safe for concurrent use, and
+// should only be modified from the GUI thread.
+package headless
@@ -0,0 +1,243 @@
+package headless
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"sort"
+)
+
+var (
+	// ErrNoFocus is returned by SendKey when there is no focused node to
+	// receive the key press.
+	ErrNoFocus = errors.New("no control has the keyboard focus")
+
+	// Node that currently has the keyboard focus.
+	focused *Node
+
+	// OnFocusChange, if not nil, is called after the keyboard focus moves.
+	// The argument is the node with the focus, or nil if no node has the
+	// focus.
+	OnFocusChange func(n *Node)
+)
+
+// Node is a simulated control.
+type Node struct {
+	parent   *Node
+	children []*Node
+	bounds   image.Rectangle
+	closed   bool
+
+	MinSize  image.Point // Minimum size, in pixels, for the control.
+	Disabled bool        // Disabled controls cannot take focus.
+	CanFocus bool        // Flag indicating that the control can take focus.
+	TabIndex int         // Override for the order of keyboard navigation.
+
+	// Paint, if not nil, is called to draw the control.  The bounds are in
+	// the coordinates of dst, which is clipped to the bounds of the parent.
+	Paint func(dst draw.Image, bounds image.Rectangle)
+	// OnFocus, if not nil, is called when the node receives the focus.
+	OnFocus func()
+	// OnBlur, if not nil, is called when the node loses the focus.
+	OnBlur func()
+	// OnKey, if not nil, is called for key presses while the node has the
+	// focus.  The enter key is reported as '\n', the escape key as '\x1b', and
+	// the backspace key as '\b'.
+	OnKey func(r rune)
+}
+
+// NewNode creates a new node.  If parent is not nil, the node is added as the
+// last child of parent.
+func NewNode(parent *Node) *Node {
+	n := &Node{parent: parent}
+	if parent != nil {
+		parent.children = append(parent.children, n)
+	}
+	return n
+}
+
+// Bounds returns the position of the node, relative to its parent.
+func (n *Node) Bounds() image.Rectangle {
+	return n.bounds
+}
+
+// SetBounds updates the position of the node, relative to its parent.
+func (n *Node) SetBounds(bounds image.Rectangle) {
+	n.bounds = bounds
+}
+
+// Origin returns the position of the node's children in the coordinates of
+// the root node.
+func (n *Node) Origin() image.Point {
+	ret := image.Point{}
+	for ; n != nil; n = n.parent {
+		ret = ret.Add(n.bounds.Min)
+	}
+	return ret
+}
+
+// Children returns the nodes that have n as their parent.
+func (n *Node) Children() []*Node {
+	return append([]*Node(nil), n.children...)
+}
+
+// IsClosed returns true if Close has been called for the node.
+func (n *Node) IsClosed() bool {
+	return n.closed
+}
+
+// Close removes the node from its parent.  If the node, or any of its
+// descendants, has the focus, it is blurred first.
+func (n *Node) Close() {
+	if n.closed {
+		return
+	}
+
+	if focused != nil && focused.IsWithin(n) {
+		Blur()
+	}
+
+	if n.parent != nil {
+		children := n.parent.children
+		for i, v := range children {
+			if v == n {
+				copy(children[i:], children[i+1:])
+				children[len(children)-1] = nil
+				n.parent.children = children[:len(children)-1]
+				break
+			}
+		}
+		n.parent = nil
+	}
+	n.closed = true
+}
+
+// IsWithin returns true if the node is ancestor, or a descendant of ancestor.
+func (n *Node) IsWithin(ancestor *Node) bool {
+	for ; n != nil; n = n.parent {
+		if n == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Focus moves the keyboard focus to the node.  The return value indicates
+// whether the node can take the focus.
+func (n *Node) Focus() bool {
+	if n.closed || n.Disabled || !n.CanFocus {
+		return false
+	}
+	if focused == n {
+		return true
+	}
+
+	blur()
+	focused = n
+	if n.OnFocus != nil {
+		n.OnFocus()
+	}
+	if OnFocusChange != nil {
+		OnFocusChange(n)
+	}
+	return true
+}
+
+// IsFocused returns true if the node has the keyboard focus.
+func (n *Node) IsFocused() bool {
+	return focused == n
+}
+
+// Focused returns the node with the keyboard focus, if any.
+func Focused() *Node {
+	return focused
+}
+
+// Blur removes the keyboard focus from the focused node, if any.
+func Blur() {
+	if blur() && OnFocusChange != nil {
+		OnFocusChange(nil)
+	}
+}
+
+func blur() bool {
+	n := focused
+	if n == nil {
+		return false
+	}
+
+	focused = nil
+	if n.OnBlur != nil {
+		n.OnBlur()
+	}
+	return true
+}
+
+// FocusNext moves the keyboard focus to the next node within root, as if the
+// user had pressed the tab key.  Nodes with a positive TabIndex are visited
+// first, in increasing order, followed by the remaining nodes in the order of
+// the tree.  The return value indicates whether any node took the focus.
+func FocusNext(root *Node) bool {
+	order := []*Node(nil)
+	root.walk(func(n *Node) {
+		if n.CanFocus && !n.Disabled {
+			order = append(order, n)
+		}
+	})
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i].TabIndex, order[j].TabIndex
+		if a <= 0 || b <= 0 {
+			return a > 0 && b <= 0
+		}
+		return a < b
+	})
+	if len(order) == 0 {
+		return false
+	}
+
+	// Find the node after the focused node, wrapping around at the end.
+	next := 0
+	for i, v := range order {
+		if v == focused {
+			next = (i + 1) % len(order)
+			break
+		}
+	}
+	return order[next].Focus()
+}
+
+// walk calls fn for the node and its descendants, in depth-first order.
+func (n *Node) walk(fn func(*Node)) {
+	fn(n)
+	for _, v := range n.children {
+		v.walk(fn)
+	}
+}
+
+// SendKey delivers a key press to the node with the keyboard focus.
+func SendKey(r rune) error {
+	if focused == nil {
+		return ErrNoFocus
+	}
+	if focused.OnKey != nil && !focused.Disabled {
+		focused.OnKey(r)
+	}
+	return nil
+}
+
+// Render draws the node and its descendants.  The offset is the position of
+// the node's parent in the coordinates of dst.
+func (n *Node) Render(dst *image.RGBA, offset image.Point) {
+	bounds := n.bounds.Add(offset)
+	clip, ok := dst.SubImage(bounds.Intersect(dst.Rect)).(*image.RGBA)
+	if !ok || clip.Rect.Empty() {
+		return
+	}
+
+	if n.Paint != nil {
+		n.Paint(clip, bounds)
+	}
+	for _, v := range n.children {
+		v.Render(clip, bounds.Min)
+	}
+}
@@ -0,0 +1,139 @@
+package headless
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"reflect"
+	"testing"
+)
+
+func TestFocus(t *testing.T) {
+	log := bytes.NewBuffer(nil)
+	root := NewNode(nil)
+	nodes := make([]*Node, 3)
+	for i := range nodes {
+		letter := byte('a' + i)
+		nodes[i] = NewNode(root)
+		nodes[i].CanFocus = true
+		nodes[i].OnFocus = func() { log.Write([]byte{'f', letter}) }
+		nodes[i].OnBlur = func() { log.Write([]byte{'b', letter}) }
+		nodes[i].OnKey = func(r rune) { log.Write([]byte{'k', letter}) }
+	}
+	nodes[2].Disabled = true
+
+	if nodes[2].Focus() {
+		t.Errorf("disabled node took focus")
+	}
+	if err := SendKey('x'); err != ErrNoFocus {
+		t.Errorf("want ErrNoFocus, got %v", err)
+	}
+	for _, v := range nodes[:2] {
+		if !v.Focus() {
+			t.Errorf("node failed to take focus")
+		}
+		if err := SendKey('x'); err != nil {
+			t.Errorf("failed to send key, %s", err)
+		}
+	}
+	// Closing an ancestor should blur the focused node.
+	root.Close()
+
+	const want = "fakabafbkbbb"
+	if got := log.String(); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	if Focused() != nil {
+		t.Errorf("unexpected focused node after close")
+	}
+}
+
+func TestFocusNext(t *testing.T) {
+	root := NewNode(nil)
+	nodes := make([]*Node, 4)
+	for i := range nodes {
+		nodes[i] = NewNode(root)
+		nodes[i].CanFocus = true
+	}
+	nodes[1].Disabled = true
+	nodes[2].TabIndex = 2
+	nodes[3].TabIndex = 1
+	defer root.Close()
+
+	changes := []*Node(nil)
+	OnFocusChange = func(n *Node) {
+		changes = append(changes, n)
+	}
+	defer func() {
+		OnFocusChange = nil
+	}()
+
+	// Nodes with a tab index come first, and disabled nodes are skipped.
+	want := []*Node{nodes[3], nodes[2], nodes[0], nodes[3]}
+	for i, v := range want {
+		if !FocusNext(root) {
+			t.Errorf("case %d: no node took focus", i)
+		}
+		if got := Focused(); got != v {
+			t.Errorf("case %d: incorrect focused node", i)
+		}
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("incorrect focus changes, %v", changes)
+	}
+}
+
+func TestClose(t *testing.T) {
+	root := NewNode(nil)
+	a, b, c := NewNode(root), NewNode(root), NewNode(root)
+
+	b.Close()
+	if !b.IsClosed() {
+		t.Errorf("node not closed")
+	}
+	if got := root.Children(); !reflect.DeepEqual(got, []*Node{a, c}) {
+		t.Errorf("unexpected children after close, %v", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	root := NewNode(nil)
+	root.SetBounds(image.Rect(0, 0, 20, 20))
+	root.Paint = func(dst draw.Image, r image.Rectangle) {
+		Fill(dst, r, Background)
+	}
+	child := NewNode(root)
+	child.SetBounds(image.Rect(5, 5, 40, 40))
+	child.Paint = func(dst draw.Image, r image.Rectangle) {
+		Fill(dst, r, Accent)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, 20, 20))
+	root.Render(img, image.Point{})
+
+	if got := img.RGBAAt(0, 0); got != Background {
+		t.Errorf("unexpected color outside of child, %v", got)
+	}
+	if got := img.RGBAAt(19, 19); got != Accent {
+		t.Errorf("unexpected color inside of child, %v", got)
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	cases := []struct {
+		text  string
+		width int
+		out   []string
+	}{
+		{"", 70, []string{""}},
+		{"Hello world", 70, []string{"Hello", "world"}},
+		{"Hello world", 77, []string{"Hello world"}},
+		{"a b\nc", 70, []string{"a b", "c"}},
+	}
+
+	for i, v := range cases {
+		if got := WrapText(v.text, v.width); !reflect.DeepEqual(got, v.out) {
+			t.Errorf("case %d: want %q, got %q", i, v.out, got)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package headless
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	// CharWidth is the width, in pixels, of a character in the fixed-width
+	// font used to draw text.
+	CharWidth = 7
+	// LineHeight is the height, in pixels, of a line of text.
+	LineHeight = 13
+)
+
+// Colors used when painting controls.
+var (
+	Background = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	Face       = color.RGBA{0xe0, 0xe0, 0xe0, 0xff}
+	Border     = color.RGBA{0x80, 0x80, 0x80, 0xff}
+	Accent     = color.RGBA{0x33, 0x66, 0xcc, 0xff}
+	Text       = color.RGBA{0x00, 0x00, 0x00, 0xff}
+	GrayText   = color.RGBA{0x80, 0x80, 0x80, 0xff}
+)
+
+// TextSize returns the size, in pixels, required to draw the text on a single
+// line.
+func TextSize(text string) image.Point {
+	return image.Point{utf8.RuneCountInString(text) * CharWidth, LineHeight}
+}
+
+// WrapText breaks the text into lines so that each line is no wider than
+// the width, in pixels.  Words that are longer than the width are not broken.
+func WrapText(text string, width int) []string {
+	maxChars := width / CharWidth
+	if maxChars < 1 {
+		maxChars = 1
+	}
+
+	lines := []string(nil)
+	for _, paragraph := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			switch {
+			case line == "":
+				line = word
+			case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) <= maxChars:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Fill paints the rectangle with a solid color.
+func Fill(dst draw.Image, r image.Rectangle, c color.Color) {
+	draw.Draw(dst, r, image.NewUniform(c), image.Point{}, draw.Over)
+}
+
+// Stroke paints a one pixel border just inside the rectangle.
+func Stroke(dst draw.Image, r image.Rectangle, c color.Color) {
+	if r.Empty() {
+		return
+	}
+
+	Fill(dst, image.Rect(r.Min.X, r.Min.Y, r.Max.X, r.Min.Y+1), c)
+	Fill(dst, image.Rect(r.Min.X, r.Max.Y-1, r.Max.X, r.Max.Y), c)
+	Fill(dst, image.Rect(r.Min.X, r.Min.Y, r.Min.X+1, r.Max.Y), c)
+	Fill(dst, image.Rect(r.Max.X-1, r.Min.Y, r.Max.X, r.Max.Y), c)
+}
+
+// DrawText paints a single line of text.  The point specifies the top-left
+// corner of the text.
+func DrawText(dst draw.Image, pt image.Point, text string, c color.Color) {
+	d := font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(pt.X, pt.Y+basicfont.Face7x13.Ascent),
+	}
+	d.DrawString(text)
+}
+
+// DrawLines paints several lines of text, starting with the top-left corner
+// at the point.
+func DrawLines(dst draw.Image, pt image.Point, lines []string, c color.Color) {
+	for _, v := range lines {
+		DrawText(dst, pt, v, c)
+		pt.Y += LineHeight
+	}
+}
@@ -150,3 +150,82 @@ func (cb *ChangeFloat64CB) Set(elem js.Value, onchange func(float64)) {
 		elem.Set("oninput", js.Undefined())
 	}
 }
+
+// ParseTime converts the value of an HTMLInputElement with type time or
+// datetime-local.  If the value does not include a date, the date is taken
+// from the parameter date.
+func ParseTime(s string, date time.Time) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if value, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return value, nil
+		}
+	}
+
+	layout := "15:04"
+	if len(s) > len(layout) {
+		layout = "15:04:05"
+	}
+	value, err := time.ParseInLocation(layout, s, time.Local)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), value.Hour(), value.Minute(), value.Second(), 0, date.Location()), nil
+}
+
+type ChangeTimeCB struct {
+	callback
+	Fn   func(time.Time)
+	Date time.Time
+}
+
+func (cb *ChangeTimeCB) Set(elem js.Value, onchange func(time.Time)) {
+	assert.Assert((cb.Fn != nil) == cb.jsfunc.Truthy(), "callback not syncrhonized")
+
+	cb.Fn = onchange
+
+	if cb.Fn != nil && cb.jsfunc.IsUndefined() {
+		cb.jsfunc = js.FuncOf(func(js.Value, []js.Value) interface{} {
+			s := elem.Get("value").String()
+			if s != "" {
+				value, err := ParseTime(s, cb.Date)
+				assert.Assert(err == nil, "value of HTMLInput did not convert to time")
+				cb.Fn(value)
+			}
+			return nil
+		})
+		elem.Set("oninput", cb.jsfunc)
+	} else if cb.Fn == nil && !cb.jsfunc.IsUndefined() {
+		cb.release()
+		elem.Set("oninput", js.Undefined())
+	}
+}
+
+type ComboCB struct {
+	callback
+	Fn func(string, bool)
+}
+
+// Set installs a callback for an HTMLInputElement with a datalist.  The
+// callback is also passed a flag indicating whether the user selected one of
+// the options from the datalist.
+func (cb *ComboCB) Set(elem js.Value, onchange func(string, bool)) {
+	assert.Assert((cb.Fn != nil) == cb.jsfunc.Truthy(), "callback not syncrhonized")
+
+	cb.Fn = onchange
+
+	if cb.Fn != nil && cb.jsfunc.IsUndefined() {
+		cb.jsfunc = js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+			// Browsers do not agree on how to report that an option was
+			// selected.  Some send a generic Event, while others send an
+			// InputEvent.
+			inputType := args[0].Get("inputType")
+			selected := inputType.IsUndefined() || inputType.String() == "insertReplacementText"
+			cb.Fn(elem.Get("value").String(), selected)
+			return nil
+		})
+		elem.Set("oninput", cb.jsfunc)
+	} else if cb.Fn == nil && !cb.jsfunc.IsUndefined() {
+		cb.release()
+		elem.Set("oninput", js.Undefined())
+	}
+}
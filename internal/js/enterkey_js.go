@@ -56,3 +56,33 @@ func (cb *EnterKeyInt64CB) Set(elem js.Value, onenterkey func(int64)) {
 		elem.Set("onkeyup", js.Undefined())
 	}
 }
+
+type KeyStepCB struct {
+	callback
+	Fn func(int)
+}
+
+// Set installs a callback that reports when the user presses the up or down
+// arrow keys.  The callback receives +1 or -1 respectively.
+func (cb *KeyStepCB) Set(elem js.Value, onstep func(int)) {
+	cb.Fn = onstep
+
+	if cb.Fn != nil && cb.jsfunc.IsUndefined() {
+		cb.jsfunc = js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+			event := args[0]
+			switch event.Get("key").String() {
+			case "ArrowUp":
+				event.Call("preventDefault")
+				cb.Fn(1)
+			case "ArrowDown":
+				event.Call("preventDefault")
+				cb.Fn(-1)
+			}
+			return nil
+		})
+		elem.Set("onkeydown", cb.jsfunc)
+	} else if cb.Fn == nil && !cb.jsfunc.IsUndefined() {
+		cb.release()
+		elem.Set("onkeydown", js.Undefined())
+	}
+}
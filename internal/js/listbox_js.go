@@ -0,0 +1,72 @@
+package goeyjs
+
+import (
+	"syscall/js"
+)
+
+// SelectedIndices returns the indices of all selected options in a select
+// element.
+func SelectedIndices(elem js.Value) []int {
+	retval := []int(nil)
+
+	options := elem.Get("options")
+	for i, n := 0, options.Get("length").Int(); i < n; i++ {
+		if options.Index(i).Get("selected").Bool() {
+			retval = append(retval, i)
+		}
+	}
+	return retval
+}
+
+type SelectMultipleCB struct {
+	callback
+	Fn func([]int)
+}
+
+func (cb *SelectMultipleCB) Set(elem js.Value, onselect func([]int)) {
+	const event = "oninput"
+
+	cb.Fn = onselect
+
+	if cb.Fn != nil && cb.jsfunc.IsUndefined() {
+		cb.jsfunc = js.FuncOf(func(js.Value, []js.Value) interface{} {
+			cb.Fn(SelectedIndices(elem))
+			return nil
+		})
+		elem.Set(event, cb.jsfunc)
+	} else if cb.Fn == nil && !cb.jsfunc.IsUndefined() {
+		cb.release()
+		elem.Delete(event)
+	}
+}
+
+type ActivateCB struct {
+	callback
+	Fn func(int)
+}
+
+// Set installs a callback that reports when the user activates an option in a
+// select element, either by double-clicking or by pressing the enter key.
+func (cb *ActivateCB) Set(elem js.Value, onactivate func(int)) {
+	cb.Fn = onactivate
+
+	if cb.Fn != nil && cb.jsfunc.IsUndefined() {
+		cb.jsfunc = js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+			event := args[0]
+			if event.Get("type").String() == "keydown" && event.Get("key").String() != "Enter" {
+				return nil
+			}
+			if index := elem.Get("selectedIndex").Int(); index >= 0 {
+				event.Call("preventDefault")
+				cb.Fn(index)
+			}
+			return nil
+		})
+		elem.Set("ondblclick", cb.jsfunc)
+		elem.Set("onkeydown", cb.jsfunc)
+	} else if cb.Fn == nil && !cb.jsfunc.IsUndefined() {
+		cb.release()
+		elem.Delete("ondblclick")
+		elem.Delete("onkeydown")
+	}
+}
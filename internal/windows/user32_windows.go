@@ -16,6 +16,10 @@ var (
 	procGetWindowTextLength = moduser32.MustFindProc("GetWindowTextLengthW")
 	procSetWindowText       = moduser32.MustFindProc("SetWindowTextW")
 	procShowScrollBar       = moduser32.MustFindProc("ShowScrollBar")
+	procSetWindowsHookEx    = moduser32.MustFindProc("SetWindowsHookExW")
+	procCallNextHookEx      = moduser32.MustFindProc("CallNextHookEx")
+	procUnhookWindowsHookEx = moduser32.MustFindProc("UnhookWindowsHookEx")
+	procMonitorFromRect     = moduser32.MustFindProc("MonitorFromRect")
 )
 
 const (
@@ -39,8 +43,25 @@ const (
 	TBM_SETLINESIZE = win.WM_USER + 23
 
 	WS_EX_COMPOSITED = 0x02000000
+
+	WH_MOUSE       = 7
+	HC_ACTION      = 0
+	WM_MOUSEHWHEEL = 0x020E
+	WHEEL_DELTA    = 120
 )
 
+// HHOOK is a handle to a hook procedure.
+type HHOOK uintptr
+
+// MOUSEHOOKSTRUCTEX match the C structure of the same name.
+type MOUSEHOOKSTRUCTEX struct {
+	Pt           win.POINT
+	Hwnd         win.HWND
+	WHitTestCode uint32
+	DwExtraInfo  uintptr
+	MouseData    uint32
+}
+
 // NMSELCHANGE match the C structure of the same name.
 type NMSELCHANGE struct {
 	Nmhdr      win.NMHDR
@@ -108,3 +129,30 @@ func ShowScrollBar(hWnd win.HWND, wSBFlags uint, bShow win.BOOL) win.BOOL {
 	r0, _, _ := syscall.Syscall(procShowScrollBar.Addr(), 3, uintptr(hWnd), uintptr(wSBFlags), uintptr(bShow))
 	return win.BOOL(r0)
 }
+
+// SetWindowsHookEx is a wrapper.
+func SetWindowsHookEx(idHook int32, lpfn uintptr, hmod win.HINSTANCE, dwThreadId uint32) (HHOOK, error) {
+	r0, _, errno := syscall.Syscall6(procSetWindowsHookEx.Addr(), 4, uintptr(idHook), lpfn, uintptr(hmod), uintptr(dwThreadId), 0, 0)
+	if r0 == 0 {
+		return 0, errno
+	}
+	return HHOOK(r0), nil
+}
+
+// CallNextHookEx is a wrapper.
+func CallNextHookEx(hhk HHOOK, nCode int32, wParam, lParam uintptr) uintptr {
+	r0, _, _ := syscall.Syscall6(procCallNextHookEx.Addr(), 4, uintptr(hhk), uintptr(nCode), wParam, lParam, 0, 0)
+	return r0
+}
+
+// UnhookWindowsHookEx is a wrapper.
+func UnhookWindowsHookEx(hhk HHOOK) bool {
+	r0, _, _ := syscall.Syscall(procUnhookWindowsHookEx.Addr(), 1, uintptr(hhk), 0, 0)
+	return r0 != 0
+}
+
+// MonitorFromRect is a wrapper.
+func MonitorFromRect(lprc *win.RECT, dwFlags uint32) win.HMONITOR {
+	r0, _, _ := syscall.Syscall(procMonitorFromRect.Addr(), 2, uintptr(unsafe.Pointer(lprc)), uintptr(dwFlags), 0)
+	return win.HMONITOR(r0)
+}
@@ -19,6 +19,7 @@ type IntInput struct {
 	Value       int64             // Value is the current value for the field
 	Placeholder string            // Placeholder is a descriptive text that can be displayed when the field is empty
 	Disabled    bool              // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus   bool              // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
 	Min, Max    int64             // Min and Max set the range of Value
 	OnChange    func(value int64) // OnChange will be called whenever the user changes the value for this field
 	OnFocus     func()            // OnFocus will be called whenever the field receives the keyboard focus
@@ -41,7 +42,11 @@ func (w *IntInput) Mount(parent base.Control) (base.Element, error) {
 	w.UpdateValue()
 
 	// Forward to the platform-dependant code
-	return w.mount(parent)
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
 }
 
 // UpdateRange sets a default range when the fields Min and Max are both
@@ -0,0 +1,95 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+	"strconv"
+	"strings"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type intinputElement struct {
+	Control
+	props IntInput
+	spin  spinText
+}
+
+func (w *IntInput) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &intinputElement{
+		Control: newControl(parent, 120, 23),
+	}
+	retval.node.CanFocus = true
+	retval.node.OnFocus = retval.onFocus
+	retval.node.OnBlur = retval.onBlur
+	retval.node.OnKey = retval.onKey
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// commit parses the text, and updates the value.
+func (w *intinputElement) commit() {
+	value, err := strconv.ParseInt(strings.TrimSpace(w.spin.text), 10, 64)
+	if err == nil {
+		props := w.props
+		props.Value = value
+		props.UpdateValue()
+		if props.Value != w.props.Value {
+			w.props.Value = props.Value
+			if w.props.OnChange != nil {
+				w.props.OnChange(props.Value)
+			}
+		}
+	}
+	w.spin.text = strconv.FormatInt(w.props.Value, 10)
+}
+
+func (w *intinputElement) onFocus() {
+	w.spin.selected = true
+	if w.props.OnFocus != nil {
+		w.props.OnFocus()
+	}
+}
+
+func (w *intinputElement) onBlur() {
+	w.commit()
+	w.spin.selected = false
+	if w.props.OnBlur != nil {
+		w.props.OnBlur()
+	}
+}
+
+func (w *intinputElement) onKey(r rune) {
+	if r != '\n' {
+		w.spin.key(r)
+		return
+	}
+
+	w.commit()
+	if w.props.OnEnterKey != nil {
+		w.props.OnEnterKey(w.props.Value)
+	}
+}
+
+func (w *intinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	paintTextField(dst, bounds, w.node, w.spin.text, w.props.Placeholder, w.props.Disabled)
+}
+
+func (w *intinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *intinputElement) updateProps(data *IntInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+	w.spin.text = strconv.FormatInt(data.Value, 10)
+
+	return nil
+}
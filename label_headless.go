@@ -0,0 +1,54 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+	"strings"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type labelElement struct {
+	Control
+	text string
+}
+
+func (w *Label) mount(parent base.Control) (base.Element, error) {
+	retval := &labelElement{Control: newControl(parent, 0, 0)}
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+func (w *labelElement) paint(dst draw.Image, bounds image.Rectangle) {
+	headless.DrawLines(dst, bounds.Min, strings.Split(w.text, "\n"), headless.Text)
+}
+
+func (w *labelElement) Props() base.Widget {
+	return &Label{
+		Text: w.text,
+	}
+}
+
+func (w *labelElement) updateProps(data *Label) error {
+	lines := strings.Split(data.Text, "\n")
+	width := 0
+	for _, v := range lines {
+		if x := headless.TextSize(v).X; x > width {
+			width = x
+		}
+	}
+	// Ensure that the label has a non-zero size, even if the text is empty.
+	if width < headless.CharWidth {
+		width = headless.CharWidth
+	}
+	w.node.MinSize = image.Point{width, len(lines) * headless.LineHeight}
+	w.text = data.Text
+
+	return nil
+}
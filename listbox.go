@@ -0,0 +1,92 @@
+package goey
+
+import (
+	"sort"
+
+	"github.com/chaolihf/goey/base"
+)
+
+var (
+	listboxKind = base.NewKind("github.com/chaolihf/goey.ListBox")
+)
+
+// ListBox describes a widget that shows a list of choices in a bordered,
+// scrollable box.  Depending on the field Multiple, users can select either
+// a single item, or any number of items.
+//
+// Note that changing the value of the field Multiple is not supported on all
+// platforms.
+type ListBox struct {
+	Items      []string             // Items is an array of strings representing the user's possible choices
+	Selected   []int                // Selected contains the indices of the currently selected items
+	Multiple   bool                 // Multiple is a flag indicating that the user can select more than one item
+	Disabled   bool                 // Disabled is a flag indicating that the user cannot interact with this field
+	OnChange   func(selected []int) // OnChange will be called whenever the user changes the selected items
+	OnActivate func(index int)      // OnActivate will be called whenever the user double-clicks an item, or hits the enter key
+	OnFocus    func()               // OnFocus will be called whenever the field receives the keyboard focus
+	OnBlur     func()               // OnBlur will be called whenever the field loses the keyboard focus
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*ListBox) Kind() *base.Kind {
+	return &listboxKind
+}
+
+// Mount creates a list box control in the GUI.
+// The newly created widget will be a child of the widget specified by parent.
+func (w *ListBox) Mount(parent base.Control) (base.Element, error) {
+	// Make sure that the selection is coherent with the list of items.
+	w.UpdateValue()
+
+	// Forward to the platform-dependant code
+	return w.mount(parent)
+}
+
+// UpdateValue will ensure that Selected only contains indices that are within
+// the range of choices provided by w.Items.  The indices will be sorted, and
+// any duplicates removed.  If Multiple is false, at most one index will be
+// kept.
+func (w *ListBox) UpdateValue() {
+	w.Selected = normalizeSelection(w.Selected, len(w.Items), w.Multiple)
+}
+
+func normalizeSelection(selected []int, length int, multiple bool) []int {
+	retval := []int(nil)
+	for _, v := range selected {
+		if v >= 0 && v < length {
+			retval = append(retval, v)
+		}
+	}
+	if len(retval) == 0 {
+		return nil
+	}
+
+	sort.Ints(retval)
+	if !multiple {
+		return retval[:1]
+	}
+
+	// Remove duplicates
+	n := 1
+	for _, v := range retval[1:] {
+		if v != retval[n-1] {
+			retval[n] = v
+			n++
+		}
+	}
+	return retval[:n]
+}
+
+func (*listboxElement) Kind() *base.Kind {
+	return &listboxKind
+}
+
+func (w *listboxElement) UpdateProps(data base.Widget) error {
+	lb := data.(*ListBox)
+
+	// Make sure that the selection is coherent with the list of items.
+	lb.UpdateValue()
+	// Forward to the platform-dependant code
+	return w.updateProps(lb)
+}
@@ -0,0 +1,53 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+type listboxElement struct {
+	control *cocoa.Text
+}
+
+func (w *ListBox) mount(parent base.Control) (base.Element, error) {
+	control := cocoa.NewText(parent.Handle, "list box")
+
+	retval := &listboxElement{
+		control: control,
+	}
+	return retval, nil
+}
+
+func (w *listboxElement) Close() {
+	if w.control != nil {
+		w.control.Close()
+		w.control = nil
+	}
+}
+
+func (w *listboxElement) Layout(bc base.Constraints) base.Size {
+	px := w.MinIntrinsicWidth(base.Inf)
+	h := w.MinIntrinsicHeight(base.Inf)
+	return bc.Constrain(base.Size{px, h})
+}
+
+func (w *listboxElement) MinIntrinsicHeight(width base.Length) base.Length {
+	return 20 * base.DIP
+}
+
+func (w *listboxElement) MinIntrinsicWidth(base.Length) base.Length {
+	return 200 * base.DIP
+}
+
+func (w *listboxElement) SetBounds(bounds base.Rectangle) {
+	px := bounds.Pixels()
+	w.control.SetFrame(px.Min.X, px.Min.Y, px.Dx(), px.Dy())
+}
+
+func (w *listboxElement) updateProps(data *ListBox) error {
+	return nil
+}
@@ -0,0 +1,125 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type listboxElement struct {
+	Control
+
+	onChange   func([]int)
+	onActivate func(int)
+	onFocus    func()
+	onBlur     func()
+}
+
+func (w *ListBox) mount(parent base.Control) (base.Element, error) {
+	control := gtk.MountListbox(parent.Handle, serializeItems(w.Items),
+		w.Selected, w.Multiple, w.Disabled,
+		w.OnChange != nil, w.OnActivate != nil, w.OnFocus != nil, w.OnBlur != nil)
+
+	retval := &listboxElement{
+		Control:    Control{control},
+		onChange:   w.OnChange,
+		onActivate: w.OnActivate,
+		onFocus:    w.OnFocus,
+		onBlur:     w.OnBlur,
+	}
+	gtk.RegisterWidget(control, retval)
+
+	return retval, nil
+}
+
+func (w *listboxElement) selected() []int {
+	retval := []int(nil)
+	for i, count := 0, gtk.ListboxItemCount(w.handle); i < count; i++ {
+		if gtk.ListboxIsSelected(w.handle, i) {
+			retval = append(retval, i)
+		}
+	}
+	return retval
+}
+
+func (w *listboxElement) OnChange() {
+	if w.onChange != nil {
+		w.onChange(w.selected())
+	}
+}
+
+func (w *listboxElement) OnActivate(index int) {
+	if w.onActivate != nil {
+		w.onActivate(index)
+	}
+}
+
+func (w *listboxElement) OnFocus() {
+	w.onFocus()
+}
+
+func (w *listboxElement) OnBlur() {
+	w.onBlur()
+}
+
+func (w *listboxElement) Layout(bc base.Constraints) base.Size {
+	// The scrolled window does not request any height for the list, so the
+	// list is measured directly.
+	list := Control{gtk.ListboxList(w.handle)}
+	size := list.Layout(base.Loose(bc.Max))
+	if min := w.MinIntrinsicHeight(base.Inf); size.Height < min {
+		size.Height = min
+	} else if max := 8 * 24 * DIP; size.Height > max {
+		size.Height = max
+	}
+	return bc.Constrain(size)
+}
+
+func (w *listboxElement) MinIntrinsicHeight(width base.Length) base.Length {
+	// Enough room to show a few items.
+	return 3 * 24 * DIP
+}
+
+func (w *listboxElement) TakeFocus() bool {
+	control := Control{gtk.ListboxList(w.handle)}
+	return control.TakeFocus()
+}
+
+func (w *listboxElement) TypeKeys(text string) chan error {
+	control := Control{gtk.ListboxList(w.handle)}
+	return control.TypeKeys(text)
+}
+
+func (w *listboxElement) Props() base.Widget {
+	items := make([]string, gtk.ListboxItemCount(w.handle))
+	for i := range items {
+		items[i] = gtk.ListboxItem(w.handle, i)
+	}
+
+	return &ListBox{
+		Items:      items,
+		Selected:   w.selected(),
+		Multiple:   gtk.ListboxMultiple(w.handle),
+		Disabled:   !gtk.WidgetSensitive(w.handle),
+		OnChange:   w.onChange,
+		OnActivate: w.onActivate,
+		OnFocus:    w.onFocus,
+		OnBlur:     w.onBlur,
+	}
+}
+
+func (w *listboxElement) updateProps(data *ListBox) error {
+	gtk.ListboxUpdate(w.handle, serializeItems(data.Items),
+		data.Selected, data.Multiple, data.Disabled,
+		data.OnChange != nil, data.OnActivate != nil, data.OnFocus != nil, data.OnBlur != nil)
+
+	w.onChange = data.OnChange
+	w.onActivate = data.OnActivate
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+
+	return nil
+}
@@ -0,0 +1,116 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type listboxElement struct {
+	Control
+	props ListBox
+}
+
+func (w *ListBox) mount(parent base.Control) (base.Element, error) {
+	retval := &listboxElement{
+		Control: newControl(parent, 120, 3*24),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.node.OnKey = func(r rune) {
+		if r == '\n' && len(retval.props.Selected) > 0 {
+			retval.Activate(retval.props.Selected[0])
+		}
+	}
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// Select simulates a user clicking on an item.  If multiple selection is
+// enabled, the selection for the item is toggled.  Otherwise, the item
+// replaces the current selection.
+func (w *listboxElement) Select(index int) {
+	if w.props.Disabled || index < 0 || index >= len(w.props.Items) {
+		return
+	}
+
+	selected := []int{index}
+	if w.props.Multiple {
+		selected = make([]int, 0, len(w.props.Selected)+1)
+		found := false
+		for _, v := range w.props.Selected {
+			if v == index {
+				found = true
+			} else {
+				selected = append(selected, v)
+			}
+		}
+		if !found {
+			selected = append(selected, index)
+		}
+	}
+
+	w.props.Selected = normalizeSelection(selected, len(w.props.Items), w.props.Multiple)
+	if w.props.OnChange != nil {
+		w.props.OnChange(append([]int(nil), w.props.Selected...))
+	}
+}
+
+// Activate simulates a user double-clicking on an item.
+func (w *listboxElement) Activate(index int) {
+	if w.props.Disabled || index < 0 || index >= len(w.props.Items) {
+		return
+	}
+
+	if w.props.OnActivate != nil {
+		w.props.OnActivate(index)
+	}
+}
+
+func (w *listboxElement) paint(dst draw.Image, bounds image.Rectangle) {
+	paintFrame(dst, bounds, headless.Background, w.node)
+
+	const rowHeight = 24
+	selected := w.props.Selected
+	for i, v := range w.props.Items {
+		row := image.Rect(bounds.Min.X+1, bounds.Min.Y+1+i*rowHeight, bounds.Max.X-1, bounds.Min.Y+1+(i+1)*rowHeight)
+		clr := textColor(w.props.Disabled)
+		if len(selected) > 0 && selected[0] == i {
+			headless.Fill(dst, row, headless.Accent)
+			clr = headless.Background
+			selected = selected[1:]
+		}
+		headless.DrawText(dst, leftText(row), v, clr)
+	}
+}
+
+func (w *listboxElement) Layout(bc base.Constraints) base.Size {
+	size := base.Size{
+		Width:  base.FromPixelsX(w.node.MinSize.X),
+		Height: base.FromPixelsY(len(w.props.Items) * 24),
+	}
+	if min := w.MinIntrinsicHeight(base.Inf); size.Height < min {
+		size.Height = min
+	} else if max := 8 * 24 * DIP; size.Height > max {
+		size.Height = max
+	}
+	return bc.Constrain(size)
+}
+
+func (w *listboxElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *listboxElement) updateProps(data *ListBox) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
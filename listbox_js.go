@@ -0,0 +1,85 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	goeyjs "github.com/chaolihf/goey/internal/js"
+)
+
+type listboxElement struct {
+	Control
+
+	onChange   goeyjs.SelectMultipleCB
+	onActivate goeyjs.ActivateCB
+	onFocus    goeyjs.FocusCB
+	onBlur     goeyjs.BlurCB
+}
+
+func (w *ListBox) mount(parent base.Control) (base.Element, error) {
+	// Create the control
+	handle := goeyjs.CreateElement("select", "goey form-control")
+	handle.Set("size", 4)
+	parent.Handle.Call("appendChild", handle)
+
+	// Create the element
+	retval := &listboxElement{
+		Control: Control{handle},
+	}
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+func (w *listboxElement) Close() {
+	w.onChange.Close()
+	w.onActivate.Close()
+	w.onFocus.Close()
+	w.onBlur.Close()
+
+	w.Control.Close()
+}
+
+func (w *listboxElement) Props() base.Widget {
+	items := []string{}
+	n := w.handle.Get("length").Int()
+	for i := 0; i < n; i++ {
+		items = append(items,
+			w.handle.Index(i).Get("text").String())
+	}
+
+	return &ListBox{
+		Items:      items,
+		Selected:   goeyjs.SelectedIndices(w.handle),
+		Multiple:   w.handle.Get("multiple").Truthy(),
+		Disabled:   w.handle.Get("disabled").Truthy(),
+		OnChange:   w.onChange.Fn,
+		OnActivate: w.onActivate.Fn,
+		OnFocus:    w.onFocus.Fn,
+		OnBlur:     w.onBlur.Fn,
+	}
+}
+
+func (w *listboxElement) updateProps(data *ListBox) error {
+	w.handle.Set("multiple", data.Multiple)
+	updateOptionList(w.handle, data.Items)
+
+	// Update the selection
+	selected := data.Selected
+	for i := range data.Items {
+		isSelected := len(selected) > 0 && selected[0] == i
+		if isSelected {
+			selected = selected[1:]
+		}
+		w.handle.Index(i).Set("selected", isSelected)
+	}
+
+	w.handle.Set("disabled", data.Disabled)
+	w.onChange.Set(w.handle, data.OnChange)
+	w.onActivate.Set(w.handle, data.OnActivate)
+	w.onFocus.Set(w.handle, data.OnFocus)
+	w.onBlur.Set(w.handle, data.OnBlur)
+
+	return nil
+}
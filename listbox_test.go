@@ -0,0 +1,80 @@
+package goey
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chaolihf/goey/base"
+)
+
+func TestListBoxMount(t *testing.T) {
+	items := []string{"Apple", "Banana", "Cherry"}
+
+	testMountWidgets(t,
+		&ListBox{Items: items},
+		&ListBox{Items: items, Selected: []int{1}},
+		&ListBox{Items: items, Selected: []int{0, 2}, Multiple: true},
+		&ListBox{Items: items, Disabled: true},
+		&ListBox{Items: []string{"Only"}, Selected: []int{0}},
+	)
+}
+
+func TestListBoxClose(t *testing.T) {
+	items := []string{"Apple", "Banana", "Cherry"}
+
+	testCloseWidgets(t,
+		&ListBox{Items: items},
+		&ListBox{Items: items, Selected: []int{0, 2}, Multiple: true},
+		&ListBox{Items: items, Disabled: true},
+	)
+}
+
+func TestListBoxOnFocus(t *testing.T) {
+	items := []string{"Apple", "Banana", "Cherry"}
+
+	testCheckFocusAndBlur(t,
+		&ListBox{Items: items},
+		&ListBox{Items: items, Multiple: true},
+		&ListBox{Items: items},
+	)
+}
+
+func TestListBoxUpdateProps(t *testing.T) {
+	items := []string{"Apple", "Banana", "Cherry"}
+
+	testUpdateWidgets(t, []base.Widget{
+		&ListBox{Items: items, Selected: []int{1}},
+		&ListBox{Items: items, Selected: []int{0, 2}, Multiple: true},
+		&ListBox{Items: items, Disabled: true},
+	}, []base.Widget{
+		&ListBox{Items: []string{"A", "B"}, Selected: []int{0}},
+		&ListBox{Items: items, Selected: []int{1}, Multiple: true, Disabled: true},
+		&ListBox{Items: items, Selected: []int{2}},
+	})
+}
+
+func TestListBoxUpdateValue(t *testing.T) {
+	cases := []struct {
+		selected []int
+		length   int
+		multiple bool
+		out      []int
+	}{
+		{nil, 3, false, nil},
+		{[]int{}, 3, true, nil},
+		{[]int{1}, 3, false, []int{1}},
+		{[]int{3}, 3, false, nil},
+		{[]int{-1, 2}, 3, false, []int{2}},
+		{[]int{2, 0}, 3, false, []int{0}},
+		{[]int{2, 0}, 3, true, []int{0, 2}},
+		{[]int{1, 1, 0, 5}, 3, true, []int{0, 1}},
+	}
+
+	for i, v := range cases {
+		w := ListBox{Items: make([]string, v.length), Selected: v.selected, Multiple: v.multiple}
+		w.UpdateValue()
+		if !reflect.DeepEqual(w.Selected, v.out) {
+			t.Errorf("case %d: got %v, want %v", i, w.Selected, v.out)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+//go:build !headless
+// +build !headless
+
+package goey
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/goey/base"
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+var (
+	listboxClassName     = []uint16{'L', 'I', 'S', 'T', 'B', 'O', 'X', 0}
+	oldListboxWindowProc uintptr
+)
+
+func (w *ListBox) mount(parent base.Control) (base.Element, error) {
+	style := uint32(win.WS_CHILD | win.WS_VISIBLE | win.WS_TABSTOP | win.WS_VSCROLL | win.LBS_NOTIFY | win.LBS_NOINTEGRALHEIGHT)
+	if w.Multiple {
+		style |= win.LBS_EXTENDEDSEL
+	}
+	hwnd, _, err := createControlWindow(win.WS_EX_CLIENTEDGE, &listboxClassName[0], "", style, parent.HWnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the font for the window
+	if hFont := win2.MessageFont(); hFont != 0 {
+		win.SendMessage(hwnd, win.WM_SETFONT, uintptr(hFont), 0)
+	}
+
+	if w.Disabled {
+		win.EnableWindow(hwnd, false)
+	}
+
+	// Add items to the control
+	longestString, err := listboxAddItems(hwnd, w.Items)
+	if err != nil {
+		win.DestroyWindow(hwnd)
+		return nil, err
+	}
+
+	// Subclass the window procedure
+	subclassWindowProcedure(hwnd, &oldListboxWindowProc, listboxWindowProc)
+
+	retval := &listboxElement{
+		Control:       Control{hwnd},
+		multiple:      w.Multiple,
+		onChange:      w.OnChange,
+		onActivate:    w.OnActivate,
+		onFocus:       w.OnFocus,
+		onBlur:        w.OnBlur,
+		longestString: longestString,
+	}
+	retval.setSelected(w.Selected)
+	win.SetWindowLongPtr(hwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(retval)))
+
+	return retval, nil
+}
+
+func listboxAddItems(hwnd win.HWND, items []string) (string, error) {
+	longestString := ""
+	for _, v := range items {
+		text, err := syscall.UTF16PtrFromString(v)
+		if err != nil {
+			return "", err
+		}
+		win.SendMessage(hwnd, win.LB_ADDSTRING, 0, uintptr(unsafe.Pointer(text)))
+
+		if len(v) > len(longestString) {
+			longestString = v
+		}
+	}
+
+	return longestString, nil
+}
+
+type listboxElement struct {
+	Control
+	multiple   bool
+	onChange   func(selected []int)
+	onActivate func(index int)
+	onFocus    func()
+	onBlur     func()
+
+	longestString  string
+	preferredWidth base.Length
+}
+
+func (w *listboxElement) itemCount() int {
+	return int(win.SendMessage(w.Hwnd, win.LB_GETCOUNT, 0, 0))
+}
+
+func (w *listboxElement) itemHeight() base.Length {
+	height := win.SendMessage(w.Hwnd, win.LB_GETITEMHEIGHT, 0, 0)
+	return base.FromPixelsY(int(height))
+}
+
+func (w *listboxElement) selected() []int {
+	if !w.multiple {
+		cursel := win.SendMessage(w.Hwnd, win.LB_GETCURSEL, 0, 0)
+		// See note in selectinputElement.Props about checking for errors.
+		if int32(cursel) == win.LB_ERR {
+			return nil
+		}
+		return []int{int(cursel)}
+	}
+
+	count := int32(win.SendMessage(w.Hwnd, win.LB_GETSELCOUNT, 0, 0))
+	if count <= 0 {
+		return nil
+	}
+	items := make([]int32, count)
+	win.SendMessage(w.Hwnd, win.LB_GETSELITEMS, uintptr(count), uintptr(unsafe.Pointer(&items[0])))
+	retval := make([]int, count)
+	for i, v := range items {
+		retval[i] = int(v)
+	}
+	return retval
+}
+
+func (w *listboxElement) setSelected(selected []int) {
+	if !w.multiple {
+		if len(selected) > 0 {
+			win.SendMessage(w.Hwnd, win.LB_SETCURSEL, uintptr(selected[0]), 0)
+		} else {
+			win.SendMessage(w.Hwnd, win.LB_SETCURSEL, ^uintptr(0), 0)
+		}
+		return
+	}
+
+	// Clear the selection, and then select the items.
+	win.SendMessage(w.Hwnd, win.LB_SETSEL, 0, ^uintptr(0))
+	for _, v := range selected {
+		win.SendMessage(w.Hwnd, win.LB_SETSEL, 1, uintptr(v))
+	}
+}
+
+func (w *listboxElement) Layout(bc base.Constraints) base.Size {
+	width := w.MinIntrinsicWidth(0)
+	// Try to show all of the items, but not too many.
+	rows := w.itemCount()
+	if rows < 3 {
+		rows = 3
+	} else if rows > 8 {
+		rows = 8
+	}
+	height := w.itemHeight().Scale(rows, 1) + 4*DIP
+	return bc.Constrain(base.Size{width, height})
+}
+
+func (w *listboxElement) MinIntrinsicHeight(width base.Length) base.Length {
+	// Enough room to show three items, and the border.
+	return w.itemHeight().Scale(3, 1) + 4*DIP
+}
+
+func (w *listboxElement) MinIntrinsicWidth(height base.Length) base.Length {
+	if w.preferredWidth == 0 {
+		text, err := syscall.UTF16FromString(w.longestString)
+		if err != nil {
+			w.preferredWidth = 75 * DIP
+		} else {
+			width, _ := w.CalcRect(text)
+			// Add room for the scrollbar
+			scrollbar := win.GetSystemMetrics(win.SM_CXVSCROLL)
+			w.preferredWidth = base.FromPixelsX(int(width+scrollbar)).Scale(13, 10)
+		}
+		if w.preferredWidth < 75*DIP {
+			w.preferredWidth = 75 * DIP
+		}
+	}
+	return w.preferredWidth
+}
+
+func (w *listboxElement) Props() base.Widget {
+	items := make([]string, w.itemCount())
+	for i := range items {
+		length := win.SendMessage(w.Hwnd, win.LB_GETTEXTLEN, uintptr(i), 0)
+		buffer := make([]uint16, length+1)
+		win.SendMessage(w.Hwnd, win.LB_GETTEXT, uintptr(i), uintptr(unsafe.Pointer(&buffer[0])))
+		items[i] = syscall.UTF16ToString(buffer)
+	}
+
+	return &ListBox{
+		Items:      items,
+		Selected:   w.selected(),
+		Multiple:   w.multiple,
+		Disabled:   !win.IsWindowEnabled(w.Hwnd),
+		OnChange:   w.onChange,
+		OnActivate: w.onActivate,
+		OnFocus:    w.onFocus,
+		OnBlur:     w.onBlur,
+	}
+}
+
+func (w *listboxElement) updateProps(data *ListBox) error {
+	// This is a brute force approach.  The list of items is probably unchanged
+	// most of the time.
+	win.SendMessage(w.Hwnd, win.LB_RESETCONTENT, 0, 0)
+	longestString, err := listboxAddItems(w.Hwnd, data.Items)
+	if err != nil {
+		return err
+	}
+	// The style LBS_EXTENDEDSEL cannot be changed after the control is
+	// created.  If the data has Multiple set, but not the control, only the
+	// first item will be selected.
+	w.setSelected(data.Selected)
+
+	w.SetDisabled(data.Disabled)
+	w.onChange = data.OnChange
+	w.onActivate = data.OnActivate
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+	w.longestString = longestString
+	// Clear cache
+	w.preferredWidth = 0
+
+	return nil
+}
+
+func listboxWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) (result uintptr) {
+	switch msg {
+	case win.WM_DESTROY:
+		// Make sure that the data structure on the Go-side does not point to a non-existent
+		// window.
+		listboxGetPtr(hwnd).Hwnd = 0
+		// Defer to the old window proc
+
+	case win.WM_SETFOCUS:
+		if w := listboxGetPtr(hwnd); w.onFocus != nil {
+			w.onFocus()
+		}
+		// Defer to the old window proc
+
+	case win.WM_KILLFOCUS:
+		if w := listboxGetPtr(hwnd); w.onBlur != nil {
+			w.onBlur()
+		}
+		// Defer to the old window proc
+
+	case win.WM_GETDLGCODE:
+		// The message loop uses IsDialogMessage, which would otherwise
+		// intercept the enter key.
+		if lParam != 0 && listboxGetPtr(hwnd).onActivate != nil {
+			if msg := (*win.MSG)(unsafe.Pointer(lParam)); msg.Message == win.WM_KEYDOWN && msg.WParam == win.VK_RETURN {
+				return win.DLGC_WANTMESSAGE | win.CallWindowProc(oldListboxWindowProc, hwnd, win.WM_GETDLGCODE, wParam, lParam)
+			}
+		}
+		// Defer to the old window proc
+
+	case win.WM_KEYDOWN:
+		if wParam == win.VK_RETURN {
+			if w := listboxGetPtr(hwnd); w.onActivate != nil {
+				if index := win.SendMessage(hwnd, win.LB_GETCARETINDEX, 0, 0); int32(index) != win.LB_ERR {
+					w.onActivate(int(index))
+				}
+				return 0
+			}
+		}
+		// Defer to the old window proc
+
+	case win.WM_COMMAND:
+		// WM_COMMAND is sent to the parent, which will only forward certain
+		// message.  This code should only ever see LBN_SELCHANGE and
+		// LBN_DBLCLK, but we will still check.
+		switch notification := win.HIWORD(uint32(wParam)); notification {
+		case win.LBN_SELCHANGE:
+			if w := listboxGetPtr(hwnd); w.onChange != nil {
+				w.onChange(w.selected())
+			}
+		case win.LBN_DBLCLK:
+			if w := listboxGetPtr(hwnd); w.onActivate != nil {
+				if index := win.SendMessage(hwnd, win.LB_GETCARETINDEX, 0, 0); int32(index) != win.LB_ERR {
+					w.onActivate(int(index))
+				}
+			}
+		}
+		return 0
+	}
+
+	return win.CallWindowProc(oldListboxWindowProc, hwnd, msg, wParam, lParam)
+}
+
+func listboxGetPtr(hwnd win.HWND) *listboxElement {
+	gwl := win.GetWindowLongPtr(hwnd, win.GWLP_USERDATA)
+	if gwl == 0 {
+		panic("Internal error.")
+	}
+
+	ptr := (*listboxElement)(unsafe.Pointer(gwl))
+	if ptr.Hwnd != hwnd && ptr.Hwnd != 0 {
+		panic("Internal error.")
+	}
+
+	return ptr
+}
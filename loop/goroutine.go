@@ -0,0 +1,25 @@
+//go:build headless || (js && go1.12)
+// +build headless js,go1.12
+
+package loop
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// goroutineID returns the ID of the calling goroutine.  Without an OS thread
+// for the GUI, the goroutine running the event loop acts as the GUI thread.
+// The runtime does not expose the ID, so it is parsed from the header of the
+// stack trace, which has the form "goroutine 123 [running]:".
+func goroutineID() uint64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	b := bytes.TrimPrefix(buf[:n], []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
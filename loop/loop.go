@@ -1,11 +1,15 @@
 package loop
 
 import (
+	"context"
 	"errors"
 	"os"
 	"runtime"
+	"sync"
 	"sync/atomic"
 	"testing"
+
+	"github.com/chaolihf/goey/internal/nopanic"
 )
 
 var (
@@ -20,8 +24,21 @@ var (
 	isRunning uint32
 	lockCount int32
 	isTesting uint32
+	// Incremented each time the event loop terminates.
+	runCount uint32
+
+	// Actions scheduled using Post, waiting to be run on the GUI thread.
+	posted      []postedAction
+	postedMutex sync.Mutex
 )
 
+// postedAction is an action waiting to be run on the GUI thread.  If the event
+// loop terminates before the action is run, discard is called instead.
+type postedAction struct {
+	run     func()
+	discard func()
+}
+
 // Run locks the OS thread to act as a GUI thread, and then starts the GUI
 // event loop until there are no more instances of Window open.
 // If the main loop is already running, this function will return an error
@@ -47,7 +64,8 @@ func Run(action func() error) error {
 		return ErrAlreadyRunning
 	}
 	defer func() {
-		atomic.StoreUint32(&isRunning, 0)
+		atomic.AddUint32(&runCount, 1)
+		discardPosted()
 	}()
 
 	// Pin the GUI message loop to a single thread.
@@ -106,17 +124,15 @@ func Run(action func() error) error {
 // running, this function will return an error (ErrNotRunning).  Any error from
 // the callback will also be returned.
 //
-// Because this function involves asynchronous communication with the GUI thread,
-// it can deadlock if called from the GUI thread.  It is therefore not safe to
-// use in any event callbacks from widgets.  However, since those callbacks are
-// already executing on the GUI thread, the use of Do is also unnecessary in
-// that context.
+// If called from the GUI thread, for example from an event callback, the
+// passed function is run immediately.  Otherwise, the caller blocks until the
+// GUI thread has run the function.
 //
 // Note, this function contains a race-condition.  An action may be
 // scheduled while the event loop is being terminated, in which case the
-// scheduled action may never be run.  Presumably, those actions don't need to
-// be run on the GUI thread, so they should be scheduled using a different
-// mechanism.
+// scheduled action may never be run, and the caller will block.  Callers that
+// cannot rule out termination of the event loop should use DoContext, which
+// returns ErrNotRunning instead.
 //
 // If the passed function panics, the panic will be recovered, and wrapped into
 // an error.  That error will be used to create a new panic within the
@@ -130,6 +146,12 @@ func Do(action func() error) error {
 		return ErrNotRunning
 	}
 
+	// Sending the action to the GUI thread from the GUI thread would
+	// deadlock.
+	if isGUIThread() {
+		return action()
+	}
+
 	// Race-condition here!  Event loop may terminate between previous check
 	// and following call, which will block.
 
@@ -137,6 +159,143 @@ func Do(action func() error) error {
 	return do(action)
 }
 
+// DoContext runs the passed function on the GUI thread, similar to Do.
+// However, if the context is cancelled before the GUI thread starts running
+// the function, this function returns immediately with the context's error,
+// and the passed function will not be run.  Similarly, if the event loop
+// terminates before the function is run, this function returns ErrNotRunning.
+// Once the passed function has started, the caller will block until it
+// completes.
+func DoContext(ctx context.Context, action func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if IsGUIThread() {
+		return action()
+	}
+
+	// The state is used to decide the race between the GUI thread starting
+	// the action and the caller abandoning the action.
+	const (
+		pending = iota
+		started
+		abandoned
+	)
+	state := uint32(pending)
+	errs := make(chan error, 1)
+	err := post(func() {
+		if atomic.CompareAndSwapUint32(&state, pending, started) {
+			errs <- nopanic.Wrap(action)
+		}
+	}, func() {
+		if atomic.CompareAndSwapUint32(&state, pending, abandoned) {
+			errs <- ErrNotRunning
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-errs:
+		return nopanic.Unwrap(err)
+	case <-ctx.Done():
+		if atomic.CompareAndSwapUint32(&state, pending, abandoned) {
+			return ctx.Err()
+		}
+		return nopanic.Unwrap(<-errs)
+	}
+}
+
+// Post schedules the passed function to run on the GUI thread, and returns
+// without waiting.  If the GUI event loop is not running, this function will
+// return an error (ErrNotRunning).  Functions are run in the order that they
+// were posted.
+//
+// Unlike Do, this function can be called from the GUI thread, in which case the
+// function is run after the current event has been handled.  Any functions
+// still pending when the event loop terminates are discarded.
+//
+// There is no caller waiting on the passed function, so a panic in that
+// function is not recovered.
+func Post(action func()) error {
+	return post(action, nil)
+}
+
+// post schedules the passed function to run on the GUI thread.  If the event
+// loop terminates before the function is run, the function discard is called
+// instead, unless it is nil.
+func post(action func(), discard func()) error {
+	// Check if the event loop is current running.  The check is made while
+	// holding the lock so that the action cannot be added after the pending
+	// actions have been discarded.
+	postedMutex.Lock()
+	if atomic.LoadUint32(&isRunning) == 0 {
+		postedMutex.Unlock()
+		return ErrNotRunning
+	}
+	posted = append(posted, postedAction{action, discard})
+	postedMutex.Unlock()
+
+	// Defer to platform-specific code to schedule a call to runPosted.
+	wake()
+	return nil
+}
+
+// runPosted runs all actions that have been scheduled using Post.  This
+// function must be called on the GUI thread.
+func runPosted() {
+	postedMutex.Lock()
+	actions := posted
+	posted = nil
+	postedMutex.Unlock()
+
+	for _, v := range actions {
+		v.run()
+	}
+}
+
+// discardPosted marks the event loop as stopped, and clears any actions that
+// have been scheduled using Post.  Callers waiting on a discarded action are
+// notified.
+func discardPosted() {
+	postedMutex.Lock()
+	atomic.StoreUint32(&isRunning, 0)
+	actions := posted
+	posted = nil
+	postedMutex.Unlock()
+
+	for _, v := range actions {
+		if v.discard != nil {
+			v.discard()
+		}
+	}
+}
+
+// IsGUIThread returns true if the caller is executing on the GUI thread, and
+// the GUI event loop is running.
+func IsGUIThread() bool {
+	return atomic.LoadUint32(&isRunning) != 0 && isGUIThread()
+}
+
+// RunModal runs a nested GUI event loop until the function done returns
+// true.  This is used to implement modal dialogs, where the caller needs to
+// block until the user closes the dialog, while events for all of the windows
+// continue to be processed.
+//
+// This function must be called on the GUI thread, and the function done is
+// checked after every event.  If the GUI event loop is not running, this
+// function will panic.
+func RunModal(done func() bool) {
+	// Check if the event loop is current running.
+	if atomic.LoadUint32(&isRunning) == 0 {
+		panic(ErrNotRunning)
+	}
+
+	// Defer to platform-specific code.
+	runModal(done)
+}
+
 // AddLockCount is used to track the number of top-level GUI elements that are
 // created.  When the count falls back to zero, the event loop will terminate.
 //
@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package loop
@@ -48,6 +49,13 @@ func run() {
 	cocoaloop.Run()
 }
 
+func runModal(done func() bool) {
+	assert.Assert(cocoaloop.IsMainThread(), "Not main thread")
+	for !done() {
+		cocoaloop.RunOnce()
+	}
+}
+
 func runTesting(action func() error) error {
 	testingActions <- action
 	return nopanic.Unwrap(<-testingSync)
@@ -57,6 +65,14 @@ func do(action func() error) error {
 	return cocoaloop.PerformOnMainThread(action)
 }
 
+func wake() {
+	cocoaloop.PostOnMainThread(runPosted)
+}
+
+func isGUIThread() bool {
+	return cocoaloop.IsMainThread()
+}
+
 func stop() {
 	cocoaloop.Stop()
 }
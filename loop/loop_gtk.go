@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package loop
@@ -17,7 +18,8 @@ const (
 )
 
 var (
-	runLevel uint32
+	runLevel  uint32
+	guiThread uintptr
 )
 
 func init() {
@@ -25,12 +27,14 @@ func init() {
 }
 
 func initRun() error {
-	// Do nothing
+	// Run has locked the OS thread, which will remain the GUI thread until
+	// the event loop terminates.
+	atomic.StoreUintptr(&guiThread, gtkloop.ThreadID())
 	return nil
 }
 
 func terminateRun() {
-	// Do nothing
+	atomic.StoreUintptr(&guiThread, 0)
 }
 
 func run() {
@@ -44,6 +48,12 @@ func run() {
 	gtkloop.Run()
 }
 
+func runModal(done func() bool) {
+	for !done() {
+		gtkloop.Iteration()
+	}
+}
+
 func runTesting(func() error) error {
 	panic("unreachable")
 }
@@ -71,6 +81,16 @@ func do(action func() error) error {
 	return nopanic.Unwrap(<-err)
 }
 
+func wake() {
+	// An idle callback is always queued, even when called from the GUI
+	// thread, so the posted actions are run after the current event.
+	gtkloop.IdleAdd(runPosted)
+}
+
+func isGUIThread() bool {
+	return atomic.LoadUintptr(&guiThread) == gtkloop.ThreadID()
+}
+
 func stop() {
 	gtkloop.Stop()
 }
@@ -0,0 +1,109 @@
+//go:build headless
+// +build headless
+
+package loop
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/chaolihf/goey/internal/nopanic"
+)
+
+const (
+	// Flag to control behaviour of UnlockOSThread in Run.
+	isOSThreadLockedAtInit = false
+)
+
+var (
+	actions chan func()
+	quit    chan struct{}
+
+	// The channel is never closed or cleared, so that calls to wake do not
+	// need to coordinate with the event loop starting or stopping.
+	wakeup = make(chan struct{}, 1)
+
+	guiGoroutine uint64
+)
+
+func initRun() error {
+	// The channels are created here, rather than in run, so that actions
+	// can be scheduled and modal loops can be run from within the initial
+	// action passed to Run.
+	actions = make(chan func())
+	quit = make(chan struct{})
+	atomic.StoreUint64(&guiGoroutine, goroutineID())
+	return nil
+}
+
+func terminateRun() {
+	atomic.StoreUint64(&guiGoroutine, 0)
+	actions = nil
+	quit = nil
+}
+
+func run() {
+	for {
+		select {
+		case action := <-actions:
+			action()
+		case <-wakeup:
+			runPosted()
+		case <-quit:
+			return
+		}
+	}
+}
+
+func runModal(done func() bool) {
+	for !done() {
+		select {
+		case action := <-actions:
+			action()
+		case <-wakeup:
+			runPosted()
+		case <-quit:
+			return
+		}
+	}
+}
+
+func runTesting(func() error) error {
+	panic("unreachable")
+}
+
+func do(action func() error) error {
+	// Make channel for the return value of the action.
+	err := make(chan error, 1)
+
+	// Make the function to execute on the GUI thread.  The action needs
+	// to be wrapped to transport any panics across the channel.
+	actions <- func() {
+		err <- nopanic.Wrap(action)
+	}
+
+	// Block on completion of action.
+	return nopanic.Unwrap(<-err)
+}
+
+func wake() {
+	select {
+	case wakeup <- struct{}{}:
+	default:
+		// A wakeup is already pending.
+	}
+}
+
+func isGUIThread() bool {
+	return atomic.LoadUint64(&guiGoroutine) == goroutineID()
+}
+
+func stop() {
+	close(quit)
+}
+
+func testMain(m *testing.M) int {
+	// There is no native GUI thread, so no special coordination is
+	// required.
+	return m.Run()
+}
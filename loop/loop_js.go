@@ -1,9 +1,10 @@
-//go:build go1.12
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package loop
 
 import (
+	"sync/atomic"
 	"testing"
 
 	"github.com/chaolihf/goey/internal/nopanic"
@@ -17,15 +18,21 @@ const (
 var (
 	actions chan func()
 	quit    chan struct{}
+
+	// The channel is never closed or cleared, so that calls to wake do not
+	// need to coordinate with the event loop starting or stopping.
+	wakeup = make(chan struct{}, 1)
+
+	guiGoroutine uint64
 )
 
 func initRun() error {
-	// Do nothing
+	atomic.StoreUint64(&guiGoroutine, goroutineID())
 	return nil
 }
 
 func terminateRun() {
-	// Do nothing
+	atomic.StoreUint64(&guiGoroutine, 0)
 }
 
 func run() {
@@ -41,12 +48,27 @@ func run() {
 		select {
 		case action := <-actions:
 			action()
+		case <-wakeup:
+			runPosted()
 		case _, _ = <-quit:
 			ok = false
 		}
 	}
 }
 
+func runModal(done func() bool) {
+	for !done() {
+		select {
+		case action := <-actions:
+			action()
+		case <-wakeup:
+			runPosted()
+		case _, _ = <-quit:
+			return
+		}
+	}
+}
+
 func runTesting(func() error) error {
 	panic("unreachable")
 }
@@ -65,6 +87,18 @@ func do(action func() error) error {
 	return nopanic.Unwrap(<-err)
 }
 
+func wake() {
+	select {
+	case wakeup <- struct{}{}:
+	default:
+		// A wakeup is already pending.
+	}
+}
+
+func isGUIThread() bool {
+	return atomic.LoadUint64(&guiGoroutine) == goroutineID()
+}
+
 func stop() {
 	close(quit)
 }
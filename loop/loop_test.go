@@ -1,11 +1,14 @@
 package loop_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/chaolihf/goey/internal/nopanic"
 	"github.com/chaolihf/goey/loop"
@@ -382,6 +385,193 @@ func TestDoFailure(t *testing.T) {
 	}
 }
 
+func TestDoFromGUIThread(t *testing.T) {
+	init := func() error {
+		if !loop.IsGUIThread() {
+			t.Errorf("Want IsGUIThread()==true in the initialization")
+		}
+
+		// This call would deadlock if it were sent to the GUI thread.
+		count := 0
+		err := loop.Do(func() error {
+			count++
+			return nil
+		})
+		if err != nil {
+			t.Errorf("Error in Do, %s", err)
+		}
+		if count != 1 {
+			t.Errorf("Want count==1, got count==%d", count)
+		}
+
+		loop.AddLockCount(1)
+		go func() {
+			if loop.IsGUIThread() {
+				t.Errorf("Want IsGUIThread()==false in a goroutine")
+			}
+
+			err := loop.Do(func() error {
+				if !loop.IsGUIThread() {
+					t.Errorf("Want IsGUIThread()==true in Do")
+				}
+				loop.AddLockCount(-1)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("Error in Do, %s", err)
+			}
+		}()
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+	if loop.IsGUIThread() {
+		t.Errorf("Want IsGUIThread()==false when the GUI loop is not running")
+	}
+}
+
+func TestPost(t *testing.T) {
+	log := []int(nil)
+
+	init := func() error {
+		loop.AddLockCount(1)
+
+		// Posting from the GUI thread should not run the action immediately.
+		err := loop.Post(func() {
+			log = append(log, 1)
+		})
+		if err != nil {
+			t.Errorf("Error in Post, %s", err)
+		}
+		log = append(log, 0)
+
+		go func() {
+			for i := 2; i < 5; i++ {
+				i := i
+				err := loop.Post(func() {
+					log = append(log, i)
+				})
+				if err != nil {
+					t.Errorf("Error in Post, %s", err)
+				}
+			}
+
+			// Close the window
+			err := loop.Post(func() {
+				loop.AddLockCount(-1)
+			})
+			if err != nil {
+				t.Errorf("Error in Post, %s", err)
+			}
+		}()
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+	if !reflect.DeepEqual(log, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Want log==%v, got log==%v", []int{0, 1, 2, 3, 4}, log)
+	}
+}
+
+func TestPostFailure(t *testing.T) {
+	err := loop.Post(func() {})
+
+	if err != loop.ErrNotRunning {
+		t.Errorf("Unexpected success in call to Post")
+	}
+}
+
+func TestDoContext(t *testing.T) {
+	count := uint32(0)
+
+	init := func() error {
+		loop.AddLockCount(1)
+
+		go func() {
+			err := loop.DoContext(context.Background(), func() error {
+				atomic.AddUint32(&count, 1)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("Error in DoContext, %s", err)
+			}
+
+			// A context that has already been cancelled should prevent the
+			// action from running.
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			err = loop.DoContext(ctx, func() error {
+				atomic.AddUint32(&count, 1)
+				return nil
+			})
+			if err != context.Canceled {
+				t.Errorf("Error in DoContext, expected %v, got %v", context.Canceled, err)
+			}
+
+			// Close the window
+			err = loop.DoContext(context.Background(), func() error {
+				loop.AddLockCount(-1)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("Error in DoContext, %s", err)
+			}
+		}()
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+	if c := atomic.LoadUint32(&count); c != 1 {
+		t.Errorf("Want count=1, got count==%d", c)
+	}
+}
+
+func TestDoContext_Terminated(t *testing.T) {
+	count := uint32(0)
+	errs := make(chan error, 1)
+
+	init := func() error {
+		// The action is scheduled while the event loop is running, but the
+		// event loop terminates without running it, since no window is open.
+		go func() {
+			errs <- loop.DoContext(context.Background(), func() error {
+				atomic.AddUint32(&count, 1)
+				return nil
+			})
+		}()
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+	select {
+	case err := <-errs:
+		if err != loop.ErrNotRunning {
+			t.Errorf("Error in DoContext, expected %v, got %v", loop.ErrNotRunning, err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("DoContext did not return after the GUI loop terminated")
+	}
+	if c := atomic.LoadUint32(&count); c != 0 {
+		t.Errorf("Want count=0, got count==%d", c)
+	}
+}
+
 func TestDoWithError(t *testing.T) {
 	const errorString = "No luck"
 
@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package loop
 
 import (
@@ -21,6 +24,7 @@ var (
 	namePost = [...]uint16{'G', 'o', 'e', 'y', 'P', 'o', 's', 't', 'W', 'i', 'n', 'd', 'o', 'w', 0}
 
 	activeWindow uintptr
+	guiThreadID  uint32
 
 	postMessageAction = make(chan func() error, 1)
 	postMessageErr    = make(chan error, 1)
@@ -57,10 +61,12 @@ func initRun() error {
 	if hwndPost == 0 {
 		return syscall.GetLastError()
 	}
+	atomic.StoreUint32(&guiThreadID, win.GetCurrentThreadId())
 	return nil
 }
 
 func terminateRun() {
+	atomic.StoreUint32(&guiThreadID, 0)
 	win.DestroyWindow(hwndPost)
 	hwndPost = 0
 }
@@ -71,6 +77,17 @@ func run() {
 	}
 }
 
+func runModal(done func() bool) {
+	for !done() {
+		if !loop() {
+			// The quit message needs to be reposted so that the outer
+			// message loop will also terminate.
+			win.PostQuitMessage(0)
+			return
+		}
+	}
+}
+
 func runTesting(func() error) error {
 	panic("unreachable")
 }
@@ -86,6 +103,16 @@ func do(action func() error) error {
 	return nopanic.Unwrap(<-postMessageErr)
 }
 
+func wake() {
+	// Unlike SendMessage, PostMessage does not wait for the message to be
+	// processed.
+	win.PostMessage(hwndPost, win.WM_USER+1, 0, 0)
+}
+
+func isGUIThread() bool {
+	return atomic.LoadUint32(&guiThreadID) == win.GetCurrentThreadId()
+}
+
 func loop() (ok bool) {
 	// Obtain a copy of the next message from the queue.
 	var msg win.MSG
@@ -117,6 +144,10 @@ func postWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) u
 	case win.WM_USER:
 		postMessageErr <- nopanic.Wrap(<-postMessageAction)
 		return 0
+
+	case win.WM_USER + 1:
+		runPosted()
+		return 0
 	}
 
 	// Let the default window proc handle all other messages
@@ -0,0 +1,121 @@
+package loop
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// States for a Timer.
+const (
+	timerPending = iota
+	timerFired
+	timerStopped
+)
+
+// Timer represents a single event, where a function is called on the GUI
+// thread after a delay.  A Timer must be created with AfterFunc.
+type Timer struct {
+	timer *time.Timer
+	state uint32
+}
+
+// AfterFunc waits for the duration to elapse, and then calls f on the GUI
+// thread.  It returns a Timer that can be used to cancel the call.
+//
+// If the GUI event loop is not running when the duration elapses, the call is
+// dropped.
+func AfterFunc(d time.Duration, f func()) *Timer {
+	t := &Timer{}
+	t.timer = time.AfterFunc(d, func() {
+		Post(func() {
+			// The timer may have been stopped after the action was posted,
+			// but before it was run.
+			if atomic.CompareAndSwapUint32(&t.state, timerPending, timerFired) {
+				f()
+			}
+		})
+	})
+	return t
+}
+
+// Stop prevents the Timer from firing.  It returns true if the call stops the
+// timer, and false if the timer has already fired or been stopped.
+//
+// When called from the GUI thread, a true result guarantees that f will not be
+// called.
+func (t *Timer) Stop() bool {
+	t.timer.Stop()
+	return atomic.CompareAndSwapUint32(&t.state, timerPending, timerStopped)
+}
+
+// Ticker calls a function on the GUI thread at regular intervals.  A Ticker
+// must be created with NewTicker.
+type Ticker struct {
+	ticker   *time.Ticker
+	done     chan struct{}
+	stopped  uint32
+	pending  uint32
+	runCount uint32 // Value of runCount when the ticker was created
+}
+
+// NewTicker returns a new Ticker that calls f on the GUI thread after each
+// tick.  The period between ticks is specified by the duration argument,
+// which must be greater than zero.  If the GUI thread is busy, ticks will be
+// dropped to make up for slow callbacks.
+//
+// The ticker stops when the GUI event loop terminates, or when Stop is
+// called.
+func NewTicker(d time.Duration, f func()) *Ticker {
+	t := &Ticker{
+		ticker:   time.NewTicker(d),
+		done:     make(chan struct{}),
+		runCount: atomic.LoadUint32(&runCount),
+	}
+
+	go t.run(f)
+	return t
+}
+
+func (t *Ticker) run(f func()) {
+	defer t.ticker.Stop()
+
+	for {
+		select {
+		case <-t.done:
+			return
+
+		case <-t.ticker.C:
+			// The event loop may have terminated, and been restarted,
+			// since the last tick.  If a tick was pending, that action
+			// was discarded, and pending will never be cleared.
+			if atomic.LoadUint32(&runCount) != t.runCount {
+				return
+			}
+
+			// Don't queue another call if the previous tick has not yet
+			// been handled.
+			if !atomic.CompareAndSwapUint32(&t.pending, 0, 1) {
+				continue
+			}
+
+			err := Post(func() {
+				atomic.StoreUint32(&t.pending, 0)
+				if atomic.LoadUint32(&t.stopped) == 0 {
+					f()
+				}
+			})
+			if err != nil {
+				// The event loop is not running.
+				return
+			}
+		}
+	}
+}
+
+// Stop turns off the ticker.  When called from the GUI thread, no more calls
+// to f will be made after Stop returns.
+func (t *Ticker) Stop() {
+	if atomic.CompareAndSwapUint32(&t.stopped, 0, 1) {
+		close(t.done)
+	}
+}
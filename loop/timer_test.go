@@ -0,0 +1,137 @@
+package loop_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+func ExampleAfterFunc() {
+	init := func() error {
+		// Create an empty window.  Note that most user code should instead
+		// create a window, which will handle lock counting.
+		loop.AddLockCount(1)
+
+		// The callback for the timer is called on the GUI thread, so there
+		// is no need to use Do.
+		loop.AfterFunc(10*time.Millisecond, func() {
+			fmt.Println("Time's up")
+			loop.AddLockCount(-1)
+		})
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		fmt.Println("Error:", err)
+	}
+
+	// Output:
+	// Time's up
+}
+
+func TestAfterFunc(t *testing.T) {
+	fired := 0
+
+	init := func() error {
+		loop.AddLockCount(1)
+
+		stopped := loop.AfterFunc(10*time.Millisecond, func() {
+			t.Errorf("Stopped timer was fired")
+		})
+		if !stopped.Stop() {
+			t.Errorf("Want Stop()==true for a pending timer")
+		}
+		if stopped.Stop() {
+			t.Errorf("Want Stop()==false for a stopped timer")
+		}
+
+		var timer *loop.Timer
+		timer = loop.AfterFunc(20*time.Millisecond, func() {
+			if !loop.IsGUIThread() {
+				t.Errorf("Timer was not fired on the GUI thread")
+			}
+			if timer.Stop() {
+				t.Errorf("Want Stop()==false for a timer that has fired")
+			}
+			fired++
+			loop.AddLockCount(-1)
+		})
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+	if fired != 1 {
+		t.Errorf("Want fired==1, got fired==%d", fired)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	const ticks = 3
+	count := 0
+
+	init := func() error {
+		loop.AddLockCount(1)
+
+		var ticker *loop.Ticker
+		ticker = loop.NewTicker(10*time.Millisecond, func() {
+			if !loop.IsGUIThread() {
+				t.Errorf("Ticker was not fired on the GUI thread")
+			}
+			count++
+			if count == ticks {
+				ticker.Stop()
+				// Leave some time to catch any extra ticks.
+				loop.AfterFunc(50*time.Millisecond, func() {
+					loop.AddLockCount(-1)
+				})
+			} else if count > ticks {
+				t.Errorf("Ticker was fired after it was stopped")
+			}
+		})
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+	if count != ticks {
+		t.Errorf("Want count==%d, got count==%d", ticks, count)
+	}
+}
+
+func TestTicker_Pending(t *testing.T) {
+	// Count goroutines before creating the ticker, so that we can check that
+	// the ticker's goroutine exits.
+	count := runtime.NumGoroutine()
+
+	init := func() error {
+		loop.NewTicker(time.Millisecond, func() {
+			t.Errorf("Ticker was fired after the event loop stopped")
+		})
+		// Block the GUI thread so that a tick is posted, but not run,
+		// before the event loop stops.
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > count {
+		if time.Now().After(deadline) {
+			t.Fatalf("Ticker did not stop after the event loop stopped")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
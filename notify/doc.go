@@ -0,0 +1,18 @@
+// Package notify provides desktop notifications, which can be used to inform
+// the user of events while the application's windows are hidden or
+// minimized.
+//
+// Notifications are shown using the platform's notification service.  On
+// Linux and other unix-like platforms, notifications use the freedesktop
+// notification service over D-Bus.  On Windows, notifications use the shell's
+// notification area.  On JS, notifications use the Notification API, and the
+// user will be asked for permission if necessary.
+//
+// Not all platforms can show buttons for the actions of a notification.  On
+// those platforms, clicking on the notification will select the first action.
+//
+// On macOS, actions are not currently supported.
+//
+// For testing, a Fake can be installed to record notifications instead of
+// showing them.
+package notify
@@ -0,0 +1,80 @@
+package notify
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+// Fake replaces the platform's notification service while it is installed.
+// Notifications are recorded instead of being shown, so that they can be
+// inspected by tests.
+type Fake struct {
+	mutex sync.Mutex
+	sent  []*Notification
+}
+
+// NewFake returns a new fake notification service.  The fake is not active
+// until it has been installed.
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// Install replaces the platform's notification service with the fake.  The
+// returned function restores the previous service.
+func (f *Fake) Install() (restore func()) {
+	fakeMutex.Lock()
+	prev := fake
+	fake = f
+	fakeMutex.Unlock()
+
+	return func() {
+		fakeMutex.Lock()
+		fake = prev
+		fakeMutex.Unlock()
+	}
+}
+
+func (f *Fake) send(n *Notification) {
+	f.mutex.Lock()
+	f.sent = append(f.sent, n)
+	f.mutex.Unlock()
+}
+
+// Sent returns copies of all of the notifications that have been sent while
+// the fake was installed.
+func (f *Fake) Sent() []Notification {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	retval := make([]Notification, 0, len(f.sent))
+	for _, v := range f.sent {
+		retval = append(retval, *v)
+	}
+	return retval
+}
+
+// Activate simulates the user selecting an action for a notification.  The
+// parameter index selects the notification, in the order that they were
+// sent, and the parameter action selects the action.  The callback will be
+// run on the GUI thread using loop.Do, so this method cannot be called from
+// the GUI thread.
+func (f *Fake) Activate(index, action int) error {
+	f.mutex.Lock()
+	if index < 0 || index >= len(f.sent) {
+		f.mutex.Unlock()
+		return errors.New("Invalid argument, 'index' is out of range in call to Activate")
+	}
+	n := f.sent[index]
+	f.mutex.Unlock()
+
+	if action < 0 || action >= len(n.Actions) {
+		return errors.New("Invalid argument, 'action' is out of range in call to Activate")
+	}
+
+	return loop.Do(func() error {
+		n.action(action)
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package notify
+
+import (
+	"errors"
+	"image"
+	"strings"
+	"sync"
+)
+
+// Action is a choice that the user can select in response to a notification.
+type Action struct {
+	Label    string // Text to show on the button for the action
+	OnAction func() // Callback when the user selects the action
+}
+
+// Notification describes a message to show to the user.
+type Notification struct {
+	Title   string      // Summary of the notification
+	Body    string      // Text for the body of the notification
+	Icon    image.Image // Optional image to show with the notification
+	Actions []Action    // Optional actions that the user can select
+}
+
+var (
+	fakeMutex sync.Mutex
+	fake      *Fake
+)
+
+// Send shows the notification to the user.  Any callbacks for the actions
+// will be called on the GUI thread.
+//
+// Like other modifications to the GUI, this method must be called on the GUI
+// thread, which can be done using the function loop.Do.
+func (n *Notification) Send() error {
+	if strings.TrimSpace(n.Title) == "" {
+		return errors.New("Invalid argument, 'Title' cannot be empty in call to Send")
+	}
+	for _, v := range n.Actions {
+		if strings.TrimSpace(v.Label) == "" {
+			return errors.New("Invalid argument, 'Label' cannot be empty in call to Send")
+		}
+	}
+
+	// Make a copy of the notification, so that the caller can reuse the
+	// notification while it is still being shown.
+	tmp := *n
+	tmp.Actions = append([]Action(nil), n.Actions...)
+
+	fakeMutex.Lock()
+	f := fake
+	fakeMutex.Unlock()
+	if f != nil {
+		f.send(&tmp)
+		return nil
+	}
+
+	return tmp.send()
+}
+
+// action calls the callback for the action at the specified index, if it
+// exists.
+func (n *Notification) action(index int) {
+	if index >= 0 && index < len(n.Actions) && n.Actions[index].OnAction != nil {
+		n.Actions[index].OnAction()
+	}
+}
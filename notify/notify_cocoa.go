@@ -0,0 +1,15 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package notify
+
+import (
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+func (n *Notification) send() error {
+	// Actions are not currently supported.
+	cocoa.NotifySend(n.Title, n.Body)
+	return nil
+}
@@ -0,0 +1,70 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package notify
+
+import (
+	"bytes"
+	"image"
+	"strconv"
+
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+var (
+	// Notifications that are currently being shown, indexed by the ID
+	// returned by the notification service.
+	active = make(map[uint32]*Notification)
+)
+
+func init() {
+	gtk.OnNotifyAction = func(id uint32, key string) {
+		n, ok := active[id]
+		if !ok {
+			return
+		}
+
+		// The default action is used when the user clicks on the
+		// notification.
+		if key == "default" {
+			n.action(0)
+		} else if index, err := strconv.Atoi(key); err == nil {
+			n.action(index)
+		}
+	}
+	gtk.OnNotifyClosed = func(id uint32) {
+		delete(active, id)
+	}
+}
+
+func (n *Notification) send() error {
+	// Actions are identified by their index.  Clicking on the notification
+	// selects the first action.
+	actions := bytes.Buffer{}
+	if len(n.Actions) > 0 {
+		actions.WriteString("default")
+		actions.WriteByte(0)
+		actions.WriteString(n.Actions[0].Label)
+		actions.WriteByte(0)
+	}
+	for i, v := range n.Actions {
+		actions.WriteString(strconv.Itoa(i))
+		actions.WriteByte(0)
+		actions.WriteString(v.Label)
+		actions.WriteByte(0)
+	}
+	actions.WriteByte(0)
+
+	var icon *image.RGBA
+	if n.Icon != nil {
+		icon = gtk.ImageToRGBA(n.Icon)
+	}
+
+	id, err := gtk.NotifySend(n.Title, n.Body, actions.String(), icon)
+	if err != nil {
+		return err
+	}
+	active[id] = n
+	return nil
+}
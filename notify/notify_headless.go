@@ -0,0 +1,10 @@
+//go:build headless
+// +build headless
+
+package notify
+
+func (n *Notification) send() error {
+	// Without a display, there is nowhere to show the notification, so it
+	// is discarded.
+	return nil
+}
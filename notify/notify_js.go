@@ -0,0 +1,73 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package notify
+
+import (
+	"errors"
+	"syscall/js"
+
+	goeyjs "github.com/chaolihf/goey/internal/js"
+)
+
+var (
+	// ErrPermissionDenied indicates that the user has not allowed the page
+	// to show notifications.
+	ErrPermissionDenied = errors.New("permission to show notifications was denied")
+
+	errNotSupported = errors.New("notifications are not supported by the browser")
+)
+
+func (n *Notification) send() error {
+	api := js.Global().Get("Notification")
+	if api.IsUndefined() {
+		return errNotSupported
+	}
+
+	switch api.Get("permission").String() {
+	case "granted":
+		n.show(api)
+		return nil
+
+	case "denied":
+		return ErrPermissionDenied
+	}
+
+	// Ask the user for permission.  The notification will be shown once
+	// permission has been granted.
+	var then js.Func
+	then = js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+		then.Release()
+		if len(args) > 0 && args[0].String() == "granted" {
+			n.show(api)
+		}
+		return nil
+	})
+	api.Call("requestPermission").Call("then", then)
+	return nil
+}
+
+func (n *Notification) show(api js.Value) {
+	options := map[string]interface{}{
+		"body": n.Body,
+	}
+	if n.Icon != nil {
+		options["icon"] = goeyjs.ImageToAttr(n.Icon)
+	}
+	notification := api.New(n.Title, options)
+
+	// Notifications created from a page cannot show buttons, so clicking
+	// on the notification selects the first action.
+	var onclick, onclose js.Func
+	onclick = js.FuncOf(func(js.Value, []js.Value) interface{} {
+		n.action(0)
+		return nil
+	})
+	onclose = js.FuncOf(func(js.Value, []js.Value) interface{} {
+		onclick.Release()
+		onclose.Release()
+		return nil
+	})
+	notification.Set("onclick", onclick)
+	notification.Set("onclose", onclose)
+}
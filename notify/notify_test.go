@@ -0,0 +1,123 @@
+package notify_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/goey/notify"
+)
+
+func ExampleNotification() {
+	// The following sends a notification once a long-running job has
+	// completed.  The notification needs to be sent on the GUI thread.
+	err := loop.Do(func() error {
+		n := notify.Notification{
+			Title: "Export complete",
+			Body:  "The report has been saved.",
+			Actions: []notify.Action{
+				{Label: "Open", OnAction: func() { fmt.Println("Opening report...") }},
+			},
+		}
+		return n.Send()
+	})
+	if err != nil {
+		fmt.Println("Error: ", err)
+	}
+}
+
+func TestMain(m *testing.M) {
+	loop.TestMain(m)
+}
+
+func TestNotificationSend(t *testing.T) {
+	fake := notify.NewFake()
+	restore := fake.Install()
+	defer restore()
+
+	cases := []struct {
+		n  notify.Notification
+		ok bool
+	}{
+		{notify.Notification{Title: "Title"}, true},
+		{notify.Notification{Title: "Title", Body: "Some text for the body."}, true},
+		{notify.Notification{Title: "Title", Actions: []notify.Action{{Label: "Open"}}}, true},
+		{notify.Notification{}, false},
+		{notify.Notification{Title: "  "}, false},
+		{notify.Notification{Title: "Title", Actions: []notify.Action{{Label: ""}}}, false},
+	}
+
+	count := 0
+	for i, v := range cases {
+		err := v.n.Send()
+		if got := err == nil; got != v.ok {
+			t.Errorf("Case %d,  want %v, got %v", i, v.ok, got)
+			if err != nil {
+				t.Logf("Error: %s", err)
+			}
+		}
+		if err == nil {
+			count++
+		}
+	}
+
+	sent := fake.Sent()
+	if len(sent) != count {
+		t.Fatalf("Want %d notifications, got %d", count, len(sent))
+	}
+	for i, v := range sent {
+		if v.Title != "Title" {
+			t.Errorf("Case %d,  want title %q, got %q", i, "Title", v.Title)
+		}
+	}
+}
+
+func TestFakeActivate(t *testing.T) {
+	fake := notify.NewFake()
+	restore := fake.Install()
+	defer restore()
+
+	selected := -1
+	init := func() error {
+		// Keep the event loop running until the action has been checked.
+		loop.AddLockCount(1)
+
+		n := notify.Notification{
+			Title: "Title",
+			Actions: []notify.Action{
+				{Label: "First", OnAction: func() { selected = 0 }},
+				{Label: "Second", OnAction: func() { selected = 1 }},
+			},
+		}
+		if err := n.Send(); err != nil {
+			t.Errorf("Failed to send notification, %s", err)
+		}
+
+		go func() {
+			if err := fake.Activate(0, 1); err != nil {
+				t.Errorf("Failed to activate notification, %s", err)
+			}
+			if err := fake.Activate(0, 2); err == nil {
+				t.Errorf("Want error when activating missing action")
+			}
+			if err := fake.Activate(1, 0); err == nil {
+				t.Errorf("Want error when activating missing notification")
+			}
+
+			loop.Do(func() error {
+				loop.AddLockCount(-1)
+				return nil
+			})
+		}()
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+	if selected != 1 {
+		t.Errorf("Want action 1, got %d", selected)
+	}
+}
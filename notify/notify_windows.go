@@ -0,0 +1,153 @@
+//go:build !headless
+// +build !headless
+
+package notify
+
+import (
+	"syscall"
+	"unsafe"
+
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+const (
+	// Message used by the shell to report events for the notification icon.
+	wmNotifyIcon = win.WM_APP + 1
+)
+
+var (
+	notifyClass struct {
+		atom      win.ATOM
+		className []uint16
+	}
+
+	// Notifications that are currently being shown, indexed by the hidden
+	// window used to receive events from the shell.
+	active = make(map[win.HWND]*notification)
+)
+
+func init() {
+	notifyClass.className = []uint16{'G', 'o', 'e', 'y', 'N', 'o', 't', 'i', 'f', 'y', 0}
+}
+
+type notification struct {
+	*Notification
+	hicon win.HICON
+}
+
+func registerNotifyClass() (win.ATOM, error) {
+	hInstance := win.GetModuleHandle(nil)
+	if hInstance == 0 {
+		return 0, syscall.GetLastError()
+	}
+
+	wc := win.WNDCLASSEX{
+		CbSize:        uint32(unsafe.Sizeof(win.WNDCLASSEX{})),
+		HInstance:     hInstance,
+		LpfnWndProc:   syscall.NewCallback(notifyWindowProc),
+		LpszClassName: &notifyClass.className[0],
+	}
+
+	atom := win.RegisterClassEx(&wc)
+	if atom == 0 {
+		return 0, syscall.GetLastError()
+	}
+
+	return atom, nil
+}
+
+// copyString converts the string to UTF-16, truncating the string if
+// necessary so that the result, with a terminating nul, fits in dst.
+func copyString(dst []uint16, s string) {
+	tmp := syscall.StringToUTF16(s)
+	if len(tmp) > len(dst) {
+		tmp = tmp[:len(dst)]
+		tmp[len(tmp)-1] = 0
+	}
+	copy(dst, tmp)
+}
+
+func (n *Notification) send() error {
+	// Ensure that our custom window class has been registered.
+	if notifyClass.atom == 0 {
+		atom, err := registerNotifyClass()
+		if err != nil {
+			return err
+		}
+		notifyClass.atom = atom
+	}
+
+	// The shell sends events for the notification to a window, so create a
+	// message-only window for the notification.
+	hwnd := win.CreateWindowEx(0, &notifyClass.className[0], nil, 0,
+		0, 0, 0, 0, win.HWND_MESSAGE, 0, win.GetModuleHandle(nil), nil)
+	if hwnd == 0 {
+		return syscall.GetLastError()
+	}
+
+	nid := win.NOTIFYICONDATA{
+		CbSize:           uint32(unsafe.Sizeof(win.NOTIFYICONDATA{})),
+		HWnd:             hwnd,
+		UID:              1,
+		UFlags:           win.NIF_MESSAGE | win.NIF_ICON | win.NIF_INFO,
+		UCallbackMessage: wmNotifyIcon,
+		HIcon:            win.LoadIcon(0, (*uint16)(unsafe.Pointer(uintptr(win.IDI_APPLICATION)))),
+		DwInfoFlags:      win.NIIF_INFO,
+	}
+	copyString(nid.SzInfoTitle[:], n.Title)
+	copyString(nid.SzInfo[:], n.Body)
+
+	state := &notification{Notification: n}
+	if n.Icon != nil {
+		hicon, err := win2.CreateIconFromImage(n.Icon)
+		if err != nil {
+			win.DestroyWindow(hwnd)
+			return err
+		}
+		state.hicon = hicon
+		nid.HBalloonIcon = hicon
+		nid.DwInfoFlags = win.NIIF_USER | win.NIIF_LARGE_ICON
+	}
+
+	if !win.Shell_NotifyIcon(win.NIM_ADD, &nid) {
+		state.close(hwnd)
+		return syscall.GetLastError()
+	}
+	active[hwnd] = state
+	return nil
+}
+
+func (n *notification) close(hwnd win.HWND) {
+	nid := win.NOTIFYICONDATA{
+		CbSize: uint32(unsafe.Sizeof(win.NOTIFYICONDATA{})),
+		HWnd:   hwnd,
+		UID:    1,
+	}
+	win.Shell_NotifyIcon(win.NIM_DELETE, &nid)
+	if n.hicon != 0 {
+		win.DestroyIcon(n.hicon)
+		n.hicon = 0
+	}
+	win.DestroyWindow(hwnd)
+	delete(active, hwnd)
+}
+
+func notifyWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) uintptr {
+	if msg == wmNotifyIcon {
+		if n, ok := active[hwnd]; ok {
+			switch lParam {
+			case win.NIN_BALLOONUSERCLICK:
+				// Balloon notifications cannot show buttons, so clicking
+				// on the notification selects the first action.
+				n.close(hwnd)
+				n.action(0)
+			case win.NIN_BALLOONTIMEOUT, win.NIN_BALLOONHIDE:
+				n.close(hwnd)
+			}
+		}
+		return 0
+	}
+
+	return win.DefWindowProc(hwnd, msg, wParam, lParam)
+}
@@ -0,0 +1,146 @@
+package goey
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/chaolihf/goey/base"
+)
+
+var (
+	numberInputKind = base.NewKind("github.com/chaolihf/goey.NumberInput")
+)
+
+// NumberInput describes a widget that users input or update a single
+// floating-point value.  The model for the value is a float64.
+//
+// If the field Min and Max are both zero, then a default range will be
+// initialized covering the entire range of float64.  If the field Step is
+// zero, it will be initialized so that the smallest displayed digit is
+// changed by each step.
+//
+// The value is displayed with Precision digits after the decimal point, and
+// Value will be rounded to match.  The default is to display whole numbers
+// only.  The fields Prefix and Suffix can be used to display units, such as
+// "$" or "kg", with the value.  Users do not need to type the prefix or
+// suffix when entering values.
+type NumberInput struct {
+	Value       float64             // Value is the current value for the field
+	Placeholder string              // Placeholder is a descriptive text that can be displayed when the field is empty
+	Disabled    bool                // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus   bool                // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
+	Min, Max    float64             // Min and Max set the range of Value
+	Step        float64             // Step is the amount the value changes when using the arrow keys or spinner
+	Precision   uint                // Precision is the number of digits displayed after the decimal point
+	Prefix      string              // Prefix is displayed before the value, such as for currency
+	Suffix      string              // Suffix is displayed after the value, such as for units
+	OnChange    func(value float64) // OnChange will be called whenever the user changes the value for this field
+	OnFocus     func()              // OnFocus will be called whenever the field receives the keyboard focus
+	OnBlur      func()              // OnBlur will be called whenever the field loses the keyboard focus
+	OnEnterKey  func(value float64) // OnEnterKey will be called whenever the use hits the enter key
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*NumberInput) Kind() *base.Kind {
+	return &numberInputKind
+}
+
+// Mount creates a text field in the GUI.  The newly created widget
+// will be a child of the widget specified by parent.
+func (w *NumberInput) Mount(parent base.Control) (base.Element, error) {
+	// Fill in default values for the range.
+	w.UpdateRange()
+	// Make sure that the value is within the range.
+	w.UpdateValue()
+
+	// Forward to the platform-dependant code
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
+}
+
+// UpdateRange sets a default range when the fields Min and Max are both
+// default initialized.  The default range matches the range of float64.
+// UpdateRange also sets a default for the field Step if it is not positive.
+func (w *NumberInput) UpdateRange() {
+	if w.Min == 0 && w.Max == 0 {
+		w.Min = -math.MaxFloat64
+		w.Max = math.MaxFloat64
+	}
+	if !(w.Step > 0) {
+		w.Step = math.Pow10(-int(w.Precision))
+	}
+}
+
+// UpdateValue clamps the field Value to the range [Min,Max], and then rounds
+// the value to the displayed precision.
+func (w *NumberInput) UpdateValue() {
+	if math.IsNaN(w.Value) {
+		w.Value = 0
+	}
+	if w.Value < w.Min {
+		w.Value = w.Min
+	} else if w.Value > w.Max {
+		w.Value = w.Max
+	}
+	w.Value = roundNumber(w.Value, w.Precision)
+}
+
+func (*numberinputElement) Kind() *base.Kind {
+	return &numberInputKind
+}
+
+func (w *numberinputElement) UpdateProps(data base.Widget) error {
+	widget := data.(*NumberInput)
+
+	// Fill in default values for the range.
+	widget.UpdateRange()
+	widget.UpdateValue()
+	// Forward to the platform-dependant code
+	return w.updateProps(widget)
+}
+
+// roundNumber rounds the value to match the text that will be displayed.
+func roundNumber(value float64, precision uint) float64 {
+	if math.IsInf(value, 0) || math.Abs(value) == math.MaxFloat64 {
+		return value
+	}
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(value, 'f', int(precision), 64), 64)
+	if err != nil {
+		return value
+	}
+	return rounded
+}
+
+// formatNumber converts the value to text, including the prefix and suffix.
+func formatNumber(value float64, precision uint, prefix, suffix string) string {
+	return prefix + strconv.FormatFloat(value, 'f', int(precision), 64) + suffix
+}
+
+// parseNumber converts text to a value.  The prefix and suffix are optional.
+func parseNumber(text string, prefix, suffix string) (float64, error) {
+	text = strings.TrimSpace(text)
+	if prefix = strings.TrimSpace(prefix); prefix != "" {
+		text = strings.TrimSpace(strings.TrimPrefix(text, prefix))
+	}
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		text = strings.TrimSpace(strings.TrimSuffix(text, suffix))
+	}
+	return strconv.ParseFloat(text, 64)
+}
+
+// stepNumber increments the value by a number of steps, and then clamps the
+// value to the range [min,max].
+func stepNumber(value float64, steps int, step, min, max float64) float64 {
+	value += float64(steps) * step
+	if value < min {
+		return min
+	} else if value > max {
+		return max
+	}
+	return value
+}
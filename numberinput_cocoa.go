@@ -0,0 +1,53 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+type numberinputElement struct {
+	control *cocoa.Text
+}
+
+func (w *NumberInput) mount(parent base.Control) (base.Element, error) {
+	control := cocoa.NewText(parent.Handle, "number input")
+
+	retval := &numberinputElement{
+		control: control,
+	}
+	return retval, nil
+}
+
+func (w *numberinputElement) Close() {
+	if w.control != nil {
+		w.control.Close()
+		w.control = nil
+	}
+}
+
+func (w *numberinputElement) Layout(bc base.Constraints) base.Size {
+	px := w.MinIntrinsicWidth(base.Inf)
+	h := w.MinIntrinsicHeight(base.Inf)
+	return bc.Constrain(base.Size{px, h})
+}
+
+func (w *numberinputElement) MinIntrinsicHeight(width base.Length) base.Length {
+	return 20 * base.DIP
+}
+
+func (w *numberinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	return 200 * base.DIP
+}
+
+func (w *numberinputElement) SetBounds(bounds base.Rectangle) {
+	px := bounds.Pixels()
+	w.control.SetFrame(px.Min.X, px.Min.Y, px.Dx(), px.Dy())
+}
+
+func (w *numberinputElement) updateProps(data *NumberInput) error {
+	return nil
+}
@@ -0,0 +1,91 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package goey
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type numberinputElement struct {
+	Control
+
+	min, max   float64
+	onChange   func(float64)
+	onFocus    func()
+	onBlur     func()
+	onEnterKey func(float64)
+}
+
+func (w *NumberInput) mount(parent base.Control) (base.Element, error) {
+	control := gtk.MountNumberInput(parent.Handle, w.Value, w.Placeholder, w.Disabled,
+		w.Min, w.Max, w.Step, w.Precision, w.Prefix, w.Suffix,
+		w.OnChange != nil, w.OnFocus != nil, w.OnBlur != nil, w.OnEnterKey != nil)
+
+	// Create the element
+	retval := &numberinputElement{
+		Control:    Control{control},
+		min:        w.Min,
+		max:        w.Max,
+		onChange:   w.OnChange,
+		onFocus:    w.OnFocus,
+		onBlur:     w.OnBlur,
+		onEnterKey: w.OnEnterKey,
+	}
+	gtk.RegisterWidget(control, retval)
+
+	return retval, nil
+}
+
+func (w *numberinputElement) OnChange(value float64) {
+	if w.onChange != nil {
+		w.onChange(value)
+	}
+}
+
+func (w *numberinputElement) OnFocus() {
+	w.onFocus()
+}
+
+func (w *numberinputElement) OnBlur() {
+	w.onBlur()
+}
+
+func (w *numberinputElement) OnEnterKey(value float64) {
+	w.onEnterKey(value)
+}
+
+func (w *numberinputElement) Props() base.Widget {
+	return &NumberInput{
+		Value:       gtk.NumberInputValue(w.handle),
+		Placeholder: gtk.TextboxPlaceholder(w.handle),
+		Disabled:    !gtk.WidgetSensitive(w.handle),
+		Min:         w.min,
+		Max:         w.max,
+		Step:        gtk.NumberInputStep(w.handle),
+		Precision:   gtk.NumberInputPrecision(w.handle),
+		Prefix:      gtk.NumberInputPrefix(w.handle),
+		Suffix:      gtk.NumberInputSuffix(w.handle),
+		OnChange:    w.onChange,
+		OnFocus:     w.onFocus,
+		OnBlur:      w.onBlur,
+		OnEnterKey:  w.onEnterKey,
+	}
+}
+
+func (w *numberinputElement) updateProps(data *NumberInput) error {
+	gtk.NumberInputUpdate(w.handle, data.Value, data.Placeholder, data.Disabled,
+		data.Min, data.Max, data.Step, data.Precision, data.Prefix, data.Suffix,
+		data.OnChange != nil, data.OnFocus != nil, data.OnBlur != nil, data.OnEnterKey != nil)
+
+	w.min = data.Min
+	w.max = data.Max
+	w.onChange = data.OnChange
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+	w.onEnterKey = data.OnEnterKey
+
+	return nil
+}
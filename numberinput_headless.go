@@ -0,0 +1,93 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type numberinputElement struct {
+	Control
+	props NumberInput
+	spin  spinText
+}
+
+func (w *NumberInput) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &numberinputElement{
+		Control: newControl(parent, 120, 23),
+	}
+	retval.node.CanFocus = true
+	retval.node.OnFocus = retval.onFocus
+	retval.node.OnBlur = retval.onBlur
+	retval.node.OnKey = retval.onKey
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// commit parses the text, and updates the value.
+func (w *numberinputElement) commit() {
+	value, err := parseNumber(w.spin.text, w.props.Prefix, w.props.Suffix)
+	if err == nil {
+		props := w.props
+		props.Value = value
+		props.UpdateValue()
+		if props.Value != w.props.Value {
+			w.props.Value = props.Value
+			if w.props.OnChange != nil {
+				w.props.OnChange(props.Value)
+			}
+		}
+	}
+	w.spin.text = formatNumber(w.props.Value, w.props.Precision, w.props.Prefix, w.props.Suffix)
+}
+
+func (w *numberinputElement) onFocus() {
+	w.spin.selected = true
+	if w.props.OnFocus != nil {
+		w.props.OnFocus()
+	}
+}
+
+func (w *numberinputElement) onBlur() {
+	w.commit()
+	w.spin.selected = false
+	if w.props.OnBlur != nil {
+		w.props.OnBlur()
+	}
+}
+
+func (w *numberinputElement) onKey(r rune) {
+	if r != '\n' {
+		w.spin.key(r)
+		return
+	}
+
+	w.commit()
+	if w.props.OnEnterKey != nil {
+		w.props.OnEnterKey(w.props.Value)
+	}
+}
+
+func (w *numberinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	paintTextField(dst, bounds, w.node, w.spin.text, w.props.Placeholder, w.props.Disabled)
+}
+
+func (w *numberinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *numberinputElement) updateProps(data *NumberInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+	w.spin.text = formatNumber(data.Value, data.Precision, data.Prefix, data.Suffix)
+
+	return nil
+}
@@ -0,0 +1,184 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package goey
+
+import (
+	"syscall/js"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/js"
+)
+
+type numberinputElement struct {
+	Control
+
+	min, max   float64
+	step       float64
+	precision  uint
+	prefix     string
+	suffix     string
+	onChange   func(float64)
+	onEnterKey func(float64)
+
+	onChangeCB   goeyjs.ChangeStringCB
+	onFocus      goeyjs.FocusCB
+	onBlur       goeyjs.BlurCB
+	onEnterKeyCB goeyjs.EnterKeyCB
+	onStep       goeyjs.KeyStepCB
+}
+
+func (w *NumberInput) mount(parent base.Control) (base.Element, error) {
+	// Create the control.  A text input is used instead of a number input so
+	// that the prefix and suffix can be displayed.
+	handle := goeyjs.CreateElement("input", "goey form-control")
+	handle.Set("type", "text")
+	handle.Set("inputMode", "decimal")
+	parent.Handle.Call("appendChild", handle)
+
+	// Create the element
+	retval := &numberinputElement{
+		Control: Control{handle},
+	}
+	retval.updateProps(w)
+	retval.onStep.Set(handle, retval.stepValue)
+
+	return retval, nil
+}
+
+func (w *numberinputElement) Close() {
+	w.onChangeCB.Close()
+	w.onFocus.Close()
+	w.onBlur.Close()
+	w.onEnterKeyCB.Close()
+	w.onStep.Close()
+
+	w.Control.Close()
+}
+
+func (w *numberinputElement) createMeasurementElement() js.Value {
+	handle := goeyjs.CreateElement("input", "form-control goey-measure")
+	handle.Set("type", "number")
+
+	goeyjs.AppendChildToBody(handle)
+
+	return handle
+}
+
+func (w *numberinputElement) Layout(bc base.Constraints) base.Size {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := base.FromPixelsX(handle.Get("offsetWidth").Int() + 1)
+	width = bc.ConstrainWidth(width)
+	height := base.FromPixelsY(handle.Get("offsetHeight").Int() + 1)
+	height = bc.ConstrainHeight(height)
+
+	return base.Size{width, height}
+}
+
+func (w *numberinputElement) MinIntrinsicHeight(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	height := handle.Get("offsetHeight").Int()
+
+	return base.FromPixelsY(height)
+}
+
+func (w *numberinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := handle.Get("offsetWidth").Int()
+
+	return base.FromPixelsX(width + 1)
+}
+
+func (w *numberinputElement) value() (float64, error) {
+	value, err := parseNumber(w.handle.Get("value").String(), w.prefix, w.suffix)
+	if err != nil {
+		return 0, err
+	}
+	if value < w.min {
+		value = w.min
+	} else if value > w.max {
+		value = w.max
+	}
+	return value, nil
+}
+
+func (w *numberinputElement) stepValue(steps int) {
+	value, err := w.value()
+	if err != nil {
+		// Text in the control is not a valid number.  Leave it for the user
+		// to correct.
+		return
+	}
+
+	value = stepNumber(value, steps, w.step, w.min, w.max)
+	w.handle.Set("value", formatNumber(value, w.precision, w.prefix, w.suffix))
+	if w.onChange != nil {
+		w.onChange(roundNumber(value, w.precision))
+	}
+}
+
+func (w *numberinputElement) thunkOnChange(string) {
+	if value, err := w.value(); err == nil {
+		w.onChange(value)
+	}
+}
+
+func (w *numberinputElement) thunkOnEnterKey(string) {
+	if value, err := w.value(); err == nil {
+		w.onEnterKey(value)
+	}
+}
+
+func (w *numberinputElement) Props() base.Widget {
+	value, _ := parseNumber(w.handle.Get("value").String(), w.prefix, w.suffix)
+
+	return &NumberInput{
+		Value:       value,
+		Placeholder: w.handle.Get("placeholder").String(),
+		Disabled:    w.handle.Get("disabled").Truthy(),
+		Min:         w.min,
+		Max:         w.max,
+		Step:        w.step,
+		Precision:   w.precision,
+		Prefix:      w.prefix,
+		Suffix:      w.suffix,
+		OnChange:    w.onChange,
+		OnFocus:     w.onFocus.Fn,
+		OnBlur:      w.onBlur.Fn,
+		OnEnterKey:  w.onEnterKey,
+	}
+}
+
+func (w *numberinputElement) updateProps(data *NumberInput) error {
+	w.min, w.max = data.Min, data.Max
+	w.step = data.Step
+	w.precision = data.Precision
+	w.prefix, w.suffix = data.Prefix, data.Suffix
+
+	w.handle.Set("value", formatNumber(data.Value, data.Precision, data.Prefix, data.Suffix))
+	w.handle.Set("placeholder", data.Placeholder)
+	w.handle.Set("disabled", data.Disabled)
+
+	w.onChange = data.OnChange
+	if data.OnChange != nil {
+		w.onChangeCB.Set(w.handle, w.thunkOnChange)
+	} else {
+		w.onChangeCB.Set(w.handle, nil)
+	}
+	w.onEnterKey = data.OnEnterKey
+	if data.OnEnterKey != nil {
+		w.onEnterKeyCB.Set(w.handle, w.thunkOnEnterKey)
+	} else {
+		w.onEnterKeyCB.Set(w.handle, nil)
+	}
+	w.onFocus.Set(w.handle, data.OnFocus)
+	w.onBlur.Set(w.handle, data.OnBlur)
+
+	return nil
+}
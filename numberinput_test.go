@@ -0,0 +1,225 @@
+package goey
+
+import (
+	"math"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/chaolihf/goey/base"
+)
+
+func TestNumberInputMount(t *testing.T) {
+	testMountWidgets(t,
+		&NumberInput{Value: 1},
+		&NumberInput{Value: 2, Placeholder: "..."},
+		&NumberInput{Value: 3, Disabled: true},
+		&NumberInput{Value: 4.25, Min: 0, Max: 10, Precision: 2},
+		&NumberInput{Value: 5.5, Min: -1000, Max: 1000, Step: 0.5, Precision: 1},
+		&NumberInput{Value: 6.75, Precision: 2, Prefix: "$"},
+		&NumberInput{Value: 7, Suffix: " kg"},
+	)
+}
+
+func TestNumberInputClose(t *testing.T) {
+	testCloseWidgets(t,
+		&NumberInput{Value: 1},
+		&NumberInput{Value: 2, Placeholder: "..."},
+		&NumberInput{Value: 3, Disabled: true},
+		&NumberInput{Value: 4.25, Min: 0, Max: 10, Precision: 2},
+		&NumberInput{Value: 7, Prefix: "$", Suffix: " kg"},
+	)
+}
+
+func TestNumberInputOnFocus(t *testing.T) {
+	testCheckFocusAndBlur(t,
+		&NumberInput{},
+		&NumberInput{},
+		&NumberInput{},
+	)
+}
+
+func TestNumberInputOnChange(t *testing.T) {
+	log := make([]float64, 0)
+
+	testTypeKeys(t, "12.5",
+		&NumberInput{Precision: 1, OnChange: func(v float64) {
+			log = append(log, v)
+		}})
+
+	want := []float64{1, 12, 12, 12.5}
+	if runtime.GOOS == "linux" {
+		// Control does not output events for intermediate typing.
+		want = []float64{12.5}
+	}
+	if !reflect.DeepEqual(want, log) {
+		t.Errorf("Wanted %v, got %v", want, log)
+	}
+}
+
+func TestNumberInputOnEnterKey(t *testing.T) {
+	got := float64(0)
+
+	testTypeKeys(t, "12.5\n",
+		&NumberInput{Precision: 1, OnEnterKey: func(v float64) {
+			got = v
+		}})
+
+	const want = 12.5
+	if got != want {
+		t.Errorf("Wanted %v, got %v", want, got)
+	}
+}
+
+func TestNumberInputUpdateProps(t *testing.T) {
+	testUpdateWidgets(t, []base.Widget{
+		&NumberInput{Value: 1},
+		&NumberInput{Value: 2, Placeholder: "..."},
+		&NumberInput{Value: 3, Disabled: true},
+		&NumberInput{Value: 4, Prefix: "$"},
+	}, []base.Widget{
+		&NumberInput{Value: 1.5, Precision: 1},
+		&NumberInput{Value: 4, Disabled: true},
+		&NumberInput{Value: 5, Placeholder: "***"},
+		&NumberInput{Value: 6.25, Precision: 2, Suffix: "%"},
+	})
+}
+
+func TestNumberInputUpdateRange(t *testing.T) {
+	cases := []struct {
+		min, max, step float64
+		precision      uint
+		outMin, outMax float64
+		outStep        float64
+	}{
+		{0, 0, 0, 0, -math.MaxFloat64, math.MaxFloat64, 1},
+		{0, 10, 0, 0, 0, 10, 1},
+		{0, 10, 0, 2, 0, 10, 0.01},
+		{0, 10, 0.25, 2, 0, 10, 0.25},
+		{-1, 1, -1, 1, -1, 1, 0.1},
+	}
+
+	for i, v := range cases {
+		widget := NumberInput{
+			Min:       v.min,
+			Max:       v.max,
+			Step:      v.step,
+			Precision: v.precision,
+		}
+
+		widget.UpdateRange()
+		if widget.Min != v.outMin || widget.Max != v.outMax {
+			t.Errorf("case %d: got [%v,%v], want [%v,%v]", i, widget.Min, widget.Max, v.outMin, v.outMax)
+		}
+		if widget.Step != v.outStep {
+			t.Errorf("case %d: got step %v, want %v", i, widget.Step, v.outStep)
+		}
+	}
+}
+
+func TestNumberInputUpdateValue(t *testing.T) {
+	cases := []struct {
+		in, min, max float64
+		precision    uint
+		out          float64
+	}{
+		{0, 10, 20, 0, 10},
+		{15, 10, 20, 0, 15},
+		{25, 10, 20, 0, 20},
+		{-20, -10, 10, 0, -10},
+		{1.25, 0, 10, 0, 1},
+		{1.25, 0, 10, 1, 1.2},
+		{1.26, 0, 10, 1, 1.3},
+		{1.25, 0, 10, 2, 1.25},
+		{math.NaN(), -10, 10, 0, 0},
+	}
+
+	for i, v := range cases {
+		widget := NumberInput{
+			Value:     v.in,
+			Min:       v.min,
+			Max:       v.max,
+			Precision: v.precision,
+		}
+
+		widget.UpdateValue()
+		if got := widget.Value; got != v.out {
+			t.Errorf("case %d: got %v, want %v", i, got, v.out)
+		}
+	}
+}
+
+func TestNumberInputFormat(t *testing.T) {
+	cases := []struct {
+		value          float64
+		precision      uint
+		prefix, suffix string
+		text           string
+	}{
+		{1, 0, "", "", "1"},
+		{1.5, 2, "", "", "1.50"},
+		{-2.25, 2, "$", "", "$-2.25"},
+		{75, 0, "", " kg", "75 kg"},
+		{12.5, 1, "", "%", "12.5%"},
+	}
+
+	for i, v := range cases {
+		if got := formatNumber(v.value, v.precision, v.prefix, v.suffix); got != v.text {
+			t.Errorf("case %d: got %q, want %q", i, got, v.text)
+		}
+		got, err := parseNumber(v.text, v.prefix, v.suffix)
+		if err != nil {
+			t.Errorf("case %d: unexpected error, %s", i, err)
+		} else if got != v.value {
+			t.Errorf("case %d: got %v, want %v", i, got, v.value)
+		}
+	}
+}
+
+func TestNumberInputParse(t *testing.T) {
+	cases := []struct {
+		text           string
+		prefix, suffix string
+		value          float64
+		ok             bool
+	}{
+		{"1.5", "", "", 1.5, true},
+		{" 1.5 ", "", "", 1.5, true},
+		{"1.5", "$", "", 1.5, true},
+		{"$ 1.5", "$", "", 1.5, true},
+		{"75", "", " kg", 75, true},
+		{"75kg", "", " kg", 75, true},
+		{"75 lb", "", " kg", 0, false},
+		{"", "", "", 0, false},
+		{"abc", "", "", 0, false},
+	}
+
+	for i, v := range cases {
+		got, err := parseNumber(v.text, v.prefix, v.suffix)
+		if ok := err == nil; ok != v.ok {
+			t.Errorf("case %d: got error %v", i, err)
+		} else if ok && got != v.value {
+			t.Errorf("case %d: got %v, want %v", i, got, v.value)
+		}
+	}
+}
+
+func TestNumberInputStep(t *testing.T) {
+	cases := []struct {
+		value          float64
+		steps          int
+		step, min, max float64
+		out            float64
+	}{
+		{1, 1, 0.5, 0, 10, 1.5},
+		{1, -1, 0.5, 0, 10, 0.5},
+		{1, -3, 0.5, 0, 10, 0},
+		{9.75, 1, 0.5, 0, 10, 10},
+	}
+
+	for i, v := range cases {
+		if got := stepNumber(v.value, v.steps, v.step, v.min, v.max); got != v.out {
+			t.Errorf("case %d: got %v, want %v", i, got, v.out)
+		}
+	}
+}
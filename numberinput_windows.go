@@ -0,0 +1,366 @@
+//go:build !headless
+// +build !headless
+
+package goey
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/goey/base"
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+var (
+	numberinput struct {
+		oldWindowProc       uintptr
+		oldUpDownWindowProc uintptr
+	}
+)
+
+func (w *NumberInput) mount(parent base.Control) (base.Element, error) {
+	// Create the control
+	style := uint32(win.WS_CHILD | win.WS_VISIBLE | win.WS_TABSTOP | win.ES_LEFT | win.ES_AUTOHSCROLL)
+	if w.OnEnterKey != nil {
+		style = style | win.ES_MULTILINE
+	}
+	text := formatNumber(w.Value, w.Precision, w.Prefix, w.Suffix)
+	hwnd, _, err := createControlWindow(win.WS_EX_CLIENTEDGE, &edit.className[0], text, style, parent.HWnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the updown control.  The updown control is not used to track
+	// the value, as it only supports integers.  Instead, the notifications
+	// are used to step the value.
+	hwndUpDown, _, err := createControlWindow(win.WS_EX_LEFT|win.WS_EX_LTRREADING,
+		&intinput.className[0],
+		"",
+		win.WS_CHILDWINDOW|win.WS_VISIBLE|win.UDS_ARROWKEYS|win.UDS_HOTTRACK,
+		parent.HWnd)
+	if err != nil {
+		win.DestroyWindow(hwnd)
+		return nil, err
+	}
+	win.SendMessage(hwndUpDown, win.UDM_SETRANGE32, 0, 2)
+	win.SendMessage(hwndUpDown, win.UDM_SETPOS32, 0, 1)
+
+	if w.Disabled {
+		win.EnableWindow(hwnd, false)
+		win.EnableWindow(hwndUpDown, false)
+	}
+
+	// Create placeholder, if required.
+	if w.Placeholder != "" {
+		textPlaceholder, err := syscall.UTF16PtrFromString(w.Placeholder)
+		if err != nil {
+			win.DestroyWindow(hwndUpDown)
+			win.DestroyWindow(hwnd)
+			return nil, err
+		}
+
+		win.SendMessage(hwnd, win.EM_SETCUEBANNER, 0, uintptr(unsafe.Pointer(textPlaceholder)))
+	}
+
+	// Create the return value.
+	retval := &numberinputElement{
+		Control:    Control{hwnd},
+		hwndUpDown: hwndUpDown,
+		min:        w.Min,
+		max:        w.Max,
+		step:       w.Step,
+		precision:  w.Precision,
+		prefix:     w.Prefix,
+		suffix:     w.Suffix,
+		onChange:   w.OnChange,
+		onFocus:    w.OnFocus,
+		onBlur:     w.OnBlur,
+		onEnterKey: w.OnEnterKey,
+	}
+
+	// Link the control back to Go for event handling
+	subclassWindowProcedure(hwnd, &numberinput.oldWindowProc, numberinputWindowProc)
+	win.SetWindowLongPtr(hwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(retval)))
+	subclassWindowProcedure(hwndUpDown, &numberinput.oldUpDownWindowProc, numberinputUpDownWindowProc)
+	win.SetWindowLongPtr(hwndUpDown, win.GWLP_USERDATA, uintptr(unsafe.Pointer(retval)))
+
+	return retval, nil
+}
+
+type numberinputElement struct {
+	Control
+	hwndUpDown win.HWND
+
+	min        float64
+	max        float64
+	step       float64
+	precision  uint
+	prefix     string
+	suffix     string
+	onChange   func(float64)
+	onFocus    func()
+	onBlur     func()
+	onEnterKey func(float64)
+}
+
+func (w *numberinputElement) Close() {
+	if w.hwndUpDown != 0 {
+		win.DestroyWindow(w.hwndUpDown)
+		w.hwndUpDown = 0
+	}
+	if w.Hwnd != 0 {
+		win.DestroyWindow(w.Hwnd)
+		w.Hwnd = 0
+	}
+}
+
+func (w *numberinputElement) getClampedValue() (float64, error) {
+	// Get the text from the control, and convert text to a number
+	value, err := parseNumber(win2.GetWindowText(w.Hwnd), w.prefix, w.suffix)
+	if err != nil {
+		return 0, err
+	}
+	// Clamp the value
+	if value < w.min {
+		value = w.min
+	} else if value > w.max {
+		value = w.max
+	}
+
+	return value, nil
+}
+
+func (w *numberinputElement) stepValue(steps int) {
+	value, err := w.getClampedValue()
+	if err != nil {
+		// Text in the control is not a valid number.  Leave it for the user
+		// to correct.
+		return
+	}
+
+	value = stepNumber(value, steps, w.step, w.min, w.max)
+	win2.SetWindowText(w.Hwnd, formatNumber(value, w.precision, w.prefix, w.suffix))
+	win.SendMessage(w.Hwnd, win.EM_SETSEL, 0, 0x7fff)
+}
+
+func (w *numberinputElement) thunkOnChange() {
+	value, err := w.getClampedValue()
+	if err != nil {
+		// The text is not a number, which can occur while the user is
+		// still typing.
+		return
+	}
+	w.onChange(value)
+}
+
+func (w *numberinputElement) thunkOnEnterKey() {
+	value, err := w.getClampedValue()
+	if err != nil {
+		// The text is not a number, and there is no value to report.
+		return
+	}
+	w.onEnterKey(value)
+}
+
+func (w *numberinputElement) Layout(bc base.Constraints) base.Size {
+	width := w.MinIntrinsicWidth(0)
+	height := w.MinIntrinsicHeight(0)
+	return bc.Constrain(base.Size{width, height})
+}
+
+func (w *numberinputElement) MinIntrinsicHeight(base.Length) base.Length {
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/dn742486.aspx#sizingandspacing
+	return 23 * DIP
+}
+
+func (w *numberinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	return 75 * DIP
+}
+
+func (w *numberinputElement) Props() base.Widget {
+	// Ignoring the error.  If the text is not a valid number, the value will
+	// be reported as zero.
+	value, _ := parseNumber(w.Control.Text(), w.prefix, w.suffix)
+
+	return &NumberInput{
+		Value:       value,
+		Placeholder: propsPlaceholder(w.Hwnd),
+		Disabled:    !win.IsWindowEnabled(w.Hwnd),
+		Min:         w.min,
+		Max:         w.max,
+		Step:        w.step,
+		Precision:   w.precision,
+		Prefix:      w.prefix,
+		Suffix:      w.suffix,
+		OnChange:    w.onChange,
+		OnFocus:     w.onFocus,
+		OnBlur:      w.onBlur,
+		OnEnterKey:  w.onEnterKey,
+	}
+}
+
+func (w *numberinputElement) SetBounds(bounds base.Rectangle) {
+	buddyWidth := (23 * DIP) * 2 / 3
+
+	if bounds.Dx() >= 4*buddyWidth {
+		win.MoveWindow(w.Hwnd, int32(bounds.Min.X.PixelsX()), int32(bounds.Min.Y.PixelsY()), int32((bounds.Dx() - buddyWidth).PixelsX()), int32(bounds.Dy().PixelsY()), false)
+		win.MoveWindow(w.hwndUpDown, int32((bounds.Max.X - buddyWidth).PixelsX()), int32(bounds.Min.Y.PixelsY()), int32(buddyWidth.PixelsX()), int32(bounds.Dy().PixelsY()), false)
+		win.ShowWindow(w.hwndUpDown, win.SW_SHOW)
+	} else {
+		win.MoveWindow(w.Hwnd, int32(bounds.Min.X.PixelsX()), int32(bounds.Min.Y.PixelsY()), int32(bounds.Dx().PixelsX()), int32(bounds.Dy().PixelsY()), false)
+		win.ShowWindow(w.hwndUpDown, win.SW_HIDE)
+	}
+}
+
+func (w *numberinputElement) SetOrder(previous win.HWND) win.HWND {
+	win.SetWindowPos(w.hwndUpDown, previous, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOREDRAW|0x400)
+	win.SetWindowPos(w.Hwnd, w.hwndUpDown, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOREDRAW|0x400)
+	return w.Hwnd
+}
+
+func (w *numberinputElement) TakeFocus() bool {
+	ok := w.Control.TakeFocus()
+	if ok {
+		win.SendMessage(w.Hwnd, win.EM_SETSEL, 0, 0x7fff)
+	}
+	return ok
+}
+
+func (w *numberinputElement) updateProps(data *NumberInput) error {
+	// Update the formatting before the text, so that any events will use
+	// the new prefix and suffix.
+	w.min = data.Min
+	w.max = data.Max
+	w.step = data.Step
+	w.precision = data.Precision
+	w.prefix = data.Prefix
+	w.suffix = data.Suffix
+
+	text := formatNumber(data.Value, data.Precision, data.Prefix, data.Suffix)
+	if text != w.Text() {
+		_, err := win2.SetWindowText(w.Hwnd, text)
+		if err != nil {
+			return err
+		}
+	}
+	err := updatePlaceholder(w.Hwnd, data.Placeholder)
+	if err != nil {
+		return err
+	}
+	w.SetDisabled(data.Disabled)
+	win.EnableWindow(w.hwndUpDown, !data.Disabled)
+
+	w.onChange = data.OnChange
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+	w.onEnterKey = data.OnEnterKey
+
+	return nil
+}
+
+func numberinputWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) (result uintptr) {
+	switch msg {
+	case win.WM_DESTROY:
+		// Make sure that the data structure on the Go-side does not point to a non-existent
+		// window.
+		numberinputGetPtr(hwnd).Hwnd = 0
+		// Defer to the old window proc
+
+	case win.WM_SETFOCUS:
+		if w := numberinputGetPtr(hwnd); w.onFocus != nil {
+			w.onFocus()
+		}
+		// Defer to the old window proc
+
+	case win.WM_KILLFOCUS:
+		if w := numberinputGetPtr(hwnd); w.onBlur != nil {
+			w.onBlur()
+		}
+		// Defer to the old window proc
+
+	case win.WM_KEYDOWN:
+		switch wParam {
+		case win.VK_RETURN:
+			if w := numberinputGetPtr(hwnd); w.onEnterKey != nil {
+				w.thunkOnEnterKey()
+				return 0
+			}
+		case win.VK_UP:
+			numberinputGetPtr(hwnd).stepValue(1)
+			return 0
+		case win.VK_DOWN:
+			numberinputGetPtr(hwnd).stepValue(-1)
+			return 0
+		}
+		// Defer to the old window proc
+
+	case win.WM_COMMAND:
+		// WM_COMMAND is sent to the parent, which will only forward certain
+		// message.  This code should only ever see EN_UPDATE, but we will
+		// still check.
+		switch notification := win.HIWORD(uint32(wParam)); notification {
+		case win.EN_UPDATE:
+			if w := numberinputGetPtr(hwnd); w.onChange != nil {
+				w.thunkOnChange()
+			}
+		}
+		return 0
+
+	}
+
+	return win.CallWindowProc(numberinput.oldWindowProc, hwnd, msg, wParam, lParam)
+}
+
+func numberinputUpDownWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) (result uintptr) {
+	switch msg {
+	case win.WM_DESTROY:
+		// Make sure that the data structure on the Go-side does not point to a non-existent
+		// window.
+		numberinputUpDownGetPtr(hwnd).hwndUpDown = 0
+		// Defer to the old window proc
+
+	case win.WM_NOTIFY:
+		// WM_NOTIFY is sent to the parent, which forwards the message back
+		// to the control.
+		switch code := (*win.NMHDR)(unsafe.Pointer(lParam)).Code; code {
+		case win.UDN_DELTAPOS:
+			nmhdr := (*win.NMUPDOWN)(unsafe.Pointer(lParam))
+			numberinputUpDownGetPtr(hwnd).stepValue(int(nmhdr.IDelta))
+			// Prevent the updown control from changing its position.
+			return 1
+		}
+		return 0
+
+	}
+
+	return win.CallWindowProc(numberinput.oldUpDownWindowProc, hwnd, msg, wParam, lParam)
+}
+
+func numberinputGetPtr(hwnd win.HWND) *numberinputElement {
+	gwl := win.GetWindowLongPtr(hwnd, win.GWLP_USERDATA)
+	if gwl == 0 {
+		panic("Internal error.")
+	}
+
+	ptr := (*numberinputElement)(unsafe.Pointer(gwl))
+	if ptr.Hwnd != hwnd && ptr.Hwnd != 0 {
+		panic("Internal error.")
+	}
+
+	return ptr
+}
+
+func numberinputUpDownGetPtr(hwnd win.HWND) *numberinputElement {
+	gwl := win.GetWindowLongPtr(hwnd, win.GWLP_USERDATA)
+	if gwl == 0 {
+		panic("Internal error.")
+	}
+
+	ptr := (*numberinputElement)(unsafe.Pointer(gwl))
+	if ptr.hwndUpDown != hwnd && ptr.hwndUpDown != 0 {
+		panic("Internal error.")
+	}
+
+	return ptr
+}
@@ -0,0 +1,88 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type paragraphElement struct {
+	Control
+	props P
+}
+
+func (w *P) mount(parent base.Control) (base.Element, error) {
+	retval := &paragraphElement{
+		Control: newControl(parent, 0, 0),
+		props:   *w,
+	}
+	retval.node.Paint = retval.paint
+
+	return retval, nil
+}
+
+func (w *paragraphElement) paint(dst draw.Image, bounds image.Rectangle) {
+	lines := headless.WrapText(w.props.Text, bounds.Dx())
+	for i, v := range lines {
+		pt := image.Point{bounds.Min.X, bounds.Min.Y + i*headless.LineHeight}
+		switch w.props.Align {
+		case JustifyCenter:
+			pt.X += (bounds.Dx() - headless.TextSize(v).X) / 2
+		case JustifyRight:
+			pt.X += bounds.Dx() - headless.TextSize(v).X
+		}
+		headless.DrawText(dst, pt, v, headless.Text)
+	}
+}
+
+func (w *paragraphElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *paragraphElement) measureReflowLimits() {
+	paragraphMaxWidth = base.FromPixelsX(80 * headless.CharWidth)
+}
+
+// naturalWidth returns the width of the longest line, without any wrapping.
+func (w *paragraphElement) naturalWidth() base.Length {
+	width := 0
+	for _, v := range headless.WrapText(w.props.Text, 1<<30) {
+		if x := headless.TextSize(v).X; x > width {
+			width = x
+		}
+	}
+	// Ensure that the paragraph has a non-zero size, even if the text is
+	// empty.
+	if width < headless.CharWidth {
+		width = headless.CharWidth
+	}
+	return base.FromPixelsX(width)
+}
+
+func (w *paragraphElement) MinIntrinsicHeight(width base.Length) base.Length {
+	if width == base.Inf {
+		width = w.maxReflowWidth()
+	}
+
+	lines := headless.WrapText(w.props.Text, width.PixelsX())
+	return base.FromPixelsY(len(lines) * headless.LineHeight)
+}
+
+func (w *paragraphElement) MinIntrinsicWidth(height base.Length) base.Length {
+	if height != base.Inf {
+		return min(w.naturalWidth(), w.maxReflowWidth())
+	}
+
+	return min(w.naturalWidth(), w.minReflowWidth())
+}
+
+func (w *paragraphElement) updateProps(data *P) error {
+	w.props = *data
+	return nil
+}
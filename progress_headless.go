@@ -0,0 +1,46 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type progressElement struct {
+	Control
+	props Progress
+}
+
+func (w *Progress) mount(parent base.Control) (base.Element, error) {
+	retval := &progressElement{
+		Control: newControl(parent, 120, 16),
+		props:   *w,
+	}
+	retval.node.Paint = retval.paint
+
+	return retval, nil
+}
+
+func (w *progressElement) paint(dst draw.Image, bounds image.Rectangle) {
+	paintFrame(dst, bounds, headless.Face, w.node)
+	if w.props.Max > w.props.Min {
+		inner := bounds.Inset(1)
+		inner.Max.X = inner.Min.X + inner.Dx()*(w.props.Value-w.props.Min)/(w.props.Max-w.props.Min)
+		headless.Fill(dst, inner, headless.Accent)
+	}
+}
+
+func (w *progressElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *progressElement) updateProps(data *Progress) error {
+	w.props = *data
+	return nil
+}
@@ -10,13 +10,14 @@ var (
 
 // SelectInput describes a widget that users can click to select one from a fixed list of choices.
 type SelectInput struct {
-	Items    []string        // Items is an array of strings representing the user's possible choices
-	Value    int             // Value is the index of the currently selected item
-	Unset    bool            // Unset is a flag indicating that no choice has yet been made
-	Disabled bool            // Disabled is a flag indicating that the user cannot interact with this field
-	OnChange func(value int) // OnChange will be called whenever the user changes the value for this field
-	OnFocus  func()          // OnFocus will be called whenever the field receives the keyboard focus
-	OnBlur   func()          // OnBlur will be called whenever the field loses the keyboard focus
+	Items     []string        // Items is an array of strings representing the user's possible choices
+	Value     int             // Value is the index of the currently selected item
+	Unset     bool            // Unset is a flag indicating that no choice has yet been made
+	Disabled  bool            // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus bool            // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
+	OnChange  func(value int) // OnChange will be called whenever the user changes the value for this field
+	OnFocus   func()          // OnFocus will be called whenever the field receives the keyboard focus
+	OnBlur    func()          // OnBlur will be called whenever the field loses the keyboard focus
 }
 
 // Kind returns the concrete type for use in the Widget interface.
@@ -33,7 +34,11 @@ func (w *SelectInput) Mount(parent base.Control) (base.Element, error) {
 	w.UpdateValue()
 
 	// Forward to the platform-dependant code
-	return w.mount(parent)
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
 }
 
 // UpdateValue will ensure that the Value is within the range of choices
@@ -0,0 +1,62 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type selectinputElement struct {
+	Control
+	props SelectInput
+}
+
+func (w *SelectInput) mount(parent base.Control) (base.Element, error) {
+	retval := &selectinputElement{
+		Control: newControl(parent, 120, 23),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// Select simulates a user choosing one of the items.
+func (w *selectinputElement) Select(index int) {
+	if w.props.Disabled || index < 0 || index >= len(w.props.Items) {
+		return
+	}
+	if index == w.props.Value && !w.props.Unset {
+		return
+	}
+
+	w.props.Value, w.props.Unset = index, false
+	if w.props.OnChange != nil {
+		w.props.OnChange(index)
+	}
+}
+
+func (w *selectinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	text := ""
+	if !w.props.Unset {
+		text = w.props.Items[w.props.Value]
+	}
+	paintTextField(dst, bounds, w.node, text, "", w.props.Disabled)
+}
+
+func (w *selectinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *selectinputElement) updateProps(data *SelectInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
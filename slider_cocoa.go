@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package goey
@@ -30,6 +31,10 @@ func (w *sliderElement) Close() {
 	}
 }
 
+func (w *sliderElement) isClosed() bool {
+	return w.control == nil
+}
+
 func (w *sliderElement) Layout(bc base.Constraints) base.Size {
 	px, h := w.control.IntrinsicContentSize()
 	return bc.Constrain(base.Size{
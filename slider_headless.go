@@ -0,0 +1,70 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type sliderElement struct {
+	Control
+	props Slider
+}
+
+func (w *Slider) mount(parent base.Control) (base.Element, error) {
+	retval := &sliderElement{
+		Control: newControl(parent, 120, 24),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// SetValue simulates a user dragging the slider.  The value is clamped to
+// the allowed range.
+func (w *sliderElement) SetValue(value float64) {
+	if w.props.Disabled {
+		return
+	}
+
+	props := w.props
+	props.Value = value
+	props.UpdateValue()
+	if props.Value == w.props.Value {
+		return
+	}
+	w.props.Value = props.Value
+	if w.props.OnChange != nil {
+		w.props.OnChange(props.Value)
+	}
+}
+
+func (w *sliderElement) paint(dst draw.Image, bounds image.Rectangle) {
+	y := (bounds.Min.Y + bounds.Max.Y) / 2
+	headless.Fill(dst, image.Rect(bounds.Min.X+4, y-1, bounds.Max.X-4, y+1), headless.Border)
+
+	x := bounds.Min.X + 4
+	if w.props.Max > w.props.Min {
+		x += int(float64(bounds.Dx()-8) * (w.props.Value - w.props.Min) / (w.props.Max - w.props.Min))
+	}
+	paintFrame(dst, image.Rect(x-4, y-8, x+4, y+8), headless.Face, w.node)
+}
+
+func (w *sliderElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *sliderElement) updateProps(data *Slider) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
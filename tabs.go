@@ -2,6 +2,7 @@ package goey
 
 import (
 	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/windows"
 )
 
 var (
@@ -17,12 +18,19 @@ var (
 //
 // When calling UpdateProps, setting Value to an integer less than zero will
 // leave the currently selected tab unchanged.
+//
+// If StateStore is set, the selected tab is saved under StateKey whenever the
+// user selects a different tab, and a saved value replaces Value when the tabs
+// are mounted.  Applications that update the tabs should initialize Value
+// using LoadValue, so that later updates do not change the selected tab.
 type Tabs struct {
-	Value           int       // Index of the selected tab
-	Children        []TabItem // Description of the tabs
-	Insets          Insets    // Space between edge of element and the child element.
-	WithCloseButton bool      // Whether to show close button on the tab
-	OnChange        func(int) // OnChange will be called whenever the user selects a different tab
+	Value           int                // Index of the selected tab
+	Children        []TabItem          // Description of the tabs
+	Insets          Insets             // Space between edge of element and the child element.
+	WithCloseButton bool               // Whether to show close button on the tab
+	OnChange        func(int)          // OnChange will be called whenever the user selects a different tab
+	StateStore      windows.StateStore // Optional store used to persist the selected tab
+	StateKey        string             // Key used to save the selected tab in the store
 }
 
 // TabItem describes a tab for a Tab widget.
@@ -40,12 +48,50 @@ func (*Tabs) Kind() *base.Kind {
 // Mount creates a tabs control in the GUI.
 // The newly created widget will be a child of the widget specified by parent.
 func (w *Tabs) Mount(parent base.Control) (base.Element, error) {
+	// Restore any persisted value.
+	w.LoadValue()
 	// Ensure that the Value is a useable index.
 	w.UpdateValue()
 	// Forward to the platform-dependant code
 	return w.mount(parent)
 }
 
+// LoadValue replaces Value with the index saved in the StateStore, if any.
+// Errors are ignored, and leave Value unchanged.
+func (w *Tabs) LoadValue() {
+	if w.StateStore == nil || w.StateKey == "" {
+		return
+	}
+
+	value := 0
+	if ok, err := w.StateStore.Load(w.StateKey, &value); ok && err == nil {
+		w.Value = value
+	}
+}
+
+// tabsStateStore persists the selected tab for an element.  It is embedded
+// in the platform specific elements, so that the element can save the value
+// before calling OnChange, without modifying the widget's callback.
+type tabsStateStore struct {
+	stateStore windows.StateStore
+	stateKey   string
+}
+
+func newTabsStateStore(w *Tabs) tabsStateStore {
+	return tabsStateStore{w.StateStore, w.StateKey}
+}
+
+// saveValue saves the selected tab, if the element has a StateStore.
+func (s *tabsStateStore) saveValue(value int) {
+	if s.stateStore == nil || s.stateKey == "" {
+		return
+	}
+
+	// Errors are ignored.  Failing to persist the selection should not
+	// interrupt the user.
+	s.stateStore.Save(s.stateKey, value)
+}
+
 // UpdateValue ensures that the index for the currently selected tab is with the
 // allowed range.  If the list of tabs is empty, then the index will be
 // negative.
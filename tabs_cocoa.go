@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package goey
@@ -15,6 +16,7 @@ type tabsElement struct {
 	widgets  []TabItem
 	insets   Insets
 	onChange func(int)
+	tabsStateStore
 
 	cachedBounds base.Rectangle
 	cachedInsets base.Point
@@ -40,12 +42,13 @@ func (w *Tabs) mount(parent base.Control) (base.Element, error) {
 	}
 
 	retval := &tabsElement{
-		control:  control,
-		child:    child,
-		value:    w.Value,
-		widgets:  w.Children,
-		insets:   w.Insets,
-		onChange: w.OnChange,
+		control:        control,
+		child:          child,
+		value:          w.Value,
+		widgets:        w.Children,
+		insets:         w.Insets,
+		onChange:       w.OnChange,
+		tabsStateStore: newTabsStateStore(w),
 	}
 	control.SetOnChange(retval.OnChange)
 	return retval, nil
@@ -97,6 +100,7 @@ func (w *tabsElement) mountPage(page int) {
 
 func (w *tabsElement) OnChange(page int) {
 	if page != w.value {
+		w.saveValue(page)
 		if w.onChange != nil {
 			w.onChange(page)
 		}
@@ -117,10 +121,12 @@ func (w *tabsElement) Props() base.Widget {
 	}
 
 	return &Tabs{
-		Value:    w.value,
-		Children: children,
-		Insets:   w.insets,
-		OnChange: w.onChange,
+		Value:      w.value,
+		Children:   children,
+		Insets:     w.insets,
+		OnChange:   w.onChange,
+		StateStore: w.stateStore,
+		StateKey:   w.stateKey,
 	}
 }
 
@@ -164,6 +170,7 @@ func (w *tabsElement) updateProps(data *Tabs) error {
 		}
 	}
 	w.widgets = data.Children
+	w.tabsStateStore = newTabsStateStore(data)
 
 	// Update the selected widget
 	if data.Value != w.value {
@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package goey
@@ -17,6 +18,7 @@ type tabsElement struct {
 	widgets  []TabItem
 	insets   Insets
 	onChange func(int)
+	tabsStateStore
 
 	cachedInsets base.Point
 	cachedBounds base.Rectangle
@@ -25,7 +27,7 @@ type tabsElement struct {
 
 func (w *Tabs) mount(parent base.Control) (base.Element, error) {
 	control := gtk.MountTabs(parent.Handle, w.Value, w.serializeItems(),
-		w.OnChange != nil)
+		w.OnChange != nil || w.StateStore != nil)
 
 	child := base.Element(nil)
 	if len(w.Children) > 0 {
@@ -39,12 +41,13 @@ func (w *Tabs) mount(parent base.Control) (base.Element, error) {
 	}
 
 	retval := &tabsElement{
-		Control:  Control{control},
-		child:    child,
-		value:    w.Value,
-		widgets:  w.Children,
-		insets:   w.Insets,
-		onChange: w.OnChange,
+		Control:        Control{control},
+		child:          child,
+		value:          w.Value,
+		widgets:        w.Children,
+		insets:         w.Insets,
+		onChange:       w.OnChange,
+		tabsStateStore: newTabsStateStore(w),
 	}
 	gtk.RegisterWidget(control, retval)
 
@@ -65,6 +68,7 @@ func (w *Tabs) serializeItems() string {
 
 func (w *tabsElement) OnChange(page int) {
 	if page != w.value {
+		w.saveValue(page)
 		if w.onChange != nil {
 			w.onChange(page)
 		}
@@ -131,10 +135,12 @@ func (w *tabsElement) Props() base.Widget {
 	}
 
 	return &Tabs{
-		Value:    w.value,
-		Children: children,
-		Insets:   w.insets,
-		OnChange: w.onChange,
+		Value:      w.value,
+		Children:   children,
+		Insets:     w.insets,
+		OnChange:   w.onChange,
+		StateStore: w.stateStore,
+		StateKey:   w.stateKey,
 	}
 }
 
@@ -164,8 +170,9 @@ func (w *tabsElement) SetBounds(bounds base.Rectangle) {
 
 func (w *tabsElement) updateProps(data *Tabs) error {
 	gtk.TabsUpdate(w.handle, data.Value, data.serializeItems(),
-		data.OnChange != nil)
+		data.OnChange != nil || data.StateStore != nil)
 	w.widgets = data.Children
+	w.tabsStateStore = newTabsStateStore(data)
 
 	// Update the selected widget
 	if data.Value == w.value {
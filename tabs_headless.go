@@ -0,0 +1,225 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+const (
+	// Height of the strip of tabs, in pixels.
+	tabsStripHeight = 24
+	// Padding between the caption of a tab and its edges, in pixels.
+	tabsCaptionPadding = 8
+)
+
+// TabsElement is the element created by mounting a Tabs.
+type TabsElement struct {
+	Control
+	child    base.Element
+	value    int
+	insets   Insets
+	widgets  []TabItem
+	onChange func(int)
+	tabsStateStore
+	withCloseButton bool
+	cachedBounds    base.Rectangle
+}
+
+func (w *Tabs) mount(parent base.Control) (base.Element, error) {
+	retval := &TabsElement{
+		Control:         newControl(parent, 0, 0),
+		value:           w.Value,
+		insets:          w.Insets,
+		widgets:         w.Children,
+		onChange:        w.OnChange,
+		tabsStateStore:  newTabsStateStore(w),
+		withCloseButton: w.WithCloseButton,
+	}
+	retval.node.Paint = retval.paint
+
+	// The child for the selected tab is placed inside of the tabs, so
+	// that its position is relative to the tabs.
+	if w.Value >= 0 {
+		child, err := base.Mount(base.Control{Node: retval.node}, w.Children[w.Value].Child)
+		if err != nil {
+			retval.Control.Close()
+			return nil, err
+		}
+		retval.child = child
+	}
+
+	return retval, nil
+}
+
+func (w *TabsElement) Close() {
+	if w.child != nil {
+		w.child.Close()
+		w.child = nil
+	}
+	w.Control.Close()
+}
+
+func (w *TabsElement) contentInsets() base.Point {
+	return base.Point{
+		X: base.FromPixelsX(2),
+		Y: base.FromPixelsY(tabsStripHeight + 1),
+	}
+}
+
+func (w *TabsElement) controlTabsMinWidth() base.Length {
+	width := 0
+	for _, v := range w.widgets {
+		width += headless.TextSize(v.Caption).X + 2*tabsCaptionPadding
+	}
+	return base.FromPixelsX(width)
+}
+
+// captionBounds returns the bounds for each tab in the strip, relative to the
+// top-left corner of the control.
+func (w *TabsElement) captionBounds() []image.Rectangle {
+	ret := make([]image.Rectangle, len(w.widgets))
+	x := 0
+	for i, v := range w.widgets {
+		dx := headless.TextSize(v.Caption).X + 2*tabsCaptionPadding
+		ret[i] = image.Rect(x, 0, x+dx, tabsStripHeight)
+		x += dx
+	}
+	return ret
+}
+
+func (w *TabsElement) paint(dst draw.Image, bounds image.Rectangle) {
+	panel := bounds
+	panel.Min.Y += tabsStripHeight
+	headless.Fill(dst, panel, headless.Background)
+	headless.Stroke(dst, panel, headless.Border)
+
+	for i, v := range w.captionBounds() {
+		v = v.Add(bounds.Min)
+		if i == w.value {
+			headless.Fill(dst, v, headless.Background)
+		} else {
+			headless.Fill(dst, v, headless.Face)
+		}
+		headless.Stroke(dst, v, headless.Border)
+		headless.DrawText(dst, centerText(v, w.widgets[i].Caption), w.widgets[i].Caption, headless.Text)
+	}
+}
+
+func (w *TabsElement) Props() base.Widget {
+	return &Tabs{
+		Value:           w.value,
+		Children:        w.widgets,
+		Insets:          w.insets,
+		WithCloseButton: w.withCloseButton,
+		OnChange:        w.onChange,
+		StateStore:      w.stateStore,
+		StateKey:        w.stateKey,
+	}
+}
+
+func (w *TabsElement) SetBounds(bounds base.Rectangle) {
+	w.Control.SetBounds(bounds)
+
+	// Determine the bounds for the child widget, relative to the control.
+	insets := w.contentInsets()
+	w.cachedBounds = base.Rectangle{
+		Min: base.Point{
+			X: insets.X/2 + w.insets.Left,
+			Y: insets.Y + w.insets.Top,
+		},
+		Max: base.Point{
+			X: bounds.Dx() - insets.X/2 - w.insets.Right,
+			Y: bounds.Dy() - w.insets.Bottom,
+		},
+	}
+	if w.child != nil {
+		w.child.SetBounds(w.cachedBounds)
+	}
+}
+
+// ClickTab simulates a user clicking on one of the tabs.
+func (w *TabsElement) ClickTab(index int) error {
+	if index < 0 || index >= len(w.widgets) || index == w.value {
+		return nil
+	}
+
+	w.saveValue(index)
+	if w.onChange != nil {
+		w.onChange(index)
+	}
+	return w.mountPage(index)
+}
+
+func (w *TabsElement) GetTabItems() []TabItem {
+	return w.widgets
+}
+
+func (w *TabsElement) UpdateTabItems(items []TabItem) error {
+	w.widgets = items
+	if w.value >= len(items) {
+		return w.mountPage(len(items) - 1)
+	}
+	return nil
+}
+
+func (w *TabsElement) SelectItem(index int) {
+	if w.value != index && index >= 0 && index < len(w.widgets) {
+		// Errors cannot be reported.  The previous page will remain
+		// visible.
+		_ = w.mountPage(index)
+	}
+}
+
+func (w *TabsElement) GetItemCountDirect() int {
+	return len(w.widgets)
+}
+
+func (w *TabsElement) GetSelectItemDirect() int {
+	return w.value
+}
+
+// mountPage replaces the child with the contents of the selected tab.
+func (w *TabsElement) mountPage(index int) error {
+	if w.child != nil {
+		w.child.Close()
+		w.child = nil
+	}
+	w.value = index
+	if index < 0 {
+		return nil
+	}
+
+	child, err := base.Mount(base.Control{Node: w.node}, w.widgets[index].Child)
+	if err != nil {
+		return err
+	}
+	child.Layout(base.Tight(base.Size{
+		Width:  w.cachedBounds.Dx(),
+		Height: w.cachedBounds.Dy(),
+	}))
+	child.SetBounds(w.cachedBounds)
+	w.child = child
+	return nil
+}
+
+func (w *TabsElement) updateProps(data *Tabs) (err error) {
+	w.widgets = data.Children
+	w.withCloseButton = data.WithCloseButton
+	w.onChange = data.OnChange
+	w.tabsStateStore = newTabsStateStore(data)
+
+	// Update the selected tab.
+	if data.Value != w.value {
+		return w.mountPage(data.Value)
+	}
+	if data.Value >= 0 {
+		w.child, err = base.DiffChild(base.Control{Node: w.node}, w.child, data.Children[data.Value].Child)
+	}
+	return err
+}
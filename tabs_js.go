@@ -1,5 +1,5 @@
-//go:build go1.12
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package goey
 
@@ -21,6 +21,7 @@ type tabsElement struct {
 	widgets  []TabItem
 	insets   Insets
 	onChange func(int)
+	tabsStateStore
 
 	cachedInsets base.Point
 	cachedBounds base.Rectangle
@@ -115,6 +116,7 @@ func (w *tabsElement) mountPage(page int) error {
 
 func (w *tabsElement) onClick(value int) {
 	if value != w.value {
+		w.saveValue(value)
 		if w.onChange != nil {
 			w.onChange(value)
 		}
@@ -140,10 +142,12 @@ func (w *tabsElement) Props() base.Widget {
 	}
 
 	return &Tabs{
-		Value:    w.value,
-		Children: children,
-		Insets:   w.insets,
-		OnChange: w.onChange,
+		Value:      w.value,
+		Children:   children,
+		Insets:     w.insets,
+		OnChange:   w.onChange,
+		StateStore: w.stateStore,
+		StateKey:   w.stateKey,
 	}
 }
 
@@ -213,6 +217,8 @@ func updateTabItems(handle js.Value, clickCB js.Value, items []TabItem) {
 func (w *tabsElement) updateProps(data *Tabs) error {
 	updateTabItems(w.handle, w.clickCB.Value, data.Children)
 	w.widgets = data.Children
+	w.onChange = data.OnChange
+	w.tabsStateStore = newTabsStateStore(data)
 
 	if w.value != data.Value {
 		w.mountPage(data.Value)
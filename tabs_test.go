@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/goeytest"
+	"github.com/chaolihf/goey/loop"
 )
 
 func TestTabsMount(t *testing.T) {
@@ -103,3 +105,76 @@ func TestTabs_UpdateValue(t *testing.T) {
 		}
 	}
 }
+
+// memoryStateStore is a StateStore that keeps its values in memory.
+type memoryStateStore map[string]int
+
+func (s memoryStateStore) Load(key string, value interface{}) (bool, error) {
+	v, ok := s[key]
+	if ok {
+		*value.(*int) = v
+	}
+	return ok, nil
+}
+
+func (s memoryStateStore) Save(key string, value interface{}) error {
+	s[key] = value.(int)
+	return nil
+}
+
+func TestTabs_StateStore(t *testing.T) {
+	items := []TabItem{
+		{"Tab A", nil},
+		{"Tab B", nil},
+		{"Tab C", nil},
+	}
+
+	store := memoryStateStore{"tabs": 1}
+	changes := 0
+	widget := &Tabs{
+		Children:   items,
+		OnChange:   func(int) { changes++ },
+		StateStore: store,
+		StateKey:   "tabs",
+	}
+	window, closer := goeytest.WithWindow(t, widget)
+	defer closer()
+
+	err := loop.Do(func() error {
+		props := window.Child().(Proper).Props().(*Tabs)
+		if props.Value != 1 {
+			t.Errorf("Saved value not restored, want 1, got %d", props.Value)
+		}
+
+		// Updating the element should not modify the widget's callback.
+		if err := window.SetChild(widget); err != nil {
+			return err
+		}
+		widget.OnChange(0)
+		if got := store["tabs"]; got != 1 {
+			t.Errorf("Widget's OnChange saved the value, want 1, got %d", got)
+		}
+		changes = 0
+
+		// Selecting a tab should save the value, and still call OnChange
+		// once.
+		clicker, ok := window.Child().(interface{ ClickTab(int) error })
+		if !ok {
+			t.Log("Cannot simulate clicking on a tab")
+			return nil
+		}
+		if err := clicker.ClickTab(2); err != nil {
+			return err
+		}
+		if got := store["tabs"]; got != 2 {
+			t.Errorf("Value not saved, want 2, got %d", got)
+		}
+		if changes != 1 {
+			t.Errorf("OnChange not called once, got %d calls", changes)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("failed loop.Do: %s", err)
+	}
+}
@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package goey
 
 import (
@@ -73,6 +76,7 @@ func (w *Tabs) mount(parent base.Control) (base.Element, error) {
 		insets:          w.Insets,
 		widgets:         w.Children,
 		onChange:        w.OnChange,
+		tabsStateStore:  newTabsStateStore(w),
 		withCloseButton: w.WithCloseButton,
 	}
 
@@ -85,12 +89,13 @@ func (w *Tabs) mount(parent base.Control) (base.Element, error) {
 
 type TabsElement struct {
 	Control
-	child           base.Element
-	parent          base.Control
-	value           int
-	insets          Insets
-	widgets         []TabItem
-	onChange        func(int)
+	child    base.Element
+	parent   base.Control
+	value    int
+	insets   Insets
+	widgets  []TabItem
+	onChange func(int)
+	tabsStateStore
 	withCloseButton bool
 	cachedInsets    base.Point
 	cachedBounds    base.Rectangle
@@ -133,9 +138,11 @@ func (w *TabsElement) Props() base.Widget {
 	}
 
 	return &Tabs{
-		Value:    int(win.SendMessage(w.Hwnd, win.TCM_GETCURSEL, 0, 0)),
-		Children: children,
-		OnChange: w.onChange,
+		Value:      int(win.SendMessage(w.Hwnd, win.TCM_GETCURSEL, 0, 0)),
+		Children:   children,
+		OnChange:   w.onChange,
+		StateStore: w.stateStore,
+		StateKey:   w.stateKey,
 	}
 }
 
@@ -271,6 +278,7 @@ func (w *TabsElement) updateProps(data *Tabs) error {
 
 	// Update event handlers
 	w.onChange = data.OnChange
+	w.tabsStateStore = newTabsStateStore(data)
 
 	return nil
 }
@@ -441,6 +449,7 @@ func tabsWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) (
 				if n.Code == uint32(0x100000000+win.TCN_SELCHANGE) {
 					cursel := int(win.SendMessage(hwnd, win.TCM_GETCURSEL, 0, 0))
 					if w := tabsGetPtr(hwnd); w.value != cursel {
+						w.saveValue(cursel)
 						if w.onChange != nil {
 							w.onChange(cursel)
 						}
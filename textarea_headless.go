@@ -0,0 +1,96 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type textareaElement struct {
+	Control
+	props TextArea
+}
+
+func (w *TextArea) mount(parent base.Control) (base.Element, error) {
+	retval := &textareaElement{
+		Control: newControl(parent, 120, 0),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.node.OnKey = retval.onKey
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+func (w *textareaElement) onKey(r rune) {
+	if w.props.ReadOnly {
+		return
+	}
+
+	value, ok := w.props.Value, false
+	if r == '\n' {
+		value, ok = value+"\n", true
+	} else {
+		value, ok = editText(value, r)
+	}
+	if !ok {
+		return
+	}
+
+	w.props.Value = value
+	if w.props.OnChange != nil {
+		w.props.OnChange(value)
+	}
+}
+
+func (w *textareaElement) paint(dst draw.Image, bounds image.Rectangle) {
+	fill := color.Color(headless.Background)
+	if w.props.Disabled {
+		fill = headless.Face
+	}
+	paintFrame(dst, bounds, fill, w.node)
+
+	pt := bounds.Min.Add(image.Point{4, 4})
+	if w.props.Value == "" {
+		headless.DrawText(dst, pt, w.props.Placeholder, headless.GrayText)
+		return
+	}
+	headless.DrawLines(dst, pt, strings.Split(w.props.Value, "\n"), textColor(w.props.Disabled))
+}
+
+func (w *textareaElement) Layout(bc base.Constraints) base.Size {
+	width := bc.ConstrainWidth(w.MinIntrinsicWidth(base.Inf))
+	height := w.MinIntrinsicHeight(width)
+	return bc.Constrain(base.Size{width, height})
+}
+
+func (w *textareaElement) MinIntrinsicHeight(base.Length) base.Length {
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/dn742486.aspx#sizingandspacing
+	const lineHeight = 16 * DIP
+	lines := strings.Count(w.props.Value, "\n") + 1
+	if lines < w.props.MinLines {
+		lines = w.props.MinLines
+	}
+	return 23*DIP + lineHeight.Scale(lines-1, 1)
+}
+
+func (w *textareaElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *textareaElement) updateProps(data *TextArea) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+	w.props.MinLines = minlinesDefault(data.MinLines)
+
+	return nil
+}
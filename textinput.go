@@ -17,6 +17,7 @@ type TextInput struct {
 	Value       string             // Value is the current string for the field
 	Placeholder string             // Placeholder is a descriptive text that can be displayed when the field is empty
 	Disabled    bool               // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus   bool               // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
 	Password    bool               // Password is a flag indicating that the characters should be hidden
 	ReadOnly    bool               // ReadOnly is a flag indicate that the contents cannot be modified by the user
 	OnChange    func(value string) // OnChange will be called whenever the user changes the value for this field
@@ -35,7 +36,11 @@ func (*TextInput) Kind() *base.Kind {
 // will be a child of the widget specified by parent.
 func (w *TextInput) Mount(parent base.Control) (base.Element, error) {
 	// Forward to the platform-dependant code
-	return w.mount(parent)
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
 }
 
 func (*textinputElement) Kind() *base.Kind {
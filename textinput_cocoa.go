@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package goey
@@ -35,6 +36,10 @@ func (w *textinputElement) Close() {
 	}
 }
 
+func (w *textinputElement) isClosed() bool {
+	return w.control == nil
+}
+
 func (w *textinputElement) Layout(bc base.Constraints) base.Size {
 	px, h := w.control.IntrinsicContentSize()
 	return bc.Constrain(base.Size{
@@ -0,0 +1,71 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type textinputElement struct {
+	Control
+	props TextInput
+}
+
+func (w *TextInput) mount(parent base.Control) (base.Element, error) {
+	retval := &textinputElement{
+		Control: newControl(parent, 120, 23),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.node.OnKey = retval.onKey
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+func (w *textinputElement) onKey(r rune) {
+	if r == '\n' {
+		if w.props.OnEnterKey != nil {
+			w.props.OnEnterKey(w.props.Value)
+		}
+		return
+	}
+	if w.props.ReadOnly {
+		return
+	}
+
+	value, ok := editText(w.props.Value, r)
+	if !ok {
+		return
+	}
+	w.props.Value = value
+	if w.props.OnChange != nil {
+		w.props.OnChange(value)
+	}
+}
+
+func (w *textinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	text := w.props.Value
+	if w.props.Password {
+		text = strings.Repeat("*", utf8.RuneCountInString(text))
+	}
+	paintTextField(dst, bounds, w.node, text, w.props.Placeholder, w.props.Disabled)
+}
+
+func (w *textinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *textinputElement) updateProps(data *TextInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package goey
+
+import (
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+var (
+	timeInputKind = base.NewKind("github.com/chaolihf/goey.TimeInput")
+)
+
+// TimeInput describes a widget that users input or update a time of day.
+// The model for the value is a time.Time value, but only the hours, minutes,
+// and seconds are used.  When reporting changes, the date will be the same
+// as the date of the field Value.
+//
+// The fields Min and Max may be left as the zero value for time.Time, in
+// which case the range for the time is unbounded in that direction.  When
+// set, only the time of day for Min and Max is used.
+type TimeInput struct {
+	Value     time.Time             // Value is the current time of day for the field
+	Disabled  bool                  // Disabled is a flag indicating that the user cannot interact with this field
+	Autofocus bool                  // Autofocus is a flag indicating that this field takes the keyboard focus when mounted
+	Seconds   bool                  // Seconds is a flag indicating that seconds can be edited
+	Hour12    bool                  // Hour12 is a flag indicating that the time uses a 12-hour clock
+	Min, Max  time.Time             // Min and Max set the range of Value, if not zero
+	OnChange  func(value time.Time) // OnChange will be called whenever the user changes the value for this field
+	OnFocus   func()                // OnFocus will be called whenever the field receives the keyboard focus
+	OnBlur    func()                // OnBlur will be called whenever the field loses the keyboard focus
+}
+
+// Kind returns the concrete type for use in the Widget interface.
+// Users should not need to use this method directly.
+func (*TimeInput) Kind() *base.Kind {
+	return &timeInputKind
+}
+
+// Mount creates a time field in the GUI.  The newly created widget
+// will be a child of the widget specified by parent.
+func (w *TimeInput) Mount(parent base.Control) (base.Element, error) {
+	// Make sure that the value is within the range.
+	w.UpdateValue()
+
+	// Forward to the platform-dependant code
+	elem, err := w.mount(parent)
+	if err == nil && w.Autofocus {
+		autofocus(elem)
+	}
+	return elem, err
+}
+
+// UpdateValue clamps the time of day for the field Value to the range
+// [Min,Max].  If either Min or Max is zero, then the range is not bounded
+// in that direction.  If seconds are not shown, Value is truncated to the
+// minute.
+func (w *TimeInput) UpdateValue() {
+	value := w.Value
+	if !w.Seconds {
+		value = withClock(value, value.Hour(), value.Minute(), 0)
+	}
+	if !w.Min.IsZero() && clockSeconds(value) < clockSeconds(w.Min) {
+		value = withClock(value, w.Min.Hour(), w.Min.Minute(), w.Min.Second())
+	}
+	if !w.Max.IsZero() && clockSeconds(value) > clockSeconds(w.Max) {
+		value = withClock(value, w.Max.Hour(), w.Max.Minute(), w.Max.Second())
+	}
+	w.Value = value
+}
+
+func (*timeinputElement) Kind() *base.Kind {
+	return &timeInputKind
+}
+
+func (w *timeinputElement) UpdateProps(data base.Widget) error {
+	widget := data.(*TimeInput)
+
+	// Make sure that the value is within the range.
+	widget.UpdateValue()
+	// Forward to the platform-dependant code
+	return w.updateProps(widget)
+}
+
+// clockSeconds returns the number of seconds since midnight.
+func clockSeconds(t time.Time) int {
+	return t.Hour()*3600 + t.Minute()*60 + t.Second()
+}
+
+// withClock returns a new time.Time with the same date as t, but with the
+// time of day replaced.
+func withClock(t time.Time, hour, minute, second int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, t.Location())
+}
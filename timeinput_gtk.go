@@ -0,0 +1,118 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package goey
+
+import (
+	"time"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type timeinputElement struct {
+	Control
+	date     time.Time // The control only tracks the clock.
+	min, max time.Time
+
+	onChange func(time.Time)
+	onFocus  func()
+	onBlur   func()
+}
+
+func (w *TimeInput) flags() uint {
+	flags := uint(0)
+	if w.Seconds {
+		flags |= gtk.TimeSeconds
+	}
+	if w.Hour12 {
+		flags |= gtk.TimeHour12
+	}
+	return flags
+}
+
+// secondsRange returns the range for the control, as the number of seconds
+// since midnight.
+func (w *TimeInput) secondsRange() (float64, float64) {
+	min, max := 0, 24*60*60-1
+	if !w.Min.IsZero() {
+		min = clockSeconds(w.Min)
+	}
+	if !w.Max.IsZero() {
+		max = clockSeconds(w.Max)
+	}
+	return float64(min), float64(max)
+}
+
+func (w *TimeInput) mount(parent base.Control) (base.Element, error) {
+	min, max := w.secondsRange()
+	control := gtk.MountTimeInput(parent.Handle,
+		float64(clockSeconds(w.Value)), min, max, w.flags(), w.Disabled,
+		w.OnChange != nil, w.OnFocus != nil, w.OnBlur != nil)
+
+	// Create the element
+	retval := &timeinputElement{
+		Control:  Control{control},
+		date:     w.Value,
+		min:      w.Min,
+		max:      w.Max,
+		onChange: w.OnChange,
+		onFocus:  w.OnFocus,
+		onBlur:   w.OnBlur,
+	}
+	gtk.RegisterWidget(control, retval)
+
+	return retval, nil
+}
+
+func (w *timeinputElement) value() time.Time {
+	value := int(gtk.TimeInputValue(w.handle))
+	return withClock(w.date, value/3600, value/60%60, value%60)
+}
+
+func (w *timeinputElement) OnChange(value int64) {
+	if w.onChange != nil {
+		w.onChange(withClock(w.date, int(value/3600), int(value/60%60), int(value%60)))
+	}
+}
+
+func (w *timeinputElement) OnFocus() {
+	w.onFocus()
+}
+
+func (w *timeinputElement) OnBlur() {
+	w.onBlur()
+}
+
+func (w *timeinputElement) Props() base.Widget {
+	flags := gtk.TimeInputFlags(w.handle)
+
+	return &TimeInput{
+		Value:    w.value(),
+		Disabled: !gtk.WidgetSensitive(w.handle),
+		Seconds:  flags&gtk.TimeSeconds != 0,
+		Hour12:   flags&gtk.TimeHour12 != 0,
+		Min:      w.min,
+		Max:      w.max,
+		OnChange: w.onChange,
+		OnFocus:  w.onFocus,
+		OnBlur:   w.onBlur,
+	}
+}
+
+func (w *timeinputElement) updateProps(data *TimeInput) error {
+	min, max := data.secondsRange()
+	gtk.TimeInputUpdate(w.handle,
+		float64(clockSeconds(data.Value)), min, max, data.flags(), data.Disabled,
+		data.OnChange != nil, data.OnFocus != nil, data.OnBlur != nil)
+
+	w.date = data.Value
+	w.min = data.Min
+	w.max = data.Max
+	w.onChange = data.OnChange
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+
+	return nil
+}
@@ -0,0 +1,79 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/draw"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+type timeinputElement struct {
+	Control
+	props TimeInput
+}
+
+func (w *TimeInput) mount(parent base.Control) (base.Element, error) {
+	// Create the element
+	retval := &timeinputElement{
+		Control: newControl(parent, 100, 23),
+	}
+	retval.focusHandlers(&retval.props.OnFocus, &retval.props.OnBlur)
+	retval.node.Paint = retval.paint
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+// clockLayout returns the layout string used to format the time of day.
+func clockLayout(seconds, hour12 bool) string {
+	switch {
+	case seconds && hour12:
+		return "3:04:05 PM"
+	case hour12:
+		return "3:04 PM"
+	case seconds:
+		return "15:04:05"
+	default:
+		return "15:04"
+	}
+}
+
+// SetValue simulates a user selecting a time of day.  The value is
+// normalized in the same manner as for UpdateProps.
+func (w *timeinputElement) SetValue(value time.Time) {
+	if w.props.Disabled {
+		return
+	}
+
+	props := w.props
+	props.Value = value
+	props.UpdateValue()
+	if props.Value.Equal(w.props.Value) {
+		return
+	}
+	w.props.Value = props.Value
+	if w.props.OnChange != nil {
+		w.props.OnChange(props.Value)
+	}
+}
+
+func (w *timeinputElement) paint(dst draw.Image, bounds image.Rectangle) {
+	text := w.props.Value.Format(clockLayout(w.props.Seconds, w.props.Hour12))
+	paintTextField(dst, bounds, w.node, text, "", w.props.Disabled)
+}
+
+func (w *timeinputElement) Props() base.Widget {
+	props := w.props
+	return &props
+}
+
+func (w *timeinputElement) updateProps(data *TimeInput) error {
+	w.node.Disabled = data.Disabled
+	w.props = *data
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package goey
+
+import (
+	"syscall/js"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/js"
+)
+
+// timeLayout returns the format used by an HTMLInputElement of type time.
+func timeLayout(seconds bool) string {
+	if seconds {
+		return "15:04:05"
+	}
+	return "15:04"
+}
+
+type timeinputElement struct {
+	Control
+	seconds  bool
+	hour12   bool
+	min, max time.Time
+
+	onChange goeyjs.ChangeTimeCB
+	onFocus  goeyjs.FocusCB
+	onBlur   goeyjs.BlurCB
+}
+
+func (w *TimeInput) mount(parent base.Control) (base.Element, error) {
+	// Create the control
+	handle := goeyjs.CreateElement("input", "goey form-control")
+	handle.Set("type", "time")
+	parent.Handle.Call("appendChild", handle)
+
+	// Create the element
+	retval := &timeinputElement{
+		Control: Control{handle},
+	}
+	retval.updateProps(w)
+
+	return retval, nil
+}
+
+func (w *timeinputElement) Close() {
+	w.onChange.Close()
+	w.onFocus.Close()
+	w.onBlur.Close()
+
+	w.Control.Close()
+}
+
+func (w *timeinputElement) createMeasurementElement() js.Value {
+	handle := goeyjs.CreateElement("input", "form-control goey-measure")
+	handle.Set("type", "time")
+	if w.seconds {
+		handle.Set("step", 1)
+	}
+
+	goeyjs.AppendChildToBody(handle)
+
+	return handle
+}
+
+func (w *timeinputElement) Layout(bc base.Constraints) base.Size {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := base.FromPixelsX(handle.Get("offsetWidth").Int() + 1)
+	width = bc.ConstrainWidth(width)
+	height := base.FromPixelsY(handle.Get("offsetHeight").Int() + 1)
+	height = bc.ConstrainHeight(height)
+
+	return base.Size{width, height}
+}
+
+func (w *timeinputElement) MinIntrinsicHeight(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	height := handle.Get("offsetHeight").Int()
+
+	return base.FromPixelsY(height)
+}
+
+func (w *timeinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	handle := w.createMeasurementElement()
+	defer handle.Call("remove")
+
+	width := handle.Get("offsetWidth").Int()
+
+	return base.FromPixelsX(width + 1)
+}
+
+func (w *timeinputElement) Props() base.Widget {
+	value, _ := goeyjs.ParseTime(w.handle.Get("value").String(), w.onChange.Date)
+
+	return &TimeInput{
+		Value:    value,
+		Disabled: w.handle.Get("disabled").Truthy(),
+		Seconds:  w.seconds,
+		Hour12:   w.hour12,
+		Min:      w.min,
+		Max:      w.max,
+		OnChange: w.onChange.Fn,
+		OnFocus:  w.onFocus.Fn,
+		OnBlur:   w.onBlur.Fn,
+	}
+}
+
+func (w *timeinputElement) updateProps(data *TimeInput) error {
+	// The browser controls whether a 12-hour clock is used, based on the
+	// user's locale.
+	if data.Seconds {
+		w.handle.Set("step", 1)
+	} else {
+		w.handle.Call("removeAttribute", "step")
+	}
+	setTimeAttribute(w.handle, "min", data.Min, timeLayout(data.Seconds))
+	setTimeAttribute(w.handle, "max", data.Max, timeLayout(data.Seconds))
+	w.handle.Set("value", data.Value.Format(timeLayout(data.Seconds)))
+	w.handle.Set("disabled", data.Disabled)
+	w.seconds = data.Seconds
+	w.hour12 = data.Hour12
+	w.min, w.max = data.Min, data.Max
+
+	w.onChange.Date = data.Value
+	w.onChange.Set(w.handle, data.OnChange)
+	w.onFocus.Set(w.handle, data.OnFocus)
+	w.onBlur.Set(w.handle, data.OnBlur)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package goey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaolihf/goey/base"
+)
+
+func TestTimeInputMount(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2006, time.January, 2, 8, 30, 15, 0, time.Local)
+	v3 := time.Date(2006, time.January, 2, 23, 59, 0, 0, time.Local)
+
+	testMountWidgets(t,
+		&TimeInput{Value: v1},
+		&TimeInput{Value: v2, Seconds: true},
+		&TimeInput{Value: v3, Disabled: true},
+		&TimeInput{Value: v1, Hour12: true},
+		&TimeInput{Value: v1, Min: v2, Max: v3},
+	)
+}
+
+func TestTimeInputClose(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2006, time.January, 2, 8, 30, 15, 0, time.Local)
+	v3 := time.Date(2006, time.January, 2, 23, 59, 0, 0, time.Local)
+
+	testCloseWidgets(t,
+		&TimeInput{Value: v1},
+		&TimeInput{Value: v2, Seconds: true},
+		&TimeInput{Value: v3, Disabled: true},
+	)
+}
+
+func TestTimeInputEvents(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2006, time.January, 2, 8, 30, 0, 0, time.Local)
+
+	testCheckFocusAndBlur(t,
+		&TimeInput{Value: v1},
+		&TimeInput{Value: v2},
+		&TimeInput{Value: v2},
+	)
+}
+
+func TestTimeInputUpdate(t *testing.T) {
+	v1 := time.Date(2006, time.January, 2, 15, 4, 0, 0, time.Local)
+	v2 := time.Date(2006, time.January, 2, 8, 30, 15, 0, time.Local)
+
+	testUpdateWidgets(t, []base.Widget{
+		&TimeInput{Value: v1},
+		&TimeInput{Value: v2, Disabled: true, Seconds: true},
+		&TimeInput{Value: v2},
+	}, []base.Widget{
+		&TimeInput{Value: v2, Seconds: true},
+		&TimeInput{Value: v2, Disabled: false},
+		&TimeInput{Value: v1, Disabled: true, Hour12: true},
+	})
+}
+
+func TestTimeInputUpdateValue(t *testing.T) {
+	clock := func(hour, minute, second int) time.Time {
+		return time.Date(2006, time.January, 2, hour, minute, second, 0, time.Local)
+	}
+
+	cases := []struct {
+		in       time.Time
+		seconds  bool
+		min, max time.Time
+		out      time.Time
+	}{
+		{clock(15, 4, 5), false, time.Time{}, time.Time{}, clock(15, 4, 0)},
+		{clock(15, 4, 5), true, time.Time{}, time.Time{}, clock(15, 4, 5)},
+		{clock(6, 0, 0), false, clock(8, 0, 0), clock(17, 0, 0), clock(8, 0, 0)},
+		{clock(12, 0, 0), false, clock(8, 0, 0), clock(17, 0, 0), clock(12, 0, 0)},
+		{clock(18, 0, 0), false, clock(8, 0, 0), clock(17, 0, 0), clock(17, 0, 0)},
+		{clock(6, 0, 0), false, clock(8, 0, 0), time.Time{}, clock(8, 0, 0)},
+		{clock(18, 0, 0), false, time.Time{}, clock(17, 0, 0), clock(17, 0, 0)},
+		// Only the time of day for the range is used.
+		{clock(6, 0, 0), false, time.Date(2010, time.May, 6, 8, 0, 0, 0, time.Local), time.Time{}, clock(8, 0, 0)},
+	}
+
+	for i, v := range cases {
+		widget := TimeInput{
+			Value:   v.in,
+			Seconds: v.seconds,
+			Min:     v.min,
+			Max:     v.max,
+		}
+
+		widget.UpdateValue()
+		if got := widget.Value; !got.Equal(v.out) {
+			t.Errorf("case %d: got %v, want %v", i, got, v.out)
+		}
+	}
+}
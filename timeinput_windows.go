@@ -0,0 +1,231 @@
+//go:build !headless
+// +build !headless
+
+package goey
+
+import (
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/win"
+)
+
+var (
+	oldTimePickWindowProc uintptr
+)
+
+// timeFormat returns the format string used by the date and time picker
+// control (see DTM_SETFORMAT).
+func timeFormat(seconds, hour12 bool) string {
+	if hour12 {
+		if seconds {
+			return "hh':'mm':'ss tt"
+		}
+		return "hh':'mm tt"
+	}
+	if seconds {
+		return "HH':'mm':'ss"
+	}
+	return "HH':'mm"
+}
+
+// timepick contains the state shared by the elements for TimeInput and
+// DateTimeInput, which are both created using a date and time picker control.
+type timepick struct {
+	Control
+	seconds  bool
+	hour12   bool
+	min      time.Time
+	max      time.Time
+	onChange func(value time.Time)
+	onFocus  func()
+	onBlur   func()
+}
+
+func mountTimepick(parent base.Control, style uint32, format string, value time.Time) (win.HWND, error) {
+	hwnd, _, err := createControlWindow(0, &datetimepickClassName[0], "", win.WS_CHILD|win.WS_VISIBLE|win.WS_TABSTOP|style, parent.HWnd)
+	if err != nil {
+		return 0, err
+	}
+
+	err = setTimepickFormat(hwnd, format)
+	if err != nil {
+		win.DestroyWindow(hwnd)
+		return 0, err
+	}
+	st := toSystemTime(value)
+	win.SendMessage(hwnd, win.DTM_SETSYSTEMTIME, win.GDT_VALID, uintptr(unsafe.Pointer(&st)))
+
+	// Subclass the window procedure
+	subclassWindowProcedure(hwnd, &oldTimePickWindowProc, timepickWindowProc)
+
+	return hwnd, nil
+}
+
+func setTimepickFormat(hwnd win.HWND, format string) error {
+	text, err := syscall.UTF16PtrFromString(format)
+	if err != nil {
+		return err
+	}
+	win.SendMessage(hwnd, win.DTM_SETFORMAT, 0, uintptr(unsafe.Pointer(text)))
+	return nil
+}
+
+func (w *timepick) value() time.Time {
+	st := win.SYSTEMTIME{}
+	win.SendMessage(w.Hwnd, win.DTM_GETSYSTEMTIME, 0, uintptr(unsafe.Pointer(&st)))
+	return fromSystemTime(&st)
+}
+
+func (w *timepick) setValue(value time.Time, min, max time.Time) {
+	// Update the range before the value, so that the new value is not clamped
+	// by the old range.
+	setDateTimeRange(w.Hwnd, min, max)
+	st := toSystemTime(value)
+	win.SendMessage(w.Hwnd, win.DTM_SETSYSTEMTIME, win.GDT_VALID, uintptr(unsafe.Pointer(&st)))
+}
+
+func (w *TimeInput) mount(parent base.Control) (base.Element, error) {
+	hwnd, err := mountTimepick(parent, win.DTS_TIMEFORMAT, timeFormat(w.Seconds, w.Hour12), w.Value)
+	if err != nil {
+		return nil, err
+	}
+	if w.Disabled {
+		win.EnableWindow(hwnd, false)
+	}
+
+	retval := &timeinputElement{timepick{
+		Control:  Control{hwnd},
+		seconds:  w.Seconds,
+		hour12:   w.Hour12,
+		min:      w.Min,
+		max:      w.Max,
+		onChange: w.OnChange,
+		onFocus:  w.OnFocus,
+		onBlur:   w.OnBlur,
+	}}
+	min, max := w.clockRange()
+	retval.setValue(w.Value, min, max)
+	win.SetWindowLongPtr(hwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(&retval.timepick)))
+
+	return retval, nil
+}
+
+// clockRange returns the range for the control.  The control stores a
+// complete date, so the range uses the date from the field Value.
+func (w *TimeInput) clockRange() (time.Time, time.Time) {
+	min, max := time.Time{}, time.Time{}
+	if !w.Min.IsZero() {
+		min = withClock(w.Value, w.Min.Hour(), w.Min.Minute(), w.Min.Second())
+	}
+	if !w.Max.IsZero() {
+		max = withClock(w.Value, w.Max.Hour(), w.Max.Minute(), w.Max.Second())
+	}
+	return min, max
+}
+
+type timeinputElement struct {
+	timepick
+}
+
+func (w *timeinputElement) Layout(bc base.Constraints) base.Size {
+	height := w.MinIntrinsicHeight(0)
+	width := w.MinIntrinsicWidth(0)
+	return bc.Constrain(base.Size{width, height})
+}
+
+func (w *timeinputElement) MinIntrinsicHeight(base.Length) base.Length {
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/dn742486.aspx#sizingandspacing
+	return 23 * DIP
+}
+
+func (w *timeinputElement) MinIntrinsicWidth(base.Length) base.Length {
+	// https://msdn.microsoft.com/en-us/library/windows/desktop/dn742486.aspx#sizingandspacing
+	return 75 * DIP
+}
+
+func (w *timeinputElement) Props() base.Widget {
+	return &TimeInput{
+		Value:    w.value(),
+		Disabled: !win.IsWindowEnabled(w.Hwnd),
+		Seconds:  w.seconds,
+		Hour12:   w.hour12,
+		Min:      w.min,
+		Max:      w.max,
+		OnChange: w.onChange,
+		OnFocus:  w.onFocus,
+		OnBlur:   w.onBlur,
+	}
+}
+
+func (w *timeinputElement) updateProps(data *TimeInput) error {
+	if data.Seconds != w.seconds || data.Hour12 != w.hour12 {
+		err := setTimepickFormat(w.Hwnd, timeFormat(data.Seconds, data.Hour12))
+		if err != nil {
+			return err
+		}
+	}
+	min, max := data.clockRange()
+	w.setValue(data.Value, min, max)
+
+	w.SetDisabled(data.Disabled)
+	w.seconds = data.Seconds
+	w.hour12 = data.Hour12
+	w.min = data.Min
+	w.max = data.Max
+	w.onChange = data.OnChange
+	w.onFocus = data.OnFocus
+	w.onBlur = data.OnBlur
+	return nil
+}
+
+func timepickWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) (result uintptr) {
+	switch msg {
+	case win.WM_DESTROY:
+		// Make sure that the data structure on the Go-side does not point to a non-existent
+		// window.
+		timepickGetPtr(hwnd).Hwnd = 0
+		// Defer to the old window proc
+
+	case win.WM_SETFOCUS:
+		if w := timepickGetPtr(hwnd); w.onFocus != nil {
+			w.onFocus()
+		}
+		// Defer to the old window proc
+
+	case win.WM_KILLFOCUS:
+		if w := timepickGetPtr(hwnd); w.onBlur != nil {
+			w.onBlur()
+		}
+		// Defer to the old window proc
+
+	case win.WM_NOTIFY:
+		switch code := (*win.NMHDR)(unsafe.Pointer(lParam)).Code; code {
+		case win.DTN_DATETIMECHANGE:
+			if w := timepickGetPtr(hwnd); w.onChange != nil {
+				nmhdr := (*win.NMDATETIMECHANGE)(unsafe.Pointer(lParam))
+				w.onChange(fromSystemTime(&nmhdr.St))
+			}
+		}
+		return 0
+
+	}
+
+	return win.CallWindowProc(oldTimePickWindowProc, hwnd, msg, wParam, lParam)
+}
+
+func timepickGetPtr(hwnd win.HWND) *timepick {
+	gwl := win.GetWindowLongPtr(hwnd, win.GWLP_USERDATA)
+	if gwl == 0 {
+		panic("Internal error.")
+	}
+
+	ptr := (*timepick)(unsafe.Pointer(gwl))
+	if ptr.Hwnd != hwnd && ptr.Hwnd != 0 {
+		panic("Internal error.")
+	}
+
+	return ptr
+}
@@ -0,0 +1,13 @@
+// Package tray provides icons in the system tray, also called the
+// notification area or status area.
+//
+// A tray icon holds a reference on the GUI event loop, so the event loop will
+// continue to run while the icon exists, even if there are no windows open.
+// This allows applications to continue running in the background.
+//
+// On Linux and other unix-like platforms, tray icons use the
+// StatusNotifierItem protocol over D-Bus, and the menu is exported using the
+// dbusmenu protocol.  The icon will be shown once a StatusNotifierWatcher is
+// available on the session bus.  On Windows, tray icons use the shell's
+// notification area.
+package tray
@@ -0,0 +1,96 @@
+package tray
+
+import (
+	"errors"
+	"image"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+var (
+	// ErrNotSupported indicates that the platform does not have a system
+	// tray.
+	ErrNotSupported = errors.New("system tray is not supported on this platform")
+)
+
+// MenuItem describes an item in the context menu for a tray icon.
+type MenuItem struct {
+	Text     string // Text for the item, or empty for a separator
+	Disabled bool   // Disabled
+	OnClick  func() // Callback when the user selects the item
+}
+
+// Icon represents an icon in the system tray.
+type Icon struct {
+	iconImpl
+}
+
+// New creates a new icon in the system tray.  The menu will be shown when the
+// user opens the context menu for the icon.
+//
+// The icon holds a reference on the GUI event loop until it is closed, so
+// the event loop will not terminate while the icon exists.  Like other
+// modifications to the GUI, this function must be called on the GUI thread.
+func New(icon image.Image, tooltip string, menu []MenuItem) (*Icon, error) {
+	// Check that the image is not nil.  Want to enforce the precondition before
+	// starting platform specific code to maintain uniformity.
+	_ = icon.Bounds()
+
+	retval := &Icon{}
+	err := retval.create(icon, tooltip, copyMenu(menu))
+	if err != nil {
+		return nil, err
+	}
+
+	loop.AddLockCount(1)
+	return retval, nil
+}
+
+// Close removes the icon from the system tray, and releases all associated
+// resources.  Once the last icon and window have been closed, the event loop
+// will terminate.
+func (i *Icon) Close() {
+	if i.isClosed() {
+		return
+	}
+
+	i.close()
+	loop.AddLockCount(-1)
+}
+
+// SetIcon changes the image shown in the system tray.
+func (i *Icon) SetIcon(img image.Image) error {
+	// Check that the image is not nil.
+	_ = img.Bounds()
+
+	// Defer to platform specific code.
+	return i.setIcon(img)
+}
+
+// SetMenu changes the items of the context menu.
+func (i *Icon) SetMenu(menu []MenuItem) error {
+	return i.setMenu(copyMenu(menu))
+}
+
+// SetOnClick changes the event callback for when the user clicks on the
+// icon.
+func (i *Icon) SetOnClick(callback func()) {
+	i.onClick = callback
+}
+
+// SetTooltip changes the text shown when the user hovers over the icon.
+func (i *Icon) SetTooltip(tooltip string) error {
+	return i.setTooltip(tooltip)
+}
+
+func copyMenu(menu []MenuItem) []MenuItem {
+	return append([]MenuItem(nil), menu...)
+}
+
+// menuItem calls the callback for the menu item at the specified index, if
+// it exists.
+func (i *iconImpl) menuItem(index int) {
+	if index >= 0 && index < len(i.menu) && i.menu[index].OnClick != nil {
+		i.menu[index].OnClick()
+	}
+}
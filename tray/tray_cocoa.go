@@ -0,0 +1,101 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package tray
+
+import (
+	"image"
+	"time"
+
+	"github.com/chaolihf/goey/internal/cocoa"
+	"github.com/chaolihf/goey/loop"
+)
+
+type iconImpl struct {
+	handle  *cocoa.StatusItem
+	onClick func()
+	menu    []MenuItem
+}
+
+func (i *iconImpl) create(icon image.Image, tooltip string, menu []MenuItem) error {
+	handle, err := cocoa.NewStatusItem(icon, tooltip)
+	if err != nil {
+		return err
+	}
+
+	i.handle = handle
+	i.handle.SetCallbacks(func() {
+		if i.onClick != nil {
+			i.onClick()
+		}
+	}, i.menuItem)
+	return i.setMenu(menu)
+}
+
+func (i *iconImpl) close() {
+	i.handle.Close()
+	i.handle = nil
+}
+
+func (i *iconImpl) isClosed() bool {
+	return i.handle == nil
+}
+
+func (i *iconImpl) setIcon(img image.Image) error {
+	return i.handle.SetImage(img)
+}
+
+func (i *iconImpl) setMenu(menu []MenuItem) error {
+	i.menu = menu
+
+	items := make([]string, len(menu))
+	disabled := make([]bool, len(menu))
+	for index, v := range menu {
+		items[index], disabled[index] = v.Text, v.Disabled
+	}
+	i.handle.SetMenu(items, disabled)
+	return nil
+}
+
+func (i *iconImpl) setTooltip(tooltip string) error {
+	i.handle.SetTooltip(tooltip)
+	return nil
+}
+
+// asyncClick simulates the user clicking on the icon after a delay.  This is
+// used for testing.
+func asyncClick(i *Icon, initialWait time.Duration) <-chan error {
+	return asyncDo(initialWait, func() {
+		if i.onClick != nil {
+			i.onClick()
+		}
+	})
+}
+
+// asyncClickMenuItem simulates the user selecting an item from the context
+// menu after a delay.  This is used for testing.
+func asyncClickMenuItem(i *Icon, index int, initialWait time.Duration) <-chan error {
+	return asyncDo(initialWait, func() {
+		i.menuItem(index)
+	})
+}
+
+func asyncDo(initialWait time.Duration, fn func()) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		time.Sleep(initialWait)
+		err := loop.Do(func() error {
+			fn()
+			return nil
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return errs
+}
@@ -0,0 +1,100 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package tray
+
+import (
+	"image"
+	"time"
+
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type iconImpl struct {
+	handle  uintptr
+	onClick func()
+	menu    []MenuItem
+}
+
+func (i *iconImpl) create(icon image.Image, tooltip string, menu []MenuItem) error {
+	handle, err := gtk.MountTray(i, icon, tooltip)
+	if err != nil {
+		return err
+	}
+
+	i.handle = handle
+	return i.setMenu(menu)
+}
+
+func (i *iconImpl) close() {
+	gtk.TrayClose(i.handle)
+	i.handle = 0
+}
+
+func (i *iconImpl) isClosed() bool {
+	return i.handle == 0
+}
+
+func (i *iconImpl) OnActivate() {
+	if i.onClick != nil {
+		i.onClick()
+	}
+}
+
+func (i *iconImpl) OnMenuItem(index int) {
+	i.menuItem(index)
+}
+
+func (i *iconImpl) setIcon(img image.Image) error {
+	gtk.TraySetIcon(i.handle, img)
+	return nil
+}
+
+func (i *iconImpl) setMenu(menu []MenuItem) error {
+	i.menu = menu
+
+	gtk.TraySetMenuCount(i.handle, len(menu))
+	for index, v := range menu {
+		gtk.TraySetMenuItem(i.handle, index, v.Text, v.Text == "", v.Disabled)
+	}
+	gtk.TrayMenuUpdated(i.handle)
+	return nil
+}
+
+func (i *iconImpl) setTooltip(tooltip string) error {
+	gtk.TraySetTooltip(i.handle, tooltip)
+	return nil
+}
+
+// asyncClick simulates the user clicking on the icon after a delay.  This is
+// used for testing.
+func asyncClick(i *Icon, initialWait time.Duration) <-chan error {
+	return asyncCall(initialWait, func() error {
+		return gtk.TrayActivateForTesting(i.handle)
+	})
+}
+
+// asyncClickMenuItem simulates the user selecting an item from the context
+// menu after a delay.  This is used for testing.
+func asyncClickMenuItem(i *Icon, index int, initialWait time.Duration) <-chan error {
+	return asyncCall(initialWait, func() error {
+		return gtk.TrayMenuItemForTesting(i.handle, index)
+	})
+}
+
+func asyncCall(initialWait time.Duration, fn func() error) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		// The call is made over D-Bus, so it is made off of the GUI thread.
+		time.Sleep(initialWait)
+		if err := fn(); err != nil {
+			errs <- err
+		}
+	}()
+
+	return errs
+}
@@ -0,0 +1,84 @@
+//go:build headless
+// +build headless
+
+package tray
+
+import (
+	"image"
+	"time"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+type iconImpl struct {
+	icon    image.Image
+	tooltip string
+	created bool
+	onClick func()
+	menu    []MenuItem
+}
+
+func (i *iconImpl) create(icon image.Image, tooltip string, menu []MenuItem) error {
+	// Without a display, the icon is only recorded.
+	i.icon, i.tooltip, i.menu = icon, tooltip, menu
+	i.created = true
+	return nil
+}
+
+func (i *iconImpl) close() {
+	i.created = false
+}
+
+func (i *iconImpl) isClosed() bool {
+	return !i.created
+}
+
+func (i *iconImpl) setIcon(img image.Image) error {
+	i.icon = img
+	return nil
+}
+
+func (i *iconImpl) setMenu(menu []MenuItem) error {
+	i.menu = menu
+	return nil
+}
+
+func (i *iconImpl) setTooltip(tooltip string) error {
+	i.tooltip = tooltip
+	return nil
+}
+
+// asyncClick simulates the user clicking on the icon after a delay.  This is
+// used for testing.
+func asyncClick(i *Icon, initialWait time.Duration) <-chan error {
+	return asyncCall(initialWait, func() error {
+		if i.onClick != nil {
+			i.onClick()
+		}
+		return nil
+	})
+}
+
+// asyncClickMenuItem simulates the user selecting an item from the context
+// menu after a delay.  This is used for testing.
+func asyncClickMenuItem(i *Icon, index int, initialWait time.Duration) <-chan error {
+	return asyncCall(initialWait, func() error {
+		i.menuItem(index)
+		return nil
+	})
+}
+
+func asyncCall(initialWait time.Duration, fn func() error) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		time.Sleep(initialWait)
+		if err := loop.Do(fn); err != nil {
+			errs <- err
+		}
+	}()
+
+	return errs
+}
@@ -0,0 +1,50 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package tray
+
+import (
+	"image"
+	"time"
+)
+
+type iconImpl struct {
+	onClick func()
+	menu    []MenuItem
+}
+
+func (i *iconImpl) create(icon image.Image, tooltip string, menu []MenuItem) error {
+	// Browsers do not provide a system tray.
+	return ErrNotSupported
+}
+
+func (i *iconImpl) close() {
+	// Do nothing
+}
+
+func (i *iconImpl) isClosed() bool {
+	return true
+}
+
+func (i *iconImpl) setIcon(img image.Image) error {
+	return ErrNotSupported
+}
+
+func (i *iconImpl) setMenu(menu []MenuItem) error {
+	return ErrNotSupported
+}
+
+func (i *iconImpl) setTooltip(tooltip string) error {
+	return ErrNotSupported
+}
+
+func asyncClick(i *Icon, initialWait time.Duration) <-chan error {
+	errs := make(chan error, 1)
+	errs <- ErrNotSupported
+	close(errs)
+	return errs
+}
+
+func asyncClickMenuItem(i *Icon, index int, initialWait time.Duration) <-chan error {
+	return asyncClick(i, initialWait)
+}
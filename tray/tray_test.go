@@ -0,0 +1,114 @@
+package tray
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/chaolihf/goey/loop"
+)
+
+var (
+	asyncWait = 500 * time.Millisecond
+)
+
+func ExampleNew() {
+	// The following creates an icon in the system tray, which keeps the
+	// application running in the background.
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+
+	err := loop.Run(func() error {
+		var icon *Icon
+		var err error
+		icon, err = New(img, "Example", []MenuItem{
+			{Text: "Status", Disabled: true},
+			{},
+			{Text: "Quit", OnClick: func() { icon.Close() }},
+		})
+		return err
+	})
+	if err != nil {
+		fmt.Println("Error: ", err)
+	}
+}
+
+func TestMain(m *testing.M) {
+	loop.TestMain(m)
+}
+
+func newTestImage(clr color.RGBA) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = clr.R, clr.G, clr.B, clr.A
+	}
+	return img
+}
+
+func TestNew(t *testing.T) {
+	clicked, selected, supported := false, -1, true
+
+	init := func() error {
+		count := loop.LockCount()
+
+		icon, err := New(newTestImage(color.RGBA{0xff, 0, 0, 0xff}), t.Name(), []MenuItem{
+			{Text: "First", OnClick: func() { selected = 0 }},
+			{},
+			{Text: "Second", OnClick: func() { selected = 2 }},
+			{Text: "Disabled", Disabled: true},
+		})
+		if err == ErrNotSupported {
+			supported = false
+			return nil
+		}
+		if err != nil {
+			t.Errorf("Failed to create icon, %s", err)
+			return nil
+		}
+		if c := loop.LockCount(); c != count+1 {
+			t.Errorf("Want lockCount==%d, got lockCount==%d", count+1, c)
+		}
+		icon.SetOnClick(func() { clicked = true })
+
+		if err := icon.SetIcon(newTestImage(color.RGBA{0, 0, 0xff, 0xff})); err != nil {
+			t.Errorf("Failed to set icon, %s", err)
+		}
+		if err := icon.SetTooltip("Tooltip"); err != nil {
+			t.Errorf("Failed to set tooltip, %s", err)
+		}
+
+		go func() {
+			if err := <-asyncClick(icon, asyncWait); err != nil {
+				t.Errorf("Failed to click icon, %s", err)
+			}
+			if err := <-asyncClickMenuItem(icon, 2, asyncWait); err != nil {
+				t.Errorf("Failed to click menu item, %s", err)
+			}
+			time.Sleep(asyncWait)
+
+			// Closing the icon should release the lock on the event loop.
+			loop.Do(func() error {
+				icon.Close()
+				icon.Close()
+				return nil
+			})
+		}()
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+	if !supported {
+		t.Skip("System tray is not supported")
+	}
+	if !clicked {
+		t.Errorf("Callback for click was not called")
+	}
+	if selected != 2 {
+		t.Errorf("Want menu item 2, got %d", selected)
+	}
+}
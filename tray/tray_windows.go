@@ -0,0 +1,282 @@
+//go:build !headless
+// +build !headless
+
+package tray
+
+import (
+	"image"
+	"syscall"
+	"time"
+	"unsafe"
+
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/win"
+)
+
+const (
+	// Message used by the shell to report mouse events for the icon.
+	wmTrayIcon = win.WM_APP + 1
+)
+
+var (
+	trayClass struct {
+		atom      win.ATOM
+		className []uint16
+	}
+
+	// Message sent when the taskbar is recreated, for example if explorer
+	// restarts.  Icons need to be added again.
+	wmTaskbarCreated uint32
+
+	// Icons that are currently shown, indexed by the hidden window used to
+	// receive events from the shell.
+	active = make(map[win.HWND]*iconImpl)
+)
+
+func init() {
+	trayClass.className = []uint16{'G', 'o', 'e', 'y', 'T', 'r', 'a', 'y', 0}
+}
+
+type iconImpl struct {
+	hwnd    win.HWND
+	hicon   win.HICON
+	tooltip string
+	onClick func()
+	menu    []MenuItem
+}
+
+func registerTrayClass() (win.ATOM, error) {
+	hInstance := win.GetModuleHandle(nil)
+	if hInstance == 0 {
+		return 0, syscall.GetLastError()
+	}
+
+	wc := win.WNDCLASSEX{
+		CbSize:        uint32(unsafe.Sizeof(win.WNDCLASSEX{})),
+		HInstance:     hInstance,
+		LpfnWndProc:   syscall.NewCallback(trayWindowProc),
+		LpszClassName: &trayClass.className[0],
+	}
+
+	atom := win.RegisterClassEx(&wc)
+	if atom == 0 {
+		return 0, syscall.GetLastError()
+	}
+
+	name := [...]uint16{'T', 'a', 's', 'k', 'b', 'a', 'r', 'C', 'r', 'e', 'a', 't', 'e', 'd', 0}
+	wmTaskbarCreated = win.RegisterWindowMessage(&name[0])
+
+	return atom, nil
+}
+
+func (i *iconImpl) create(icon image.Image, tooltip string, menu []MenuItem) error {
+	// Ensure that our custom window class has been registered.
+	if trayClass.atom == 0 {
+		atom, err := registerTrayClass()
+		if err != nil {
+			return err
+		}
+		trayClass.atom = atom
+	}
+
+	hicon, err := win2.CreateIconFromImage(icon)
+	if err != nil {
+		return err
+	}
+
+	// The shell sends events for the icon to a window, so create a
+	// message-only window for the icon.
+	hwnd := win.CreateWindowEx(0, &trayClass.className[0], nil, 0,
+		0, 0, 0, 0, win.HWND_MESSAGE, 0, win.GetModuleHandle(nil), nil)
+	if hwnd == 0 {
+		err := syscall.GetLastError()
+		win.DestroyIcon(hicon)
+		return err
+	}
+
+	i.hwnd = hwnd
+	i.hicon = hicon
+	i.tooltip = tooltip
+	i.menu = menu
+	if err := i.notify(win.NIM_ADD); err != nil {
+		win.DestroyWindow(hwnd)
+		win.DestroyIcon(hicon)
+		i.hwnd, i.hicon = 0, 0
+		return err
+	}
+	active[hwnd] = i
+	return nil
+}
+
+func (i *iconImpl) notify(message uint32) error {
+	nid := win.NOTIFYICONDATA{
+		CbSize:           uint32(unsafe.Sizeof(win.NOTIFYICONDATA{})),
+		HWnd:             i.hwnd,
+		UID:              1,
+		UFlags:           win.NIF_MESSAGE | win.NIF_ICON | win.NIF_TIP,
+		UCallbackMessage: wmTrayIcon,
+		HIcon:            i.hicon,
+	}
+	tip := syscall.StringToUTF16(i.tooltip)
+	if len(tip) > len(nid.SzTip) {
+		tip = tip[:len(nid.SzTip)]
+		tip[len(tip)-1] = 0
+	}
+	copy(nid.SzTip[:], tip)
+
+	if !win.Shell_NotifyIcon(message, &nid) {
+		return syscall.GetLastError()
+	}
+	return nil
+}
+
+func (i *iconImpl) close() {
+	nid := win.NOTIFYICONDATA{
+		CbSize: uint32(unsafe.Sizeof(win.NOTIFYICONDATA{})),
+		HWnd:   i.hwnd,
+		UID:    1,
+	}
+	win.Shell_NotifyIcon(win.NIM_DELETE, &nid)
+	win.DestroyWindow(i.hwnd)
+	win.DestroyIcon(i.hicon)
+	delete(active, i.hwnd)
+	i.hwnd, i.hicon = 0, 0
+}
+
+func (i *iconImpl) isClosed() bool {
+	return i.hwnd == 0
+}
+
+func (i *iconImpl) setIcon(img image.Image) error {
+	hicon, err := win2.CreateIconFromImage(img)
+	if err != nil {
+		return err
+	}
+
+	prev := i.hicon
+	i.hicon = hicon
+	if err := i.notify(win.NIM_MODIFY); err != nil {
+		i.hicon = prev
+		win.DestroyIcon(hicon)
+		return err
+	}
+	win.DestroyIcon(prev)
+	return nil
+}
+
+func (i *iconImpl) setMenu(menu []MenuItem) error {
+	// The menu is built when the user opens the context menu.
+	i.menu = menu
+	return nil
+}
+
+func (i *iconImpl) setTooltip(tooltip string) error {
+	i.tooltip = tooltip
+	return i.notify(win.NIM_MODIFY)
+}
+
+func (i *iconImpl) showMenu() {
+	if len(i.menu) == 0 {
+		return
+	}
+
+	hmenu := win.CreatePopupMenu()
+	if hmenu == 0 {
+		return
+	}
+	defer win.DestroyMenu(hmenu)
+
+	// Menu items are identified by their index, offset by one, since zero
+	// indicates that no item was selected.
+	for index, v := range i.menu {
+		mii := win.MENUITEMINFO{
+			CbSize: uint32(unsafe.Sizeof(win.MENUITEMINFO{})),
+			FMask:  win.MIIM_FTYPE | win.MIIM_ID | win.MIIM_STATE | win.MIIM_STRING,
+			WID:    uint32(index + 1),
+		}
+		if v.Text == "" {
+			mii.FType = win.MFT_SEPARATOR
+		} else {
+			mii.FType = win.MFT_STRING
+			mii.DwTypeData, _ = syscall.UTF16PtrFromString(v.Text)
+		}
+		if v.Disabled {
+			mii.FState = win.MFS_DISABLED
+		}
+		win.InsertMenuItem(hmenu, uint32(index), true, &mii)
+	}
+
+	// The window must be in the foreground, or the menu will not close when
+	// the user clicks elsewhere.  The selected item is reported using
+	// WM_COMMAND.
+	pt := win.POINT{}
+	win.GetCursorPos(&pt)
+	win.SetForegroundWindow(i.hwnd)
+	win.TrackPopupMenu(hmenu, win.TPM_RIGHTBUTTON, pt.X, pt.Y, 0, i.hwnd, nil)
+	win.PostMessage(i.hwnd, win.WM_NULL, 0, 0)
+}
+
+func trayWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) uintptr {
+	i, ok := active[hwnd]
+	if !ok {
+		return win.DefWindowProc(hwnd, msg, wParam, lParam)
+	}
+
+	switch msg {
+	case wmTrayIcon:
+		switch lParam {
+		case win.WM_LBUTTONUP:
+			if i.onClick != nil {
+				i.onClick()
+			}
+		case win.WM_RBUTTONUP:
+			i.showMenu()
+		}
+		return 0
+
+	case win.WM_COMMAND:
+		if id := win.LOWORD(uint32(wParam)); id > 0 {
+			i.menuItem(int(id) - 1)
+		}
+		return 0
+	}
+
+	if msg == wmTaskbarCreated {
+		i.notify(win.NIM_ADD)
+		return 0
+	}
+
+	return win.DefWindowProc(hwnd, msg, wParam, lParam)
+}
+
+// asyncClick simulates the user clicking on the icon after a delay.  This is
+// used for testing.
+func asyncClick(i *Icon, initialWait time.Duration) <-chan error {
+	return asyncPost(i, initialWait, wmTrayIcon, 0, win.WM_LBUTTONUP)
+}
+
+// asyncClickMenuItem simulates the user selecting an item from the context
+// menu after a delay.  This is used for testing.
+func asyncClickMenuItem(i *Icon, index int, initialWait time.Duration) <-chan error {
+	return asyncPost(i, initialWait, win.WM_COMMAND, uintptr(index+1), 0)
+}
+
+func asyncPost(i *Icon, initialWait time.Duration, msg uint32, wParam, lParam uintptr) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		time.Sleep(initialWait)
+		err := loop.Do(func() error {
+			win.PostMessage(i.hwnd, msg, wParam, lParam)
+			return nil
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return errs
+}
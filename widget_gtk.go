@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package goey
@@ -31,6 +32,11 @@ func (w *Control) Close() {
 	}
 }
 
+// isClosed returns true if the control has been closed or destroyed.
+func (w *Control) isClosed() bool {
+	return w.handle == 0
+}
+
 // Handle returns the platform-native handle for the control.
 func (w *Control) Handle() uintptr {
 	return w.handle
@@ -132,6 +138,14 @@ func (w *Control) MinIntrinsicWidth(base.Length) base.Length {
 	return base.FromPixelsX(width)
 }
 
+// Bounds returns the position of the widget, relative to the window's client
+// area.
+func (w *Control) Bounds() base.Rectangle {
+	x, y, width, height := gtk.WidgetBounds(w.handle)
+	return base.Rect(base.FromPixelsX(x), base.FromPixelsY(y),
+		base.FromPixelsX(x+width), base.FromPixelsY(y+height))
+}
+
 // SetBounds updates the position of the widget.
 func (w *Control) SetBounds(bounds base.Rectangle) {
 	pixels := bounds.Pixels()
@@ -0,0 +1,218 @@
+//go:build headless
+// +build headless
+
+package goey
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+	"github.com/chaolihf/goey/loop"
+	"gitlab.com/stone.code/assert"
+)
+
+// Control is an opaque type used as a platform-specific handle to a control
+// created using the platform GUI.  As an example, this will refer to a HWND
+// when targeting Windows, but a *GtkWidget when targeting GTK.
+//
+// Unless developping new widgets, users should not need to use this type.
+//
+// Any method's on this type will be platform specific.
+type Control struct {
+	node   *headless.Node
+	bounds base.Rectangle
+}
+
+// newControl creates a simulated control as the last child of the parent.
+// The minimum size is in pixels.
+func newControl(parent base.Control, minWidth, minHeight int) Control {
+	node := headless.NewNode(parent.Node)
+	node.MinSize = image.Point{minWidth, minHeight}
+	return Control{node: node}
+}
+
+// Close removes the element from the GUI, and frees any associated resources.
+func (w *Control) Close() {
+	if w.node != nil {
+		w.node.Close()
+		w.node = nil
+	}
+}
+
+// isClosed returns true if the control has been closed.
+func (w *Control) isClosed() bool {
+	return w.node == nil
+}
+
+// Node returns the simulated control.
+func (w *Control) Node() *headless.Node {
+	return w.node
+}
+
+// Bounds returns the position of the control, as last set by SetBounds.
+func (w *Control) Bounds() base.Rectangle {
+	return w.bounds
+}
+
+// TakeFocus moves the keyboard focus to the control.
+func (w *Control) TakeFocus() bool {
+	return w.node.Focus()
+}
+
+// TypeKeys sends events to the control as if the string was typed by a user.
+func (w *Control) TypeKeys(text string) chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		for _, r := range text {
+			err := loop.Do(func() error {
+				return headless.SendKey(r)
+			})
+			if err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	return errs
+}
+
+// Layout determines the best size for an element that satisfies the
+// constraints.
+func (w *Control) Layout(bc base.Constraints) base.Size {
+	size := base.FromPixels(w.node.MinSize.X, w.node.MinSize.Y)
+	return bc.Constrain(size)
+}
+
+// MinIntrinsicHeight returns the minimum height that this element requires
+// to be correctly displayed.
+func (w *Control) MinIntrinsicHeight(base.Length) base.Length {
+	return base.FromPixelsY(w.node.MinSize.Y)
+}
+
+// MinIntrinsicWidth returns the minimum width that this element requires
+// to be correctly displayed.
+func (w *Control) MinIntrinsicWidth(base.Length) base.Length {
+	return base.FromPixelsX(w.node.MinSize.X)
+}
+
+// SetBounds updates the position of the widget.
+func (w *Control) SetBounds(bounds base.Rectangle) {
+	pixels := bounds.Pixels()
+	assert.Assert(pixels.Dx() >= 0 && pixels.Dy() >= 0, "zero width or zero height bounds for control")
+
+	w.bounds = bounds
+	w.node.SetBounds(pixels)
+}
+
+// focusHandlers updates the callbacks for the simulated control when it
+// receives or loses the keyboard focus.
+func (w *Control) focusHandlers(onFocus, onBlur *func()) {
+	w.node.CanFocus = true
+	w.node.OnFocus = func() {
+		if *onFocus != nil {
+			(*onFocus)()
+		}
+	}
+	w.node.OnBlur = func() {
+		if *onBlur != nil {
+			(*onBlur)()
+		}
+	}
+}
+
+// paintFrame draws the border and background common to most controls.
+func paintFrame(dst draw.Image, bounds image.Rectangle, fill color.Color, node *headless.Node) {
+	headless.Fill(dst, bounds, fill)
+	if node.IsFocused() {
+		headless.Stroke(dst, bounds, headless.Accent)
+	} else {
+		headless.Stroke(dst, bounds, headless.Border)
+	}
+}
+
+// textColor returns the color used to draw text for the control.
+func textColor(disabled bool) color.Color {
+	if disabled {
+		return headless.GrayText
+	}
+	return headless.Text
+}
+
+// centerText returns the top-left point where a single line of text should
+// be drawn to be centered within the bounds.
+func centerText(bounds image.Rectangle, text string) image.Point {
+	size := headless.TextSize(text)
+	return image.Point{
+		X: (bounds.Min.X + bounds.Max.X - size.X) / 2,
+		Y: (bounds.Min.Y + bounds.Max.Y - size.Y) / 2,
+	}
+}
+
+// leftText returns the top-left point where a single line of text should
+// be drawn to be left aligned and vertically centered within the bounds.
+func leftText(bounds image.Rectangle) image.Point {
+	return image.Point{
+		X: bounds.Min.X + 4,
+		Y: (bounds.Min.Y + bounds.Max.Y - headless.LineHeight) / 2,
+	}
+}
+
+// editText applies a key press to the contents of an editable control.  The
+// return value is false if the key does not change the text.
+func editText(text string, r rune) (string, bool) {
+	if r == '\b' {
+		if text == "" {
+			return text, false
+		}
+		_, size := utf8.DecodeLastRuneInString(text)
+		return text[:len(text)-size], true
+	}
+	if !unicode.IsPrint(r) {
+		return text, false
+	}
+	return text + string(r), true
+}
+
+// paintTextField draws an editable, single-line text field.
+func paintTextField(dst draw.Image, bounds image.Rectangle, node *headless.Node, text, placeholder string, disabled bool) {
+	fill := color.Color(headless.Background)
+	if disabled {
+		fill = headless.Face
+	}
+	paintFrame(dst, bounds, fill, node)
+
+	if text == "" {
+		headless.DrawText(dst, leftText(bounds), placeholder, headless.GrayText)
+		return
+	}
+	headless.DrawText(dst, leftText(bounds), text, textColor(disabled))
+}
+
+// spinText holds the text being edited in a numeric field.  Like a GTK spin
+// button, all of the text is selected when the field receives the focus, and
+// the value is only committed when the user hits enter or the field loses
+// the focus.
+type spinText struct {
+	text     string
+	selected bool
+}
+
+// key applies a key press to the text.
+func (s *spinText) key(r rune) {
+	if s.selected && (r == '\b' || unicode.IsPrint(r)) {
+		s.text, s.selected = "", false
+		if r == '\b' {
+			return
+		}
+	}
+	s.text, _ = editText(s.text, r)
+}
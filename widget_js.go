@@ -1,5 +1,5 @@
-//go:build go1.12
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package goey
 
@@ -22,6 +22,11 @@ type Control struct {
 	handle js.Value
 }
 
+// Handle returns the DOM element for the control.
+func (w *Control) Handle() js.Value {
+	return w.handle
+}
+
 // Close removes the element from the GUI, and frees any associated resources.
 func (w *Control) Close() {
 	if !w.handle.IsNull() {
@@ -30,6 +35,11 @@ func (w *Control) Close() {
 	}
 }
 
+// isClosed returns true if the control has been closed.
+func (w *Control) isClosed() bool {
+	return w.handle.IsNull()
+}
+
 // Layout determines the best size for an element that satisfies the
 // constraints.
 func (w *Control) Layout(bc base.Constraints) base.Size {
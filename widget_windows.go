@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package goey
 
 import (
@@ -36,6 +39,11 @@ type Control struct {
 	Hwnd win.HWND
 }
 
+// Handle returns the window handle for the control.
+func (w Control) Handle() win.HWND {
+	return w.Hwnd
+}
+
 // Text copies text of the underlying window.
 func (w Control) Text() string {
 	return win2.GetWindowText(w.Hwnd)
@@ -60,6 +68,21 @@ func (w Control) SetDisabled(value bool) {
 	win.EnableWindow(w.Hwnd, !value)
 }
 
+// Bounds returns the position of the control, relative to the window's client
+// area.
+func (w *Control) Bounds() base.Rectangle {
+	rect := win.RECT{}
+	win.GetWindowRect(w.Hwnd, &rect)
+
+	root := win.GetAncestor(w.Hwnd, win.GA_ROOT)
+	pts := [2]win.POINT{{X: rect.Left, Y: rect.Top}, {X: rect.Right, Y: rect.Bottom}}
+	win.ScreenToClient(root, &pts[0])
+	win.ScreenToClient(root, &pts[1])
+
+	return base.Rect(base.FromPixelsX(int(pts[0].X)), base.FromPixelsY(int(pts[0].Y)),
+		base.FromPixelsX(int(pts[1].X)), base.FromPixelsY(int(pts[1].Y)))
+}
+
 // SetBounds is a wrapper around the WIN32 call to MoveWindow.
 func (w *Control) SetBounds(bounds base.Rectangle) {
 	win.MoveWindow(w.Hwnd, int32(bounds.Min.X.PixelsX()), int32(bounds.Min.Y.PixelsY()), int32(bounds.Dx().PixelsX()), int32(bounds.Dy().PixelsY()), false)
@@ -149,6 +172,11 @@ func (w *Control) Close() {
 	}
 }
 
+// isClosed returns true if the control has been closed.
+func (w *Control) isClosed() bool {
+	return w.Hwnd == 0
+}
+
 func createControlWindow(exStyle uint32, classname *uint16, text string, style uint32, parent win.HWND) (win.HWND, []uint16, error) {
 	// Get the text for the control.  There may be extra work here if the
 	// string is empty, but that is not expected to be common.
@@ -0,0 +1,145 @@
+package windows
+
+import (
+	"github.com/chaolihf/goey/base"
+)
+
+// Cursor identifies the shape of the mouse cursor.
+type Cursor int
+
+// Shapes for the mouse cursor.
+const (
+	CursorDefault          Cursor = iota // Platform's default cursor, normally an arrow
+	CursorText                           // I-beam, used over editable text
+	CursorPointer                        // Pointing hand, used over links
+	CursorBusy                           // Wait cursor, used while the application cannot accept input
+	CursorCrosshair                      // Crosshair, used for precise selection
+	CursorResizeHorizontal               // Double arrow for resizing left and right
+	CursorResizeVertical                 // Double arrow for resizing up and down
+	CursorMove                           // Four arrows, used when moving an object
+)
+
+// String returns the name of the cursor.  The names match those used by
+// CSS for the cursor property.
+func (c Cursor) String() string {
+	switch c {
+	case CursorText:
+		return "text"
+	case CursorPointer:
+		return "pointer"
+	case CursorBusy:
+		return "wait"
+	case CursorCrosshair:
+		return "crosshair"
+	case CursorResizeHorizontal:
+		return "ew-resize"
+	case CursorResizeVertical:
+		return "ns-resize"
+	case CursorMove:
+		return "move"
+	}
+	return "default"
+}
+
+// cursorState holds the window's cursor, as set with SetCursor and SetBusy.
+type cursorState struct {
+	cursor Cursor
+	busy   bool
+}
+
+type cursorRegion struct {
+	owner  interface{}
+	parent base.Control
+	bounds base.Rectangle
+	cursor Cursor
+}
+
+var (
+	// List of regions set with SetCursorRegion.
+	cursorRegions []cursorRegion
+)
+
+// SetCursorRegion sets the cursor shown while the mouse is over a region of
+// a window.  The bounds are in the coordinates of parent, which should be the
+// parent control passed when the owner was mounted.  If the owner already has
+// a region, that region is updated.  Elements must call RemoveCursorRegion
+// when they are closed.
+//
+// When regions overlap, the cursor of the smallest region is used.
+//
+// Users should not need to use this function directly.
+func SetCursorRegion(owner interface{}, parent base.Control, bounds base.Rectangle, cursor Cursor) {
+	for i := range cursorRegions {
+		if cursorRegions[i].owner == owner {
+			cursorRegions[i] = cursorRegion{owner, parent, bounds, cursor}
+			return
+		}
+	}
+	cursorRegions = append(cursorRegions, cursorRegion{owner, parent, bounds, cursor})
+}
+
+// RemoveCursorRegion removes a region set with SetCursorRegion.
+//
+// Users should not need to use this function directly.
+func RemoveCursorRegion(owner interface{}) {
+	for i, v := range cursorRegions {
+		if v.owner == owner {
+			copy(cursorRegions[i:], cursorRegions[i+1:])
+			cursorRegions[len(cursorRegions)-1] = cursorRegion{}
+			cursorRegions = cursorRegions[:len(cursorRegions)-1]
+			return
+		}
+	}
+}
+
+// cursorAt returns the cursor that should be shown.  The platform-specific
+// callback reports whether the mouse is within a region.
+func (c *cursorState) cursorAt(contains func(parent base.Control, bounds base.Rectangle) bool) Cursor {
+	if c.busy {
+		return CursorBusy
+	}
+
+	ret := c.cursor
+	area := base.Length(-1)
+	for _, v := range cursorRegions {
+		if a := v.bounds.Dx() * v.bounds.Dy() / base.DIP; (area < 0 || a < area) && contains(v.parent, v.bounds) {
+			ret, area = v.cursor, a
+		}
+	}
+	return ret
+}
+
+// SetCursor changes the cursor shown while the mouse is over the window.
+// Regions of the window can override the cursor, see goey.CursorArea.
+//
+// On Cocoa, the cursor is not currently changed.
+func (w *Window) SetCursor(cursor Cursor) {
+	w.cursor = cursor
+	w.updateCursor()
+}
+
+// Cursor returns the cursor set with SetCursor.
+func (w *Window) Cursor() Cursor {
+	return w.cursor
+}
+
+// SetBusy changes whether the window is busy.  While busy, the window shows a
+// wait cursor, and does not accept mouse or keyboard input.  This is
+// intended for long operations that run synchronously on the GUI thread, so
+// the cursor is updated immediately, without waiting for the event loop.
+//
+// On GTK, the window's contents are also shown as insensitive.  On JS, only
+// mouse input is blocked.  On Cocoa, SetBusy currently has no effect.
+func (w *Window) SetBusy(busy bool) {
+	if busy == w.busy {
+		return
+	}
+	w.busy = busy
+	w.setBusy(busy)
+	w.updateCursor()
+}
+
+// IsBusy returns whether the window is busy, as set with SetBusy.
+func (w *Window) IsBusy() bool {
+	return w.busy
+}
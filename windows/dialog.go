@@ -17,6 +17,46 @@ func (w *Window) Message(text string) *dialog.Message {
 	return ret
 }
 
+// Question returns a builder that can be used to construct a question
+// dialog, and then show that dialog.
+func (w *Window) Question(text string) *dialog.Question {
+	ret := dialog.NewQuestion(text)
+	w.question(ret)
+	return ret
+}
+
+// Prompt returns a builder that can be used to construct a dialog asking the
+// user for a single line of text, and then show that dialog.
+func (w *Window) Prompt(text string) *dialog.Prompt {
+	ret := dialog.NewPrompt(text)
+	w.prompt(ret)
+	return ret
+}
+
+// ColorChooser returns a builder that can be used to construct a color
+// chooser dialog, and then show that dialog.
+func (w *Window) ColorChooser() *dialog.ColorChooser {
+	ret := dialog.NewColorChooser()
+	w.colorchooser(ret)
+	return ret
+}
+
+// FontChooser returns a builder that can be used to construct a font
+// chooser dialog, and then show that dialog.
+func (w *Window) FontChooser() *dialog.FontChooser {
+	ret := dialog.NewFontChooser()
+	w.fontchooser(ret)
+	return ret
+}
+
+// Progress returns a builder that can be used to construct a dialog showing
+// the progress of a long-running operation, and then show that dialog.
+func (w *Window) Progress(title string) *dialog.Progress {
+	ret := dialog.NewProgress(title)
+	w.progress(ret)
+	return ret
+}
+
 // OpenFileDialog returns a builder that can be used to construct an open file
 // dialog, and then show that dialog.
 func (w *Window) OpenFileDialog() *dialog.OpenFile {
@@ -25,6 +65,14 @@ func (w *Window) OpenFileDialog() *dialog.OpenFile {
 	return ret
 }
 
+// OpenFolderDialog returns a builder that can be used to construct a dialog
+// to select a directory, and then show that dialog.
+func (w *Window) OpenFolderDialog() *dialog.OpenFolder {
+	ret := dialog.NewOpenFolder()
+	w.openfolderdialog(ret)
+	return ret
+}
+
 // SaveFileDialog returns a builder that can be used to construct a save file
 // dialog, and then show that dialog.
 func (w *Window) SaveFileDialog() *dialog.SaveFile {
@@ -0,0 +1,128 @@
+package windows
+
+import (
+	"image"
+
+	"github.com/chaolihf/goey/base"
+)
+
+// windowEvents holds the callbacks for changes in the window's activation,
+// visibility, position, and size.
+type windowEvents struct {
+	onActivate    func()
+	onDeactivate  func()
+	onMinimize    func()
+	onRestore     func()
+	onMove        func(image.Point)
+	onSizeChange  func(base.Size)
+	onFocusChange func(string)
+	minimized     bool        // Last visibility reported to the callbacks.
+	position      image.Point // Last position reported to the callbacks.
+	focusKey      string      // Last key reported to the callbacks.
+}
+
+// activeChanged calls the callback for activation or deactivation.
+func (e *windowEvents) activeChanged(active bool) {
+	if active {
+		if e.onActivate != nil {
+			e.onActivate()
+		}
+	} else if e.onDeactivate != nil {
+		e.onDeactivate()
+	}
+}
+
+// minimizedChanged calls the callback for minimize or restore, but only when
+// the window's visibility has changed.
+func (e *windowEvents) minimizedChanged(minimized bool) {
+	if minimized == e.minimized {
+		return
+	}
+	e.minimized = minimized
+
+	if minimized {
+		if e.onMinimize != nil {
+			e.onMinimize()
+		}
+	} else if e.onRestore != nil {
+		e.onRestore()
+	}
+}
+
+// moved calls the callback for a change in the window's position, but only
+// when the position has changed.
+func (e *windowEvents) moved(position image.Point) {
+	if position == e.position {
+		return
+	}
+	e.position = position
+
+	if e.onMove != nil {
+		e.onMove(position)
+	}
+}
+
+// focusChanged calls the callback for a change in the keyboard focus, but only
+// when the key of the focused widget has changed.
+func (e *windowEvents) focusChanged(key string) {
+	if key == e.focusKey {
+		return
+	}
+	e.focusKey = key
+
+	if e.onFocusChange != nil {
+		e.onFocusChange(key)
+	}
+}
+
+// sizeChanged calls the callback for a change in the size of the window's
+// client area.
+func (e *windowEvents) sizeChanged(size base.Size) {
+	if e.onSizeChange != nil {
+		e.onSizeChange(size)
+	}
+}
+
+// SetOnActivate changes the event callback for when the window becomes the
+// active window, and will receive keyboard input.
+func (w *Window) SetOnActivate(callback func()) {
+	w.onActivate = callback
+}
+
+// SetOnDeactivate changes the event callback for when the window stops being
+// the active window.
+func (w *Window) SetOnDeactivate(callback func()) {
+	w.onDeactivate = callback
+}
+
+// SetOnMinimize changes the event callback for when the window is minimized,
+// and is no longer visible.  Applications can use this event to pause
+// expensive updates.
+//
+// On JS, the event is called when the page is hidden.
+func (w *Window) SetOnMinimize(callback func()) {
+	w.onMinimize = callback
+}
+
+// SetOnRestore changes the event callback for when a minimized window is
+// restored, and is again visible.
+//
+// On JS, the event is called when the page is shown after being hidden.
+func (w *Window) SetOnRestore(callback func()) {
+	w.onRestore = callback
+}
+
+// SetOnMove changes the event callback for when the window is moved.  The
+// callback receives the new position of the window's top-left corner, in the
+// same coordinates as Position.
+func (w *Window) SetOnMove(callback func(position image.Point)) {
+	w.onMove = callback
+}
+
+// SetOnSizeChange changes the event callback for when the size of the
+// window's client area changes.  Unlike the callback set with SetOnResize,
+// the size is measured in device-independent units, and the callback is
+// called after the window's child has been laid out.
+func (w *Window) SetOnSizeChange(callback func(size base.Size)) {
+	w.onSizeChange = callback
+}
@@ -0,0 +1,120 @@
+package windows
+
+import (
+	"errors"
+	"sort"
+)
+
+var (
+	// ErrKeyNotFound is returned by Focus if no mounted widget has the key.
+	ErrKeyNotFound = errors.New("no mounted widget has the key")
+
+	// ErrCannotFocus is returned by Focus if the widget cannot take the
+	// keyboard focus, for example because it is disabled.
+	ErrCannotFocus = errors.New("widget cannot take the keyboard focus")
+
+	// List of targets registered with RegisterFocusTarget, in the order of
+	// registration.
+	focusTargets []focusTarget
+)
+
+// FocusTarget is implemented by elements that can take the keyboard focus.
+type FocusTarget interface {
+	TakeFocus() bool
+}
+
+type focusTarget struct {
+	key      string
+	tabIndex int
+	target   FocusTarget
+}
+
+// RegisterFocusTarget associates the key and tab index with an element, so
+// that it can be found by Window.Focus, and so that its position in the
+// keyboard navigation order can be overridden.  Elements must call
+// UnregisterFocusTarget when they are closed.
+//
+// Keys should be unique within the application.  If more than one target
+// has the same key, the most recently registered target is used.
+//
+// Users should not need to use this function directly.
+func RegisterFocusTarget(key string, tabIndex int, target FocusTarget) {
+	focusTargets = append(focusTargets, focusTarget{key, tabIndex, target})
+}
+
+// UnregisterFocusTarget removes a target registered with
+// RegisterFocusTarget.
+//
+// Users should not need to use this function directly.
+func UnregisterFocusTarget(target FocusTarget) {
+	for i, v := range focusTargets {
+		if v.target == target {
+			copy(focusTargets[i:], focusTargets[i+1:])
+			focusTargets[len(focusTargets)-1] = focusTarget{}
+			focusTargets = focusTargets[:len(focusTargets)-1]
+			return
+		}
+	}
+}
+
+// findFocusTarget returns the most recently registered target with the key,
+// and that matches.
+func findFocusTarget(key string, match func(FocusTarget) bool) FocusTarget {
+	for i := len(focusTargets) - 1; i >= 0; i-- {
+		if focusTargets[i].key == key && match(focusTargets[i].target) {
+			return focusTargets[i].target
+		}
+	}
+	return nil
+}
+
+// focusKey returns the key for the most recently registered target that
+// matches, or an empty string.
+func focusKey(match func(FocusTarget) bool) string {
+	for i := len(focusTargets) - 1; i >= 0; i-- {
+		if match(focusTargets[i].target) {
+			return focusTargets[i].key
+		}
+	}
+	return ""
+}
+
+// tabOrder returns the targets with a positive tab index, sorted by their tab
+// index.  Targets with the same tab index remain in the order of
+// registration.
+func tabOrder() []focusTarget {
+	ret := []focusTarget(nil)
+	for _, v := range focusTargets {
+		if v.tabIndex > 0 {
+			ret = append(ret, v)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].tabIndex < ret[j].tabIndex
+	})
+	return ret
+}
+
+// Focus moves the keyboard focus to the mounted widget with the key.  Keys
+// are assigned to widgets using goey.Focusable.  Only widgets within the
+// window are considered.
+func (w *Window) Focus(key string) error {
+	target := findFocusTarget(key, w.containsTarget)
+	if target == nil {
+		return ErrKeyNotFound
+	}
+	if !target.TakeFocus() {
+		return ErrCannotFocus
+	}
+	return nil
+}
+
+// SetOnFocusChange changes the event callback for when the keyboard focus
+// moves within the window.  The callback receives the key of the widget with
+// the focus, or an empty string if the focus has moved to a widget without a
+// key.
+//
+// On Cocoa, the callback is not currently called.
+func (w *Window) SetOnFocusChange(callback func(key string)) {
+	w.onFocusChange = callback
+}
@@ -0,0 +1,140 @@
+package windows
+
+import (
+	"image"
+
+	"github.com/chaolihf/goey/base"
+)
+
+// WindowState describes how a top-level window is shown on the screen.
+type WindowState uint8
+
+// Possible values for WindowState.
+const (
+	StateNormal     WindowState = iota // Window is shown with its restored size and position.
+	StateMinimized                     // Window is minimized or hidden.
+	StateMaximized                     // Window fills the work area of the screen.
+	StateFullscreen                    // Window covers the entire screen, without decorations.
+)
+
+// String returns a description of the window state.
+func (s WindowState) String() string {
+	switch s {
+	case StateNormal:
+		return "normal"
+	case StateMinimized:
+		return "minimized"
+	case StateMaximized:
+		return "maximized"
+	case StateFullscreen:
+		return "fullscreen"
+	}
+	return "unknown"
+}
+
+// sizeLimits holds the overrides for the minimum and maximum size of the
+// window's client area.  A zero length for any dimension means that there is
+// no override.
+type sizeLimits struct {
+	minSize base.Size
+	maxSize base.Size
+}
+
+// limitMinSize applies the override for the minimum size to the size
+// required to layout the window's child.
+func (l *sizeLimits) limitMinSize(size base.Size) base.Size {
+	return base.Size{
+		Width:  max(size.Width, l.minSize.Width),
+		Height: max(size.Height, l.minSize.Height),
+	}
+}
+
+// maxPixels returns the maximum size of the client area in pixels.  A zero
+// for any dimension means that the size is not limited.  The maximum size is
+// never allowed to be smaller than the minimum size.
+func (l *sizeLimits) maxPixels(minSize base.Size) image.Point {
+	ret := image.Point{}
+	if l.maxSize.Width > 0 {
+		ret.X = max(l.maxSize.Width, minSize.Width).PixelsX()
+	}
+	if l.maxSize.Height > 0 {
+		ret.Y = max(l.maxSize.Height, minSize.Height).PixelsY()
+	}
+	return ret
+}
+
+// SetSize changes the size of the window's client area.  The size is measured
+// in pixels, to match GetSize.  The size will be adjusted to respect the
+// window's minimum and maximum size.
+//
+// On JS, the main window fills the page, and cannot be resized.
+func (w *Window) SetSize(width, height int) {
+	w.setSize(width, height)
+}
+
+// Position returns the location of the window's top-left corner in screen
+// coordinates, measured in pixels.
+//
+// On JS, the main window fills the page, and its position is always zero.
+func (w *Window) Position() image.Point {
+	return w.position()
+}
+
+// SetPosition moves the window so that its top-left corner is at the location
+// in screen coordinates, measured in pixels.
+//
+// On JS, the main window fills the page, and cannot be moved.
+func (w *Window) SetPosition(x, y int) {
+	w.setPosition(x, y)
+}
+
+// SetMinSize sets an override for the minimum size of the window's client
+// area.  The window will not be smaller than either the override or the
+// minimum size required by the window's child.  A zero length for either
+// dimension removes the override for that dimension.
+func (w *Window) SetMinSize(size base.Size) {
+	w.minSize = size
+	w.updateSizeLimits()
+}
+
+// SetMaxSize sets the maximum size of the window's client area.  A zero length
+// for either dimension removes the limit for that dimension.  If the maximum
+// size is smaller than the window's minimum size, the minimum size is used.
+func (w *Window) SetMaxSize(size base.Size) {
+	w.maxSize = size
+	w.updateSizeLimits()
+}
+
+// Maximize enlarges the window to fill the work area of the screen.
+//
+// On JS, the main window fills the page, and can only be made fullscreen.
+func (w *Window) Maximize() {
+	w.setState(StateMaximized)
+}
+
+// Minimize hides the window, leaving only its icon in the taskbar or dock.
+func (w *Window) Minimize() {
+	w.setState(StateMinimized)
+}
+
+// Restore returns a window that is minimized, maximized, or fullscreen to
+// its normal size and position.
+func (w *Window) Restore() {
+	w.setState(StateNormal)
+}
+
+// SetFullscreen changes whether the window covers the entire screen, without
+// decorations.  Leaving fullscreen returns the window to its normal state.
+func (w *Window) SetFullscreen(fullscreen bool) {
+	if fullscreen {
+		w.setState(StateFullscreen)
+	} else if w.state() == StateFullscreen {
+		w.setState(StateNormal)
+	}
+}
+
+// State returns whether the window is minimized, maximized, fullscreen, or
+// shown normally.
+func (w *Window) State() WindowState {
+	return w.state()
+}
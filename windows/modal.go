@@ -0,0 +1,84 @@
+//go:build !js
+// +build !js
+
+package windows
+
+// Modal dialogs are currently not supported on the JS platform.
+
+import (
+	"errors"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/loop"
+)
+
+var (
+	// ErrDialogClosed is returned if the method Show is called for a dialog
+	// that has already been closed.
+	ErrDialogClosed = errors.New("dialog has already been closed")
+)
+
+// Dialog represents a modal top-level window that contains other widgets.
+// While the dialog is shown, the user cannot interact with its owner.
+//
+// The contents of the dialog can be updated using the method SetChild, in
+// the same manner as for a Window.  To set the result of the dialog, and close
+// it, widgets in the dialog should call End.
+type Dialog struct {
+	*Window
+	owner  *Window
+	result interface{}
+	ended  bool
+}
+
+// NewDialog creates a new modal dialog.  The dialog is not visible until the
+// method Show is called.  The parameter owner may be nil, in which case the
+// dialog will not block interaction with any other window.
+func NewDialog(owner *Window, title string, child base.Widget) (*Dialog, error) {
+	// Create the window
+	w, err := newWindow(title, &windowOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	retval := &Dialog{Window: w, owner: owner}
+	w.setDialog(owner, func() {
+		retval.End(nil)
+	})
+
+	// Mount the widget, and initialize its layout.
+	err = w.SetChild(child)
+	if err != nil {
+		w.Close()
+		return nil, err
+	}
+
+	return retval, nil
+}
+
+// Show makes the dialog visible, and then blocks until the dialog is closed.
+// The return value is the result passed to End.  If the user closes the
+// dialog without a result, for example by pressing the escape key, the result
+// will be nil.
+//
+// The dialog is closed, and its resources released, before Show returns.
+func (d *Dialog) Show() (interface{}, error) {
+	if d.isClosed() {
+		return nil, ErrDialogClosed
+	}
+
+	d.showModal(d.owner)
+	loop.RunModal(func() bool {
+		return d.ended || d.isClosed()
+	})
+	d.endModal(d.owner)
+	d.Close()
+
+	return d.result, nil
+}
+
+// End sets the result for the dialog, and closes the dialog.  The result will
+// be returned by Show.
+func (d *Dialog) End(result interface{}) {
+	d.result, d.ended = result, true
+}
@@ -0,0 +1,133 @@
+//go:build !js
+// +build !js
+
+package windows_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chaolihf/goey"
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/goey/mock"
+	"github.com/chaolihf/goey/windows"
+)
+
+func ExampleNewDialog() {
+	// All calls that modify GUI objects need to be schedule on the GUI thread.
+	// This callback will be used to create and show the dialog.
+	login := func() error {
+		username := ""
+
+		// The dialog needs to be declared before the widgets, so that the
+		// buttons can end the dialog.
+		var dlg *windows.Dialog
+		dlg, err := windows.NewDialog(nil, "Login", &goey.Padding{
+			Insets: goey.DefaultInsets(),
+			Child: &goey.VBox{Children: []base.Widget{
+				&goey.Label{Text: "Username:"},
+				&goey.TextInput{OnChange: func(value string) {
+					username = value
+				}},
+				goey.DialogButtons(
+					&goey.Button{Text: "OK", Default: true, OnClick: func() {
+						dlg.End(username)
+					}},
+					&goey.Button{Text: "Cancel", OnClick: func() {
+						dlg.End(nil)
+					}},
+				),
+			}},
+		})
+		if err != nil {
+			return err
+		}
+
+		// Block until the user closes the dialog.
+		result, err := dlg.Show()
+		if err != nil {
+			return err
+		}
+		if result != nil {
+			fmt.Println("Username: ", result.(string))
+		}
+		return nil
+	}
+
+	// Start the GUI thread.
+	err := loop.Run(login)
+	if err != nil {
+		fmt.Println("Error: ", err)
+	}
+}
+
+func TestNewDialog(t *testing.T) {
+	errSentinel := errors.New("sentinel error")
+
+	cases := []struct {
+		owner  bool
+		widget base.Widget
+		end    func(*windows.Dialog)
+		out    interface{}
+		err    error
+	}{
+		{false, nil, func(d *windows.Dialog) { d.End(42) }, 42, nil},
+		{true, &mock.Widget{}, func(d *windows.Dialog) { d.End("text") }, "text", nil},
+		{true, &mock.Widget{}, func(d *windows.Dialog) { d.Close() }, nil, nil},
+		{true, &mock.Widget{Err: errSentinel}, nil, nil, errSentinel},
+	}
+
+	init := func() error {
+		owner, err := windows.NewWindow(t.Name(), nil)
+		if err != nil {
+			t.Fatalf("Failed to create window, %s", err)
+		}
+		defer owner.Close()
+
+		for i, v := range cases {
+			var o *windows.Window
+			if v.owner {
+				o = owner
+			}
+
+			dlg, err := windows.NewDialog(o, t.Name(), v.widget)
+			if err != v.err {
+				t.Errorf("Case %d: want error %v, got %v", i, v.err, err)
+			}
+			if err != nil {
+				continue
+			}
+
+			go func() {
+				time.Sleep(50 * time.Millisecond)
+				_ = loop.Do(func() error {
+					v.end(dlg)
+					return nil
+				})
+			}()
+
+			out, err := dlg.Show()
+			if err != nil {
+				t.Errorf("Case %d: unexpected error, %s", i, err)
+			}
+			if out != v.out {
+				t.Errorf("Case %d: want result %v, got %v", i, v.out, out)
+			}
+
+			// The dialog is closed once Show has returned.
+			if _, err := dlg.Show(); err != windows.ErrDialogClosed {
+				t.Errorf("Case %d: want error %v, got %v", i, windows.ErrDialogClosed, err)
+			}
+		}
+
+		return nil
+	}
+
+	err := loop.Run(init)
+	if err != nil {
+		t.Errorf("Failed to run GUI loop, %s", err)
+	}
+}
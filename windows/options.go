@@ -0,0 +1,79 @@
+package windows
+
+import (
+	"errors"
+)
+
+// WindowOption configures optional behavior for a window created using
+// NewWindow.
+type WindowOption func(*windowOptions)
+
+type windowOptions struct {
+	owner       *Window
+	modal       bool
+	toolWindow  bool
+	alwaysOnTop bool
+	stateStore  StateStore
+	stateKey    string
+}
+
+// WithOwner sets the owner of the window.  An owned window is always shown
+// above its owner, and will be closed when its owner is closed.
+func WithOwner(owner *Window) WindowOption {
+	return func(opts *windowOptions) {
+		opts.owner = owner
+	}
+}
+
+// WithModal makes the window modal.  While the window is open, the user
+// cannot interact with the window's owner.  Unlike a Dialog, creating a modal
+// window does not block.  A modal window must have an owner.
+//
+// On GTK, a modal window blocks interaction with all other windows of the
+// application.  On Cocoa, modality is not currently supported, and the
+// window behaves as an owned window.
+func WithModal() WindowOption {
+	return func(opts *windowOptions) {
+		opts.modal = true
+	}
+}
+
+// WithToolWindow makes the window a utility window, which is intended to
+// hold palettes or toolbars.  Depending on the platform, tool windows have a
+// smaller title bar, and are not shown in the taskbar.
+func WithToolWindow() WindowOption {
+	return func(opts *windowOptions) {
+		opts.toolWindow = true
+	}
+}
+
+// WithAlwaysOnTop keeps the window above other windows, even when the window
+// does not have focus.
+func WithAlwaysOnTop() WindowOption {
+	return func(opts *windowOptions) {
+		opts.alwaysOnTop = true
+	}
+}
+
+// WithStateStore persists the window's position, size, and maximized state
+// under the key.  Any saved geometry is restored before the window is first
+// shown, and the geometry is saved when the window is closed.
+func WithStateStore(store StateStore, key string) WindowOption {
+	return func(opts *windowOptions) {
+		opts.stateStore = store
+		opts.stateKey = key
+	}
+}
+
+func (opts *windowOptions) validate() error {
+	if opts.owner != nil && opts.owner.isClosed() {
+		return errors.New("Invalid argument, owner has been closed in call to NewWindow")
+	}
+	if opts.modal && opts.owner == nil {
+		return errors.New("Invalid argument, modal windows require an owner in call to NewWindow")
+	}
+	if opts.stateStore != nil && opts.stateKey == "" {
+		return errors.New("Invalid argument, empty key for state store in call to NewWindow")
+	}
+	return nil
+}
@@ -1,21 +1,83 @@
 package windows
 
 import (
+	"errors"
 	"fmt"
 	"image"
 	"image/png"
 	"os"
 	"time"
 
+	"github.com/chaolihf/goey/base"
 	"github.com/chaolihf/goey/loop"
 )
 
-type screenshoter interface {
-	Screenshot() (image.Image, error)
+var (
+	// ErrNotSupported is returned by Screenshot if screenshots are not
+	// supported on the platform.
+	ErrNotSupported = errors.New("screenshots are not supported on this platform")
+
+	// ErrNoBounds is returned by SnapshotElement if the element does not
+	// report its position.
+	ErrNoBounds = errors.New("element does not report its bounds")
+)
+
+// Bounder is implemented by elements that report their position in the
+// window's client area.  The bounds should match those last passed to
+// SetBounds.
+type Bounder interface {
+	Bounds() base.Rectangle
+}
+
+// Screenshot returns an image of the window, as displayed on screen.  On
+// Windows, GTK, and Cocoa, the image includes the window's frame and title
+// bar.  On the headless platform, the image is rendered in software, and only
+// covers the client area.
+//
+// On JS, screenshots are not supported, and Screenshot returns
+// ErrNotSupported.
+func (w *Window) Screenshot() (image.Image, error) {
+	img, _, err := w.screenshot()
+	return img, err
+}
+
+// ScreenshotRect returns an image of part of the window's client area.  The
+// bounds use the same coordinates as those passed to SetBounds when laying
+// out the window's child.  The bounds are clipped to the screenshot.
+func (w *Window) ScreenshotRect(bounds base.Rectangle) (image.Image, error) {
+	img, origin, err := w.screenshot()
+	if err != nil {
+		return nil, err
+	}
+
+	// Bounds in DIPs are converted using the window's DPI.
+	w.setDPI()
+	r := bounds.Pixels().Add(origin).Intersect(img.Bounds())
+
+	// Copy the pixels, so that the returned image starts at the origin.
+	dst := image.NewRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	for y := 0; y < r.Dy(); y++ {
+		for x := 0; x < r.Dx(); x++ {
+			dst.Set(x, y, img.At(r.Min.X+x, r.Min.Y+y))
+		}
+	}
+	return dst, nil
+}
+
+// SnapshotElement returns an image of the element, which must be a
+// descendant of the window's child.  The element must implement Bounder,
+// which is true for most controls on Windows, GTK, and the headless
+// platform.  Otherwise, SnapshotElement returns ErrNoBounds.
+func (w *Window) SnapshotElement(elem base.Element) (image.Image, error) {
+	b, ok := elem.(Bounder)
+	if !ok {
+		return nil, ErrNoBounds
+	}
+	return w.ScreenshotRect(b.Bounds())
 }
 
-func saveScreenshot(filename string, ss screenshoter) error {
-	img, err := ss.Screenshot()
+func saveScreenshot(filename string, window *Window) error {
+	img, err := window.Screenshot()
 	if err != nil {
 		return err
 	}
@@ -35,20 +97,12 @@ func saveScreenshot(filename string, ss screenshoter) error {
 }
 
 func asyncScreenshot(filename string, window *Window) {
-	// This weirdness below is to check at runtime if the type *Window
-	// supports Screenshot, whose existence is platform dependent.
-	ss, ok := interface{}(window).(screenshoter)
-	if !ok {
-		fmt.Println("error: GOEY_SCREENSHOT: Screenshots not supported on this platform.")
-		return
-	}
-
 	go func() {
 		// Provide a delay for the window to finish any animations.
 		time.Sleep(500 * time.Millisecond)
 
 		err := loop.Do(func() error {
-			return saveScreenshot(filename, ss)
+			return saveScreenshot(filename, window)
 		})
 		if err != nil {
 			fmt.Println("error: GOEY_SCREENSHOT:", err.Error())
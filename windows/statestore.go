@@ -0,0 +1,196 @@
+package windows
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// StateStore persists small pieces of user interface state, such as the
+// geometry of a window or the selected tab, so that they can be restored when
+// the application is next started.  Values are stored under a key, which
+// should be stable across runs of the application.
+type StateStore interface {
+	// Load decodes the value stored under key into value, which must be a
+	// pointer.  If no value has been stored under key, Load returns false,
+	// and value is left unchanged.
+	Load(key string, value interface{}) (bool, error)
+	// Save stores the value under key, replacing any previous value.
+	Save(key string, value interface{}) error
+}
+
+// FileStateStore is a StateStore that keeps its values in a JSON file.  The
+// file is rewritten whenever a value is saved.
+//
+// A FileStateStore is safe for concurrent use.
+type FileStateStore struct {
+	filename string
+
+	mutex sync.Mutex
+	data  map[string]json.RawMessage
+}
+
+// NewFileStateStore returns a StateStore for the application that keeps its
+// values in a JSON file under the user's configuration directory.  The name of
+// the application is used to select a subdirectory, and should be unique.
+func NewFileStateStore(app string) (*FileStateStore, error) {
+	if app == "" {
+		return nil, errors.New("Invalid argument, empty application name in call to NewFileStateStore")
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return OpenFileStateStore(filepath.Join(dir, app, "state.json"))
+}
+
+// OpenFileStateStore returns a StateStore that keeps its values in the named
+// JSON file.  If the file does not exist, it will be created when the first
+// value is saved.
+func OpenFileStateStore(filename string) (*FileStateStore, error) {
+	ret := &FileStateStore{
+		filename: filename,
+		data:     make(map[string]json.RawMessage),
+	}
+
+	contents, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return ret, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(contents, &ret.data); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Filename returns the name of the file used to store the values.
+func (s *FileStateStore) Filename() string {
+	return s.filename
+}
+
+// Load decodes the value stored under key into value.
+func (s *FileStateStore) Load(key string, value interface{}) (bool, error) {
+	s.mutex.Lock()
+	data, ok := s.data[key]
+	s.mutex.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Save stores the value under key, and then rewrites the file.
+func (s *FileStateStore) Save(key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.data[key] = data
+	contents, err := json.MarshalIndent(s.data, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file, and then rename, so that a failure will not
+	// leave a truncated file.
+	dir := filepath.Dir(s.filename)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(dir, ".state")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(contents)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), s.filename)
+}
+
+// savedGeometry is the state persisted for a window.  The position and size
+// are those of the window in the normal state, so that a maximized window can
+// be restored to its previous size.
+type savedGeometry struct {
+	X, Y          int
+	Width, Height int
+	Maximized     bool
+}
+
+// persistence holds the store, if any, used to save the window's geometry.
+type persistence struct {
+	stateStore StateStore
+	stateKey   string
+}
+
+// restoreState loads the window's geometry from the store, if any, and
+// applies it to the window.  Errors are ignored, since the window can always
+// be shown with its default geometry.
+func (w *windowImpl) restoreState() {
+	if w.stateStore == nil {
+		return
+	}
+
+	g := savedGeometry{}
+	if ok, err := w.stateStore.Load(w.stateKey, &g); !ok || err != nil {
+		return
+	}
+	if g.Width > 0 && g.Height > 0 {
+		w.setSize(g.Width, g.Height)
+	}
+	// The monitor that showed the window may have been disconnected, or its
+	// resolution changed.  In that case, the default position is kept.
+	if w.isOnScreen(g.X, g.Y) {
+		w.setPosition(g.X, g.Y)
+	}
+	if g.Maximized {
+		w.setState(StateMaximized)
+	}
+}
+
+// SaveState saves the window's geometry to the store set with WithStateStore.
+// The geometry is also saved automatically when the window is closed, but
+// any error is then ignored.  If the window does not have a store, SaveState
+// does nothing.
+func (w *Window) SaveState() error {
+	return w.saveState()
+}
+
+func (w *windowImpl) saveState() error {
+	if w.stateStore == nil || w.isClosed() {
+		return nil
+	}
+
+	// If the window is not in the normal state, keep the previously saved
+	// position and size, so that they can be used to restore the window.
+	g := savedGeometry{}
+	state := w.state()
+	if state == StateNormal {
+		pos := w.position()
+		g.X, g.Y = pos.X, pos.Y
+		g.Width, g.Height = w.getSize()
+	} else if _, err := w.stateStore.Load(w.stateKey, &g); err != nil {
+		return err
+	}
+	g.Maximized = state == StateMaximized
+
+	return w.stateStore.Save(w.stateKey, g)
+}
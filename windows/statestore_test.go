@@ -0,0 +1,163 @@
+package windows_test
+
+import (
+	"image"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chaolihf/goey/loop"
+	"github.com/chaolihf/goey/mock"
+	"github.com/chaolihf/goey/windows"
+)
+
+func ExampleFileStateStore() {
+	store, err := windows.NewFileStateStore("example")
+	if err != nil {
+		// Without a store, the window is shown with its default geometry.
+		store = nil
+	}
+
+	createWindow := func() error {
+		options := []windows.WindowOption(nil)
+		if store != nil {
+			options = append(options, windows.WithStateStore(store, "main"))
+		}
+		mw, err := windows.NewWindow("Example", nil, options...)
+		if err != nil {
+			return err
+		}
+
+		// The window's geometry is saved when it is closed.
+		mw.Close()
+		return nil
+	}
+
+	err = loop.Run(createWindow)
+	if err != nil {
+		println("Error: ", err)
+	}
+}
+
+func tempStateFile(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "goey")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory, %s", err)
+	}
+	return filepath.Join(dir, "config", "state.json"), func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestFileStateStore(t *testing.T) {
+	filename, cleanup := tempStateFile(t)
+	defer cleanup()
+
+	store, err := windows.OpenFileStateStore(filename)
+	if err != nil {
+		t.Fatalf("Failed to open store, %s", err)
+	}
+	if got := store.Filename(); got != filename {
+		t.Errorf("Unexpected filename, want %s, got %s", filename, got)
+	}
+
+	value := 0
+	if ok, err := store.Load("tabs", &value); ok || err != nil {
+		t.Errorf("Unexpected value for missing key, %v, %v", ok, err)
+	}
+	if err := store.Save("tabs", 2); err != nil {
+		t.Fatalf("Failed to save value, %s", err)
+	}
+	if err := store.Save("title", "Hello"); err != nil {
+		t.Fatalf("Failed to save value, %s", err)
+	}
+
+	// Reopen the store to check that the values were written to the file.
+	store, err = windows.OpenFileStateStore(filename)
+	if err != nil {
+		t.Fatalf("Failed to reopen store, %s", err)
+	}
+	if ok, err := store.Load("tabs", &value); !ok || err != nil || value != 2 {
+		t.Errorf("Unexpected value, want 2, got %d, %v, %v", value, ok, err)
+	}
+	title := ""
+	if ok, err := store.Load("title", &title); !ok || err != nil || title != "Hello" {
+		t.Errorf("Unexpected value, want Hello, got %s, %v, %v", title, ok, err)
+	}
+	if ok, err := store.Load("title", &value); ok || err == nil {
+		t.Errorf("Expected error when decoding into the wrong type")
+	}
+
+	// A corrupt file should be reported.
+	if err := ioutil.WriteFile(filename, []byte("{"), 0600); err != nil {
+		t.Fatalf("Failed to write file, %s", err)
+	}
+	if _, err := windows.OpenFileStateStore(filename); err == nil {
+		t.Errorf("Expected error when opening a corrupt file")
+	}
+}
+
+func TestNewWindow_StateStore(t *testing.T) {
+	filename, cleanup := tempStateFile(t)
+	defer cleanup()
+
+	store, err := windows.OpenFileStateStore(filename)
+	if err != nil {
+		t.Fatalf("Failed to open store, %s", err)
+	}
+
+	createWindow := func() error {
+		if _, err := windows.NewWindow(t.Name(), nil, windows.WithStateStore(store, "")); err == nil {
+			t.Errorf("Expected error for an empty key")
+		}
+
+		// Save the geometry of a window when it is closed.
+		mw, err := windows.NewWindow(t.Name(), &mock.Widget{}, windows.WithStateStore(store, "main"))
+		if err != nil {
+			return err
+		}
+		mw.SetSize(320, 240)
+		mw.SetPosition(40, 30)
+		mw.Close()
+
+		// A new window should be restored to the same geometry.
+		mw, err = windows.NewWindow(t.Name(), &mock.Widget{}, windows.WithStateStore(store, "main"))
+		if err != nil {
+			return err
+		}
+		if w, h := mw.GetSize(); w != 320 || h != 240 {
+			t.Errorf("Incorrect size, want 320x240, got %dx%d", w, h)
+		}
+		if got := mw.Position(); got != image.Pt(40, 30) {
+			t.Errorf("Incorrect position, want %v, got %v", image.Pt(40, 30), got)
+		}
+
+		// A maximized window keeps its normal geometry.
+		mw.Maximize()
+		if err := mw.SaveState(); err != nil {
+			t.Errorf("Failed to save state, %s", err)
+		}
+		mw.Close()
+
+		mw, err = windows.NewWindow(t.Name(), &mock.Widget{}, windows.WithStateStore(store, "main"))
+		if err != nil {
+			return err
+		}
+		if got := mw.State(); got != windows.StateMaximized {
+			t.Errorf("Incorrect state, want %s, got %s", windows.StateMaximized, got)
+		}
+		mw.Restore()
+		if w, h := mw.GetSize(); w != 320 || h != 240 {
+			t.Errorf("Incorrect size, want 320x240, got %dx%d", w, h)
+		}
+		mw.Close()
+
+		return nil
+	}
+
+	err = loop.Run(createWindow)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
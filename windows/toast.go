@@ -0,0 +1,200 @@
+package windows
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/chaolihf/goey/animate"
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/loop"
+)
+
+// DefaultToastDuration is the length of time that a toast remains visible
+// when no duration is specified in the call to ShowToast.
+const DefaultToastDuration = 4 * time.Second
+
+const (
+	// Distance between the toast and the edges of the window.
+	toastMargin = 12 * base.DIP
+)
+
+// ToastAction describes a button that can be added to a toast.
+type ToastAction struct {
+	Label    string // Text for the button
+	OnAction func() // Callback when the button is clicked
+}
+
+type toastState int
+
+const (
+	toastEntering toastState = iota
+	toastShown
+	toastLeaving
+	toastClosed
+)
+
+type toast struct {
+	toastImpl
+	window   *windowImpl
+	duration time.Duration
+	onAction func()
+	size     base.Size
+	ease     animate.EaseLength
+	state    toastState
+	timer    *time.Timer
+}
+
+// ShowToast shows a short, non-modal message as an overlay along the bottom
+// edge of the window.  The toast slides into view, and is dismissed
+// automatically once the duration has elapsed.  If the duration is not
+// positive, DefaultToastDuration is used.
+//
+// If action is not nil, the toast will include a button.  When the button is
+// clicked, the callback is called, and the toast is dismissed.
+//
+// Only one toast is shown at a time.  Showing a new toast replaces any toast
+// that is currently visible.  The toast is drawn above the window's child,
+// and does not change its layout.
+func (w *Window) ShowToast(text string, duration time.Duration, action *ToastAction) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return errors.New("Invalid argument, 'text' cannot be empty in call to ShowToast")
+	}
+	label, onAction := "", (func())(nil)
+	if action != nil {
+		label = strings.TrimSpace(action.Label)
+		if label == "" {
+			return errors.New("Invalid argument, 'action.Label' cannot be empty in call to ShowToast")
+		}
+		onAction = action.OnAction
+	}
+	if duration <= 0 {
+		duration = DefaultToastDuration
+	}
+
+	// Replace any toast that is currently visible.
+	if w.toast != nil {
+		w.toast.close()
+	}
+
+	// The toast may need to convert pixels to DIPs when mounting.
+	w.setDPI()
+
+	t := &toast{
+		window:   &w.windowImpl,
+		duration: duration,
+		onAction: onAction,
+	}
+	size, err := t.mount(&w.windowImpl, text, label, t.onClick)
+	if err != nil {
+		return err
+	}
+	t.size = size
+	w.toast = t
+
+	// Start just below the bottom edge of the window, and slide into view.
+	t.ease = animate.NewEaseLength(size.Height+toastMargin, 0)
+	t.place(0)
+	animate.AddAnimation(t)
+	return nil
+}
+
+// AnimateFrame updates the position of the toast.
+func (t *toast) AnimateFrame(now animate.Time) bool {
+	if t.state == toastClosed || t.state == toastShown {
+		return false
+	}
+	if t.window.isClosed() {
+		t.close()
+		return false
+	}
+
+	t.window.setDPI()
+	t.place(t.ease.Value(now))
+	if !t.ease.Done(now) {
+		return true
+	}
+
+	if t.state == toastLeaving {
+		t.close()
+		return false
+	}
+
+	// The toast is now completely visible.  Start the timer to dismiss the
+	// toast.
+	t.state = toastShown
+	t.timer = time.AfterFunc(t.duration, func() {
+		// Error is ignored.  If the event loop has stopped, there is
+		// nothing left to dismiss.
+		_ = loop.Do(func() error {
+			t.dismiss()
+			return nil
+		})
+	})
+	return false
+}
+
+func (t *toast) close() {
+	if t.state == toastClosed {
+		return
+	}
+
+	t.state = toastClosed
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	// If the window has been closed, the platform resources for the toast
+	// have been released with the window.
+	if !t.window.isClosed() {
+		t.toastImpl.close()
+	}
+	if t.window.toast == t {
+		t.window.toast = nil
+	}
+}
+
+func (t *toast) dismiss() {
+	if t.state != toastEntering && t.state != toastShown {
+		return
+	}
+
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.ease = animate.NewEaseLength(0, t.ease.Value(animate.CurrentTime()))
+	t.state = toastLeaving
+	animate.AddAnimation(t)
+}
+
+// onClick should be called by the platform-specific code, on the GUI
+// thread, when the user clicks the toast's button.
+func (t *toast) onClick() {
+	if t.onAction != nil {
+		t.onAction()
+	}
+	t.dismiss()
+}
+
+// relayout updates the position of the toast.  This should be called by the
+// platform-specific code after the window has been resized.
+func (t *toast) relayout() {
+	t.place(t.ease.Value(animate.CurrentTime()))
+}
+
+// place positions the toast, centered horizontally, so that its top edge is
+// the specified distance above the bottom of the window's client area.
+func (t *toast) place(offset base.Length) {
+	clientSize := t.window.clientSize()
+
+	width := t.size.Width
+	if limit := clientSize.Width - 2*toastMargin; width > limit {
+		width = max(limit, 0)
+	}
+	x := (clientSize.Width - width) / 2
+	y := clientSize.Height - offset
+	t.setBounds(base.Rectangle{
+		Min: base.Point{x, y},
+		Max: base.Point{x + width, y + t.size.Height},
+	})
+}
@@ -0,0 +1,39 @@
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
+// +build cocoa darwin,!gtk
+
+package windows
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/cocoa"
+)
+
+type toastImpl struct {
+	handle *cocoa.Toast
+}
+
+func (t *toastImpl) mount(w *windowImpl, text, label string, onclick func()) (base.Size, error) {
+	t.handle = cocoa.NewToast(w.handle, text, label, onclick)
+
+	width, height := t.handle.IntrinsicSize()
+	return base.Size{base.FromPixelsX(width), base.FromPixelsY(height)}, nil
+}
+
+func (t *toastImpl) close() {
+	if t.handle != nil {
+		t.handle.Close()
+		t.handle = nil
+	}
+}
+
+func (t *toastImpl) setBounds(bounds base.Rectangle) {
+	t.handle.SetFrame(
+		bounds.Min.X.PixelsX(), bounds.Min.Y.PixelsY(),
+		bounds.Dx().PixelsX(), bounds.Dy().PixelsY())
+}
+
+func (w *windowImpl) clientSize() base.Size {
+	width, height := w.handle.ContentSize()
+	return base.Size{base.FromPixelsX(width), base.FromPixelsY(height)}
+}
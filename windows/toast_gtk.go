@@ -0,0 +1,39 @@
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
+// +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
+
+package windows
+
+import (
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/gtk"
+)
+
+type toastImpl struct {
+	handle uintptr
+}
+
+func (t *toastImpl) mount(w *windowImpl, text, label string, onclick func()) (base.Size, error) {
+	t.handle = gtk.MountToast(w.layout, text, label, onclick)
+
+	width, height := gtk.WidgetNaturalSize(t.handle)
+	return base.FromPixels(width, height), nil
+}
+
+func (t *toastImpl) close() {
+	if t.handle != 0 {
+		gtk.ToastClose(t.handle)
+		t.handle = 0
+	}
+}
+
+func (t *toastImpl) setBounds(bounds base.Rectangle) {
+	gtk.ToastSetBounds(t.handle,
+		bounds.Min.X.PixelsX(), bounds.Min.Y.PixelsY(),
+		bounds.Dx().PixelsX(), bounds.Dy().PixelsY())
+}
+
+func (w *windowImpl) clientSize() base.Size {
+	width, height := gtk.WindowSize(w.handle)
+	return base.FromPixels(width, height)
+}
@@ -0,0 +1,53 @@
+//go:build headless
+// +build headless
+
+package windows
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/internal/headless"
+)
+
+type toastImpl struct {
+	node    *headless.Node
+	onClick func()
+}
+
+func (t *toastImpl) mount(w *windowImpl, text, label string, onclick func()) (base.Size, error) {
+	// The toast is the last child of the root, so that it is drawn above
+	// the window's contents.
+	t.node = headless.NewNode(w.root)
+	t.node.Paint = func(dst draw.Image, bounds image.Rectangle) {
+		headless.Fill(dst, bounds, color.RGBA{50, 50, 50, 0xff})
+		pt := image.Point{bounds.Min.X + 16, (bounds.Min.Y + bounds.Max.Y - headless.LineHeight) / 2}
+		headless.DrawText(dst, pt, text, headless.Background)
+		if label != "" {
+			pt.X = bounds.Max.X - 16 - headless.TextSize(label).X
+			headless.DrawText(dst, pt, label, headless.Accent)
+		}
+	}
+	if label != "" {
+		t.onClick = onclick
+	}
+
+	width := headless.TextSize(text).X + 32
+	if label != "" {
+		width += headless.TextSize(label).X + 16
+	}
+	return base.FromPixels(width, headless.LineHeight+12), nil
+}
+
+func (t *toastImpl) close() {
+	if t.node != nil {
+		t.node.Close()
+		t.node = nil
+	}
+}
+
+func (t *toastImpl) setBounds(bounds base.Rectangle) {
+	t.node.SetBounds(bounds.Pixels())
+}
@@ -0,0 +1,53 @@
+//go:build go1.12 && !headless
+// +build go1.12,!headless
+
+package windows
+
+import (
+	"fmt"
+	"syscall/js"
+
+	"github.com/chaolihf/goey/base"
+	goeyjs "github.com/chaolihf/goey/internal/js"
+)
+
+type toastImpl struct {
+	handle  js.Value
+	onClick goeyjs.ClickCB
+}
+
+func (t *toastImpl) mount(w *windowImpl, text, label string, onclick func()) (base.Size, error) {
+	// The toast uses fixed positioning, so that it does not scroll with the
+	// window's contents.
+	t.handle = goeyjs.CreateElement("div", "goey-toast")
+	span := goeyjs.CreateElement("span", "")
+	span.Set("textContent", text)
+	t.handle.Call("appendChild", span)
+
+	if label != "" {
+		button := goeyjs.CreateElement("button", "btn btn-link")
+		button.Set("textContent", label)
+		t.onClick.Set(button, onclick)
+		t.handle.Call("appendChild", button)
+	}
+
+	w.handle.Call("appendChild", t.handle)
+
+	width := t.handle.Get("offsetWidth").Int()
+	height := t.handle.Get("offsetHeight").Int()
+	return base.FromPixels(width, height), nil
+}
+
+func (t *toastImpl) close() {
+	t.onClick.Close()
+	t.handle.Call("remove")
+	t.handle = js.Null()
+}
+
+func (t *toastImpl) setBounds(bounds base.Rectangle) {
+	style := t.handle.Get("style")
+	style.Set("left", fmt.Sprintf("%dpx", bounds.Min.X.PixelsX()))
+	style.Set("top", fmt.Sprintf("%dpx", bounds.Min.Y.PixelsY()))
+	style.Set("width", fmt.Sprintf("%dpx", bounds.Dx().PixelsX()))
+	style.Set("height", fmt.Sprintf("%dpx", bounds.Dy().PixelsY()))
+}
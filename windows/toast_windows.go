@@ -0,0 +1,229 @@
+//go:build !headless
+// +build !headless
+
+package windows
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/chaolihf/goey/base"
+	win2 "github.com/chaolihf/goey/internal/windows"
+	"github.com/chaolihf/win"
+)
+
+var (
+	toastClass struct {
+		className []uint16
+		atom      win.ATOM
+	}
+)
+
+func init() {
+	toastClass.className = []uint16{'G', 'o', 'e', 'y', 'T', 'o', 'a', 's', 't', 0}
+}
+
+const (
+	// Padding between the edge of the toast and the text, in pixels.
+	toastPaddingX = 16
+	toastPaddingY = 12
+)
+
+type toastImpl struct {
+	hwnd       win.HWND
+	text       []uint16
+	label      []uint16
+	labelWidth int32
+	onClick    func()
+}
+
+func registerToastClass() (win.ATOM, error) {
+	hInstance := win.GetModuleHandle(nil)
+	if hInstance == 0 {
+		return 0, syscall.GetLastError()
+	}
+
+	wc := win.WNDCLASSEX{
+		CbSize:        uint32(unsafe.Sizeof(win.WNDCLASSEX{})),
+		HInstance:     hInstance,
+		LpfnWndProc:   syscall.NewCallback(toastWindowProc),
+		HCursor:       win.LoadCursor(0, (*uint16)(unsafe.Pointer(uintptr(win.IDC_ARROW)))),
+		HbrBackground: (win.HBRUSH)(win.GetStockObject(win.NULL_BRUSH)),
+		LpszClassName: &toastClass.className[0],
+	}
+
+	atom := win.RegisterClassEx(&wc)
+	if atom == 0 {
+		return 0, syscall.GetLastError()
+	}
+
+	return atom, nil
+}
+
+func (t *toastImpl) mount(w *windowImpl, text, label string, onclick func()) (base.Size, error) {
+	// Ensure that our custom window class has been registered.
+	if toastClass.atom == 0 {
+		atom, err := registerToastClass()
+		if err != nil {
+			return base.Size{}, err
+		}
+		toastClass.atom = atom
+	}
+
+	var err error
+	t.text, err = syscall.UTF16FromString(text)
+	if err != nil {
+		return base.Size{}, err
+	}
+	if label != "" {
+		t.label, err = syscall.UTF16FromString(label)
+		if err != nil {
+			return base.Size{}, err
+		}
+	}
+	t.onClick = onclick
+
+	hwnd := win.CreateWindowEx(0, &toastClass.className[0], nil,
+		win.WS_CHILD|win.WS_VISIBLE|win.WS_CLIPSIBLINGS,
+		0, 0, 0, 0, w.Hwnd, 0, 0, nil)
+	if hwnd == 0 {
+		err := syscall.GetLastError()
+		if err == nil {
+			return base.Size{}, syscall.EINVAL
+		}
+		return base.Size{}, err
+	}
+	t.hwnd = hwnd
+	win.SetWindowLongPtr(hwnd, win.GWLP_USERDATA, uintptr(unsafe.Pointer(t)))
+
+	// Measure the text to determine the size of the toast.
+	cx, cy := t.calcRect(t.text)
+	if t.label != nil {
+		lcx, lcy := t.calcRect(t.label)
+		t.labelWidth = lcx
+		cx += toastPaddingX + lcx
+		if lcy > cy {
+			cy = lcy
+		}
+	}
+
+	return base.FromPixels(int(cx+2*toastPaddingX), int(cy+2*toastPaddingY)), nil
+}
+
+func (t *toastImpl) calcRect(text []uint16) (int32, int32) {
+	hdc := win.GetDC(t.hwnd)
+	if hFont := win2.MessageFont(); hFont != 0 {
+		win.SelectObject(hdc, win.HGDIOBJ(hFont))
+	}
+
+	rect := win.RECT{0, 0, 0x7fffffff, 0x7fffffff}
+	win.DrawTextEx(hdc, &text[0], int32(len(text)-1), &rect, win.DT_CALCRECT|win.DT_SINGLELINE, nil)
+	win.ReleaseDC(t.hwnd, hdc)
+
+	return rect.Right, rect.Bottom
+}
+
+func (t *toastImpl) close() {
+	if t.hwnd != 0 {
+		win.DestroyWindow(t.hwnd)
+		t.hwnd = 0
+	}
+}
+
+func (t *toastImpl) labelRect() win.RECT {
+	rect := win.RECT{}
+	win.GetClientRect(t.hwnd, &rect)
+	rect.Right -= toastPaddingX
+	rect.Left = rect.Right - t.labelWidth
+	return rect
+}
+
+func (t *toastImpl) paint() {
+	ps := win.PAINTSTRUCT{}
+	hdc := win.BeginPaint(t.hwnd, &ps)
+	defer win.EndPaint(t.hwnd, &ps)
+
+	rect := win.RECT{}
+	win.GetClientRect(t.hwnd, &rect)
+	hbrush := win.CreateSolidBrush(win.RGB(0x32, 0x32, 0x32))
+	win.FillRect(hdc, &rect, hbrush)
+	win.DeleteObject(win.HGDIOBJ(hbrush))
+
+	if hFont := win2.MessageFont(); hFont != 0 {
+		win.SelectObject(hdc, win.HGDIOBJ(hFont))
+	}
+	win.SetBkMode(hdc, win.TRANSPARENT)
+
+	textRect := rect
+	textRect.Left += toastPaddingX
+	textRect.Right -= toastPaddingX
+	if t.label != nil {
+		labelRect := t.labelRect()
+		textRect.Right = labelRect.Left - toastPaddingX
+		win.SetTextColor(hdc, win.RGB(0x8a, 0xb4, 0xf8))
+		win.DrawTextEx(hdc, &t.label[0], int32(len(t.label)-1), &labelRect,
+			win.DT_SINGLELINE|win.DT_VCENTER|win.DT_RIGHT, nil)
+	}
+	win.SetTextColor(hdc, win.RGB(0xff, 0xff, 0xff))
+	win.DrawTextEx(hdc, &t.text[0], int32(len(t.text)-1), &textRect,
+		win.DT_SINGLELINE|win.DT_VCENTER|win.DT_END_ELLIPSIS, nil)
+}
+
+func (t *toastImpl) setBounds(bounds base.Rectangle) {
+	// Keep the toast above all of its siblings, which are the controls for
+	// the window's child.
+	win.SetWindowPos(t.hwnd, win.HWND_TOP,
+		int32(bounds.Min.X.PixelsX()), int32(bounds.Min.Y.PixelsY()),
+		int32(bounds.Dx().PixelsX()), int32(bounds.Dy().PixelsY()),
+		win.SWP_NOACTIVATE)
+}
+
+func (w *windowImpl) clientSize() base.Size {
+	rect := win.RECT{}
+	win.GetClientRect(w.Hwnd, &rect)
+	return base.FromPixels(int(rect.Right-rect.Left), int(rect.Bottom-rect.Top))
+}
+
+func toastWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) (result uintptr) {
+	switch msg {
+	case win.WM_DESTROY:
+		// Make sure that the data structure on the Go-side does not point to a non-existent
+		// window.
+		if t := toastGetPtr(hwnd); t != nil {
+			t.hwnd = 0
+		}
+		// Defer to the old window proc
+
+	case win.WM_PAINT:
+		if t := toastGetPtr(hwnd); t != nil {
+			t.paint()
+			return 0
+		}
+
+	case win.WM_LBUTTONUP:
+		if t := toastGetPtr(hwnd); t != nil && t.label != nil {
+			rect := t.labelRect()
+			pt := win.POINT{X: win.GET_X_LPARAM(lParam), Y: win.GET_Y_LPARAM(lParam)}
+			if pt.X >= rect.Left && pt.X < rect.Right {
+				t.onClick()
+			}
+			return 0
+		}
+	}
+
+	return win.DefWindowProc(hwnd, msg, wParam, lParam)
+}
+
+func toastGetPtr(hwnd win.HWND) *toastImpl {
+	gwl := win.GetWindowLongPtr(hwnd, win.GWLP_USERDATA)
+	if gwl == 0 {
+		return nil
+	}
+
+	ptr := (*toastImpl)(unsafe.Pointer(gwl))
+	if ptr.hwnd != hwnd && ptr.hwnd != 0 {
+		panic("Internal error.")
+	}
+
+	return ptr
+}
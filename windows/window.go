@@ -18,6 +18,10 @@ var (
 	ErrSetChildrenNotReentrant = errors.New("method SetChild is not reentrant")
 
 	insideSetChildren uintptr
+
+	// List of windows created by NewWindow.  Closed windows are removed
+	// lazily.
+	allWindows []*Window
 )
 
 // Window represents a top-level window that contain other widgets.
@@ -25,10 +29,20 @@ type Window struct {
 	windowImpl
 }
 
-// NewWindow create a new top-level window for the application.
-func NewWindow(title string, child base.Widget) (*Window, error) {
+// NewWindow create a new top-level window for the application.  Options
+// can be provided to set the window's owner, or to change how the window is
+// shown.
+func NewWindow(title string, child base.Widget, options ...WindowOption) (*Window, error) {
+	opts := windowOptions{}
+	for _, v := range options {
+		v(&opts)
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
 	// Create the window
-	w, err := newWindow(title)
+	w, err := newWindow(title, &opts)
 	if err != nil {
 		return nil, err
 	}
@@ -45,8 +59,14 @@ func NewWindow(title string, child base.Widget) (*Window, error) {
 		return nil, err
 	}
 
+	// Restore any saved geometry before the window is shown, to avoid
+	// flicker.
+	w.stateStore, w.stateKey = opts.stateStore, opts.stateKey
+	w.restoreState()
+
 	// Show the window
 	w.show()
+	allWindows = append(openWindows(), w)
 
 	if filename := os.Getenv("GOEY_SCREENSHOT"); filename != "" {
 		asyncScreenshot(filename, w)
@@ -55,8 +75,38 @@ func NewWindow(title string, child base.Widget) (*Window, error) {
 	return w, nil
 }
 
-// Close destroys the window, and releases all associated resources.
+// All returns the windows created using NewWindow that have not yet been
+// closed.  The windows are listed in the order that they were created.
+//
+// Like other modifications to the GUI, this function must be called on the
+// GUI thread.
+func All() []*Window {
+	allWindows = openWindows()
+	return append([]*Window(nil), allWindows...)
+}
+
+// openWindows removes any closed windows from allWindows.
+func openWindows() []*Window {
+	ret := allWindows[:0]
+	for _, v := range allWindows {
+		if !v.isClosed() {
+			ret = append(ret, v)
+		}
+	}
+	// Clear the tail so that closed windows can be collected.
+	for i := len(ret); i < len(allWindows); i++ {
+		allWindows[i] = nil
+	}
+	return ret
+}
+
+// Close destroys the window, and releases all associated resources.  If the
+// window has a state store, its geometry is saved first.
 func (w *Window) Close() {
+	w.saveState()
+	if w.toast != nil {
+		w.toast.close()
+	}
 	w.close()
 }
 
@@ -177,6 +227,8 @@ func (w *Window) SetChild(child base.Widget) error {
 	// clean-up.  This is to recalculate min window size, update scrollbars, etc.
 	w.child = newChild
 	w.setChildPost()
+	// Apply any overrides for the keyboard navigation order.
+	w.updateTabOrder()
 
 	return err
 }
@@ -203,6 +255,16 @@ func (w *Window) SetOnClosing(callback func() bool) {
 	w.setOnClosing(callback)
 }
 
+// SetOnDPIChange changes the event callback for when the DPI of the window
+// changes, for example when the window is moved to a monitor with a different
+// scale.  The window's contents are laid out again before the callback is
+// called.  The DPI passed to the callback is measured in device pixels.
+//
+// Only the GTK platform currently reports changes to the DPI.
+func (w *Window) SetOnDPIChange(callback func(dpi image.Point)) {
+	w.setOnDPIChange(callback)
+}
+
 // SetScroll sets whether scrolling is allowed in the horizontal and vertical directions.
 func (w *Window) SetScroll(horizontal, vertical bool) {
 	// Copy the new parameters for the window into the fields.
@@ -1,4 +1,5 @@
-//go:build cocoa || (darwin && !gtk)
+//go:build !headless && (cocoa || (darwin && !gtk))
+// +build !headless
 // +build cocoa darwin,!gtk
 
 package windows
@@ -21,10 +22,17 @@ type windowImpl struct {
 	verticalScroll          bool
 	verticalScrollVisible   bool
 
-	onClosing func() bool
+	onClosing   func() bool
+	onDPIChange func(image.Point)
+	onResize    func(int, int) bool
+	toast       *toast
+	sizeLimits
+	persistence
+	windowEvents
+	cursorState
 }
 
-func newWindow(title string) (*Window, error) {
+func newWindow(title string, opts *windowOptions) (*Window, error) {
 	// Update the global DPI
 	base.DPI.X, base.DPI.Y = 96, 96
 
@@ -37,6 +45,12 @@ func newWindow(title string) (*Window, error) {
 	}}
 	handle.SetCallbacks((*windowCallbacks)(&retval.windowImpl))
 
+	var owner *cocoa.Window
+	if opts.owner != nil {
+		owner = opts.owner.handle
+	}
+	handle.SetOptions(owner, opts.toolWindow, opts.alwaysOnTop)
+
 	return retval, nil
 }
 
@@ -56,11 +70,40 @@ func (w *windowImpl) message(m *dialog.Message) {
 	m.WithOwner(dialog.Owner{Window: w.handle})
 }
 
+func (w *windowImpl) question(m *dialog.Question) {
+	//m.title, m.err = w.handle.GetTitle()
+	m.WithOwner(dialog.Owner{Window: w.handle})
+}
+
+func (w *windowImpl) prompt(m *dialog.Prompt) {
+	//m.title, m.err = w.handle.GetTitle()
+	m.WithOwner(dialog.Owner{Window: w.handle})
+}
+
+func (w *windowImpl) colorchooser(m *dialog.ColorChooser) {
+	//m.title, m.err = w.handle.GetTitle()
+	m.WithOwner(dialog.Owner{Window: w.handle})
+}
+
+func (w *windowImpl) fontchooser(m *dialog.FontChooser) {
+	//m.title, m.err = w.handle.GetTitle()
+	m.WithOwner(dialog.Owner{Window: w.handle})
+}
+
+func (w *windowImpl) progress(m *dialog.Progress) {
+	m.WithOwner(dialog.Owner{Window: w.handle})
+}
+
 func (w *windowImpl) openfiledialog(m *dialog.OpenFile) {
 	//m.title, m.err = w.handle.GetTitle()
 	m.WithOwner(dialog.Owner{Window: w.handle})
 }
 
+func (w *windowImpl) openfolderdialog(m *dialog.OpenFolder) {
+	//m.title, m.err = w.handle.GetTitle()
+	m.WithOwner(dialog.Owner{Window: w.handle})
+}
+
 func (w *windowImpl) savefiledialog(m *dialog.SaveFile) {
 	//m.title, m.err = w.handle.GetTitle()
 	m.WithOwner(dialog.Owner{Window: w.handle})
@@ -112,12 +155,25 @@ func (w *windowImpl) onSize() {
 		base.Point{}, base.Point{size.Width, size.Height},
 	}
 	w.child.SetBounds(bounds)
+
+	// Keep any toast positioned along the bottom of the window.
+	if w.toast != nil {
+		w.toast.relayout()
+	}
+
+	if w.onResize != nil {
+		w.onResize(width, height)
+	}
+	w.sizeChanged(clientSize)
 }
 
-// Screenshot returns an image of the window, as displayed on screen.
-func (w *windowImpl) Screenshot() (image.Image, error) {
+// screenshot returns an image of the window, as displayed on screen, and the
+// position of the client area within the image.  The title bar is at the top
+// of the frame, so the client area is aligned with the bottom of the image.
+func (w *windowImpl) screenshot() (image.Image, image.Point, error) {
 	img := w.handle.Screenshot()
-	return img, nil
+	_, height := w.handle.ContentSize()
+	return img, image.Pt(0, img.Bounds().Dy()-height), nil
 }
 
 func (w *windowImpl) setChildPost() {
@@ -145,6 +201,22 @@ func (w *windowImpl) setScroll(horz, vert bool) {
 	w.onSize()
 }
 
+func (w *windowImpl) isClosed() bool {
+	return w.handle == nil
+}
+
+func (w *windowImpl) setDialog(owner *Window, onCancel func()) {
+	// Do nothing.
+}
+
+func (w *windowImpl) showModal(owner *Window) {
+	w.show()
+}
+
+func (w *windowImpl) endModal(owner *Window) {
+	// Do nothing.
+}
+
 func (w *windowImpl) show() {
 	//w.handle.ShowAll()
 }
@@ -157,6 +229,20 @@ func (w *windowImpl) setOnClosing(callback func() bool) {
 	w.onClosing = callback
 }
 
+func (w *windowImpl) setOnDPIChange(callback func(image.Point)) {
+	// The DPI is fixed on this platform, so the callback will never be
+	// called.
+	w.onDPIChange = callback
+}
+
+func (w *windowImpl) setOnResize(callback func(int, int) bool) {
+	w.onResize = callback
+}
+
+func (w *windowImpl) getSize() (int, int) {
+	return w.handle.ContentSize()
+}
+
 func (w *windowImpl) setTitle(value string) error {
 	w.handle.SetTitle(value)
 	return nil
@@ -203,30 +289,74 @@ func (w *windowImpl) title() string {
 }
 
 func (w *windowImpl) updateWindowMinSize() {
-	size := w.MinSize()
+	size := w.limitMinSize(w.MinSize())
 
 	dx := size.Width.PixelsX()
 	dy := size.Height.PixelsY()
 
 	// Determine the extra width and height required for scrollbars.
+	extra := image.Point{}
 	if w.verticalScroll {
 		// TODO:  Measure scrollbar width
-		dx += 15
+		extra.X = 15
 	}
 	if w.horizontalScroll {
 		// TODO:  Measure scrollbar height
-		dy += 15
+		extra.Y = 15
+	}
+
+	w.handle.SetMinSize(dx+extra.X, dy+extra.Y)
+
+	// The maximum size, if any, also needs space for the scrollbars.
+	limit := w.maxPixels(size)
+	if limit.X > 0 {
+		limit.X += extra.X
+	}
+	if limit.Y > 0 {
+		limit.Y += extra.Y
 	}
+	w.handle.SetMaxSize(limit.X, limit.Y)
+}
+
+func (w *windowImpl) updateSizeLimits() {
+	w.updateWindowMinSize()
+	w.onSize()
+}
+
+func (w *windowImpl) setSize(width, height int) {
+	w.handle.Resize(width, height)
+}
+
+func (w *windowImpl) position() image.Point {
+	x, y := w.handle.Position()
+	return image.Point{X: x, Y: y}
+}
 
-	w.handle.SetMinSize(dx, dy)
+// isOnScreen returns true if the position is on a monitor.
+func (w *windowImpl) isOnScreen(x, y int) bool {
+	// The monitors cannot currently be checked.
+	return true
+}
+
+func (w *windowImpl) setPosition(x, y int) {
+	w.handle.Move(x, y)
+}
+
+func (w *windowImpl) setState(state WindowState) {
+	w.handle.SetState(int(state))
+}
+
+func (w *windowImpl) state() WindowState {
+	return WindowState(w.handle.State())
 }
 
 type windowCallbacks windowImpl
 
 func (w *windowCallbacks) OnShouldClose() bool {
-	if w.onClosing != nil {
-		return !w.onClosing()
+	if w.onClosing != nil && w.onClosing() {
+		return false
 	}
+	(*windowImpl)(w).saveState()
 	return true
 }
 
@@ -239,3 +369,34 @@ func (w *windowCallbacks) OnDidResize() {
 	impl := (*windowImpl)(w)
 	impl.onSize()
 }
+
+func (w *windowCallbacks) OnDidChangeKey(key bool) {
+	(*windowImpl)(w).activeChanged(key)
+}
+
+func (w *windowCallbacks) OnDidChangeMiniaturized(miniaturized bool) {
+	(*windowImpl)(w).minimizedChanged(miniaturized)
+}
+
+func (w *windowCallbacks) OnDidMove() {
+	impl := (*windowImpl)(w)
+	impl.moved(impl.position())
+}
+
+// containsTarget returns true if the focus target is within the window.
+func (w *windowImpl) containsTarget(target FocusTarget) bool {
+	// The window for a control cannot currently be checked.
+	return true
+}
+
+func (w *windowImpl) updateTabOrder() {
+	// The order for keyboard navigation cannot currently be overridden.
+}
+
+func (w *windowImpl) updateCursor() {
+	// The cursor cannot currently be changed.
+}
+
+func (w *windowImpl) setBusy(busy bool) {
+	// Input cannot currently be blocked.
+}
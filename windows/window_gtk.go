@@ -1,4 +1,5 @@
-//go:build gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa)
+//go:build !headless && (gtk || (linux && !cocoa) || (freebsd && !cocoa) || (openbsd && !cocoa))
+// +build !headless
 // +build gtk linux,!cocoa freebsd,!cocoa openbsd,!cocoa
 
 package windows
@@ -22,20 +23,38 @@ type windowImpl struct {
 	verticalScroll          bool
 	verticalScrollVisible   bool
 	onClosing               func() bool
+	onDPIChange             func(image.Point)
+	onResize                func(int, int) bool
 	iconPix                 []byte
+	toast                   *toast
+	dpi                     image.Point // Resolution in application pixels.
+	scale                   int         // Device pixels per application pixel.
+	sizeLimits
+	persistence
+	windowEvents
+	cursorState
+	pointer     image.Point // Last position of the mouse in the window.
+	shownCursor Cursor      // Cursor currently set for the GDK window.
 }
 
-func newWindow(title string) (*Window, error) {
+func newWindow(title string, opts *windowOptions) (*Window, error) {
 	// Create a new GTK window
 	window := gtk.MountWindow(title)
 	loop.AddLockCount(1)
 
+	owner := uintptr(0)
+	if opts.owner != nil {
+		owner = opts.owner.handle
+	}
+	gtk.WindowSetOptions(window, owner, opts.modal, opts.toolWindow, opts.alwaysOnTop)
+
 	retval := &Window{windowImpl{
 		handle: window,
 		scroll: gtk.WindowScrolledWindow(window),
 		layout: gtk.WindowLayout(window),
 	}}
 	gtk.RegisterWidget(window, retval)
+	retval.updateDPI()
 	gtk.WindowSetDefaultSize(func() (uintptr, int, int) {
 		w, h := sizeDefaults()
 		return window, int(w), int(h)
@@ -56,10 +75,80 @@ func (w *windowImpl) OnDestroy() {
 }
 
 func (w *windowImpl) OnDeleteEvent() bool {
-	if w.onClosing == nil {
+	if w.onClosing != nil && w.onClosing() {
+		return true
+	}
+	w.saveState()
+	return false
+}
+
+// OnActiveChanged is called when the window becomes, or stops being, the
+// active window.
+func (w *windowImpl) OnActiveChanged(active bool) {
+	w.activeChanged(active)
+}
+
+// OnIconified is called when the window is minimized or restored.
+func (w *windowImpl) OnIconified(iconified bool) {
+	w.minimizedChanged(iconified)
+}
+
+// OnMove is called when the window's position may have changed.
+func (w *windowImpl) OnMove(x, y int) {
+	w.moved(image.Point{X: x, Y: y})
+}
+
+// OnSetFocus is called when the keyboard focus moves to a new widget, which
+// may be zero.
+func (w *windowImpl) OnSetFocus(focus uintptr) {
+	if focus == 0 {
+		w.focusChanged("")
+		return
+	}
+
+	w.focusChanged(focusKey(func(target FocusTarget) bool {
+		t, ok := target.(interface{ Handle() uintptr })
+		return ok && t.Handle() != 0 && gtk.WidgetIsWithin(focus, t.Handle())
+	}))
+}
+
+// OnMotion is called when the mouse moves within the window.  The position
+// is relative to the window.
+func (w *windowImpl) OnMotion(x, y int) {
+	w.pointer = image.Pt(x, y)
+	w.updateCursor()
+}
+
+// OnDPIChanged is called when the window's scale factor, or the Xft DPI,
+// may have changed.
+func (w *windowImpl) OnDPIChanged() {
+	if w.handle == 0 || !w.updateDPI() {
+		return
+	}
+
+	// Lengths need to be converted to pixels again, so the window's minimum
+	// size and the layout of the child need to be updated.
+	if w.child != nil {
+		w.setDPI()
+		w.updateWindowMinSize()
+		w.onSize()
+	}
+	if w.onDPIChange != nil {
+		w.onDPIChange(image.Point{X: w.dpi.X * w.scale, Y: w.dpi.Y * w.scale})
+	}
+}
+
+// updateDPI reads the resolution and scale factor for the window, and
+// returns true if either has changed.
+func (w *windowImpl) updateDPI() bool {
+	resolution, scale := gtk.WindowDPI(w.handle)
+	dpi := image.Point{X: int(resolution + 0.5), Y: int(resolution + 0.5)}
+	if dpi == w.dpi && scale == w.scale {
 		return false
 	}
-	return w.onClosing()
+
+	w.dpi, w.scale = dpi, scale
+	return true
 }
 
 func (w *windowImpl) onSize() {
@@ -72,7 +161,7 @@ func (w *windowImpl) OnSizeAllocate(width, height int) {
 	}
 
 	// Update the global DPI
-	base.DPI.X, base.DPI.Y = 96, 96
+	w.setDPI()
 
 	clientSize := base.FromPixels(width, height)
 	size := w.layoutChild(clientSize)
@@ -109,6 +198,16 @@ func (w *windowImpl) OnSizeAllocate(width, height int) {
 		Max: base.Point{size.Width, size.Height},
 	}
 	w.child.SetBounds(bounds)
+
+	// Keep any toast positioned along the bottom of the window.
+	if w.toast != nil {
+		w.toast.relayout()
+	}
+
+	if w.onResize != nil {
+		w.onResize(width, height)
+	}
+	w.sizeChanged(clientSize)
 }
 
 func (w *windowImpl) control() base.Control {
@@ -128,16 +227,47 @@ func (w *windowImpl) message(m *dialog.Message) {
 	m.WithOwner(dialog.Owner{Handle: w.handle})
 }
 
+func (w *windowImpl) question(m *dialog.Question) {
+	title := gtk.WindowTitle(w.handle)
+	m.WithTitle(title)
+	m.WithOwner(dialog.Owner{Handle: w.handle})
+}
+
+func (w *windowImpl) prompt(m *dialog.Prompt) {
+	title := gtk.WindowTitle(w.handle)
+	m.WithTitle(title)
+	m.WithOwner(dialog.Owner{Handle: w.handle})
+}
+
+func (w *windowImpl) colorchooser(m *dialog.ColorChooser) {
+	m.WithOwner(dialog.Owner{Handle: w.handle})
+}
+
+func (w *windowImpl) fontchooser(m *dialog.FontChooser) {
+	m.WithOwner(dialog.Owner{Handle: w.handle})
+}
+
+func (w *windowImpl) progress(m *dialog.Progress) {
+	m.WithOwner(dialog.Owner{Handle: w.handle})
+}
+
 func (w *windowImpl) openfiledialog(m *dialog.OpenFile) {
 	m.WithOwner(dialog.Owner{Handle: w.handle})
 }
 
+func (w *windowImpl) openfolderdialog(m *dialog.OpenFolder) {
+	m.WithOwner(dialog.Owner{Handle: w.handle})
+}
+
 func (w *windowImpl) savefiledialog(m *dialog.SaveFile) {
 	m.WithOwner(dialog.Owner{Handle: w.handle})
 }
 
-// Screenshot returns an image of the window, as displayed on screen.
-func (w *windowImpl) Screenshot() (image.Image, error) {
+// screenshot returns an image of the window, as displayed on screen, and the
+// position of the client area within the image.  The capture includes the
+// title bar and borders, using offsets that are tuned to XFCE.
+func (w *windowImpl) screenshot() (image.Image, image.Point, error) {
+	origin := image.Pt(1, 25)
 	pix, hasAlpha, width, height, stride := gtk.WindowScreenshot(w.handle)
 
 	if hasAlpha {
@@ -145,7 +275,7 @@ func (w *windowImpl) Screenshot() (image.Image, error) {
 			Pix:    pix,
 			Stride: stride,
 			Rect:   image.Rect(0, 0, width, height),
-		}, nil
+		}, origin, nil
 	}
 
 	newpix := make([]byte, height*width*4)
@@ -164,12 +294,38 @@ func (w *windowImpl) Screenshot() (image.Image, error) {
 		Pix:    newpix,
 		Stride: width * 4,
 		Rect:   image.Rect(0, 0, width, height),
-	}, nil
+	}, origin, nil
+}
+
+// setDPI updates the global DPI.  GTK lays out widgets using application
+// pixels, so the scale factor is applied by GTK, and is not included.
+func (w *windowImpl) setDPI() {
+	base.DPI = w.dpi
 }
 
-// setDPI updates the global DPI.
-func (*windowImpl) setDPI() {
-	base.DPI.X, base.DPI.Y = 96, 96
+func (w *windowImpl) isClosed() bool {
+	return w.handle == 0
+}
+
+func (w *windowImpl) setDialog(owner *Window, onCancel func()) {
+	// When the user closes the dialog, the window is destroyed, which
+	// will end the modal loop.  There is no need to use onCancel.
+	if owner != nil {
+		gtk.WindowSetDialog(w.handle, owner.handle)
+	} else {
+		gtk.WindowSetDialog(w.handle, 0)
+	}
+}
+
+func (w *windowImpl) showModal(owner *Window) {
+	// The size request for the window has already been set to the minimum
+	// size, so a small default size will fit the dialog to its contents.
+	gtk.WindowSetDefaultSize(w.handle, 1, 1)
+	w.show()
+}
+
+func (w *windowImpl) endModal(owner *Window) {
+	// Do nothing.  GTK restores the owner when the dialog is destroyed.
 }
 
 func (w *windowImpl) setChildPost() {
@@ -243,6 +399,18 @@ func (w *windowImpl) setOnClosing(callback func() bool) {
 	w.onClosing = callback
 }
 
+func (w *windowImpl) setOnDPIChange(callback func(image.Point)) {
+	w.onDPIChange = callback
+}
+
+func (w *windowImpl) setOnResize(callback func(int, int) bool) {
+	w.onResize = callback
+}
+
+func (w *windowImpl) getSize() (int, int) {
+	return gtk.WindowSize(w.handle)
+}
+
 func (w *windowImpl) setTitle(value string) error {
 	gtk.WindowSetTitle(w.handle, value)
 	return nil
@@ -253,18 +421,110 @@ func (w *windowImpl) title() string {
 }
 
 func (w *windowImpl) updateWindowMinSize() {
-	size := w.MinSize()
+	size := w.limitMinSize(w.MinSize())
 
 	dx := size.Width.PixelsX()
 	dy := size.Height.PixelsY()
 
 	// Determine the extra width and height required for scrollbars.
+	extra := image.Point{}
 	if w.verticalScroll {
-		dx += int(gtk.WindowVScrollbarWidth(w.handle))
+		extra.X = int(gtk.WindowVScrollbarWidth(w.handle))
 	}
 	if w.horizontalScroll {
-		dy += int(gtk.WindowHScrollbarHeight(w.handle))
+		extra.Y = int(gtk.WindowHScrollbarHeight(w.handle))
+	}
+
+	gtk.WidgetSetSizeRequest(w.handle, dx+extra.X, dy+extra.Y)
+
+	// The maximum size, if any, also needs space for the scrollbars.
+	limit := w.maxPixels(size)
+	if limit.X > 0 {
+		limit.X += extra.X
+	}
+	if limit.Y > 0 {
+		limit.Y += extra.Y
+	}
+	gtk.WindowSetMaxSize(w.handle, limit.X, limit.Y)
+}
+
+func (w *windowImpl) updateSizeLimits() {
+	w.updateWindowMinSize()
+	w.onSize()
+}
+
+func (w *windowImpl) setSize(width, height int) {
+	gtk.WindowResize(w.handle, width, height)
+}
+
+func (w *windowImpl) position() image.Point {
+	x, y := gtk.WindowPosition(w.handle)
+	return image.Point{X: x, Y: y}
+}
+
+// isOnScreen returns true if the position is within the work area of a
+// monitor.
+func (w *windowImpl) isOnScreen(x, y int) bool {
+	return gtk.WindowIsOnScreen(w.handle, x, y)
+}
+
+func (w *windowImpl) setPosition(x, y int) {
+	gtk.WindowMove(w.handle, x, y)
+}
+
+func (w *windowImpl) setState(state WindowState) {
+	gtk.WindowSetState(w.handle, int(state))
+}
+
+func (w *windowImpl) state() WindowState {
+	return WindowState(gtk.WindowState(w.handle))
+}
+
+// containsTarget returns true if the focus target is within the window.
+func (w *windowImpl) containsTarget(target FocusTarget) bool {
+	t, ok := target.(interface{ Handle() uintptr })
+	return ok && t.Handle() != 0 && gtk.WidgetIsWithin(t.Handle(), w.handle)
+}
+
+func (w *windowImpl) updateTabOrder() {
+	// Keyboard navigation follows the focus chain of each layout.  Move
+	// controls with a tab index to the front of the chains, so that they are
+	// visited first.
+	handles := []uintptr(nil)
+	for _, v := range tabOrder() {
+		if w.containsTarget(v.target) {
+			handles = append(handles, v.target.(interface{ Handle() uintptr }).Handle())
+		}
 	}
+	gtk.WindowSetTabOrder(w.handle, handles)
+}
+
+// currentCursor returns the cursor for the last position of the mouse.
+func (w *windowImpl) currentCursor() Cursor {
+	w.setDPI()
+	return w.cursorAt(func(parent base.Control, bounds base.Rectangle) bool {
+		if !gtk.WidgetIsWithin(parent.Handle, w.handle) {
+			return false
+		}
+		x, y, _, _ := gtk.WidgetBounds(parent.Handle)
+		return w.pointer.Sub(image.Pt(x, y)).In(bounds.Pixels())
+	})
+}
+
+func (w *windowImpl) updateCursor() {
+	cursor := w.currentCursor()
+	if cursor == w.shownCursor {
+		return
+	}
+	w.shownCursor = cursor
+
+	if cursor == CursorDefault {
+		gtk.WindowSetCursor(w.handle, "")
+	} else {
+		gtk.WindowSetCursor(w.handle, cursor.String())
+	}
+}
 
-	gtk.WidgetSetSizeRequest(w.handle, dx, dy)
+func (w *windowImpl) setBusy(busy bool) {
+	gtk.WindowSetBusy(w.handle, busy)
 }
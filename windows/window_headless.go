@@ -0,0 +1,428 @@
+//go:build headless
+// +build headless
+
+package windows
+
+import (
+	"image"
+	"image/draw"
+
+	"github.com/chaolihf/goey/base"
+	"github.com/chaolihf/goey/dialog"
+	"github.com/chaolihf/goey/internal/headless"
+	"github.com/chaolihf/goey/loop"
+)
+
+type windowImpl struct {
+	root                    *headless.Node
+	child                   base.Element
+	owner                   *Window
+	titleText               string
+	icon                    image.Image
+	clientWidth             int
+	clientHeight            int
+	horizontalScroll        bool
+	horizontalScrollVisible bool
+	verticalScroll          bool
+	verticalScrollVisible   bool
+	onClosing               func() bool
+	onDPIChange             func(image.Point)
+	onResize                func(int, int) bool
+	toast                   *toast
+	sizeLimits
+	persistence
+	windowEvents
+	cursorState
+	origin      image.Point     // Position of the window on the screen.
+	windowState WindowState     // Whether the window is maximized, etc.
+	restored    image.Rectangle // Position and client size in the normal state.
+}
+
+var (
+	// screenSize is the size of the simulated screen, in pixels.  Maximized
+	// and fullscreen windows are resized to fill the screen.
+	screenSize = image.Point{X: 1920, Y: 1080}
+)
+
+func init() {
+	// Report changes in the keyboard focus to the window that contains the
+	// focused node.
+	headless.OnFocusChange = func(n *headless.Node) {
+		for _, v := range All() {
+			if n == nil {
+				v.focusChanged("")
+			} else if n.IsWithin(v.root) {
+				v.focusChanged(focusKey(func(target FocusTarget) bool {
+					node := targetNode(target)
+					return node != nil && n.IsWithin(node)
+				}))
+			}
+		}
+	}
+}
+
+// targetNode returns the simulated control for a focus target, if any.
+func targetNode(target FocusTarget) *headless.Node {
+	if t, ok := target.(interface{ Node() *headless.Node }); ok {
+		return t.Node()
+	}
+	return nil
+}
+
+func newWindow(title string, opts *windowOptions) (*Window, error) {
+	width, height := sizeDefaults()
+
+	root := headless.NewNode(nil)
+	root.Paint = func(dst draw.Image, bounds image.Rectangle) {
+		headless.Fill(dst, bounds, headless.Background)
+	}
+
+	retval := &Window{windowImpl{
+		root:         root,
+		owner:        opts.owner,
+		titleText:    title,
+		clientWidth:  int(width),
+		clientHeight: int(height),
+	}}
+	loop.AddLockCount(1)
+
+	return retval, nil
+}
+
+func (w *windowImpl) clientSize() base.Size {
+	return base.FromPixels(w.clientWidth, w.clientHeight)
+}
+
+func (w *windowImpl) control() base.Control {
+	return base.Control{Node: w.root}
+}
+
+// RequestClose simulates the user clicking on the window's close button.  If
+// the callback set using SetOnClosing returns true, the window will remain
+// open.
+func (w *windowImpl) RequestClose() {
+	if w.onClosing != nil && w.onClosing() {
+		return
+	}
+	w.saveState()
+	w.close()
+}
+
+// SetActive simulates the window becoming, or no longer being, the active
+// window.
+func (w *windowImpl) SetActive(active bool) {
+	w.activeChanged(active)
+}
+
+// FocusNext simulates the user pressing the tab key to move the keyboard focus
+// to the next control.  The return value indicates whether any control took
+// the focus.
+func (w *windowImpl) FocusNext() bool {
+	return headless.FocusNext(w.root)
+}
+
+// CursorAt returns the cursor that would be shown with the mouse at the
+// point, which is in pixels relative to the window's client area.
+func (w *windowImpl) CursorAt(pt image.Point) Cursor {
+	return w.cursorAt(func(parent base.Control, bounds base.Rectangle) bool {
+		if !parent.Node.IsWithin(w.root) {
+			return false
+		}
+		return pt.Sub(parent.Node.Origin()).In(bounds.Pixels())
+	})
+}
+
+// Resize simulates the user changing the size of the window's client area.
+// The size is in pixels, and will be increased, if necessary, to meet the
+// minimum size of the window's contents.
+func (w *windowImpl) Resize(width, height int) {
+	w.clientWidth, w.clientHeight = width, height
+	w.updateWindowMinSize()
+	w.onSize()
+}
+
+func (w *windowImpl) close() {
+	if w.root == nil {
+		return
+	}
+
+	// Windows owned by this window are closed first.
+	for _, v := range All() {
+		if v.owner != nil && &v.owner.windowImpl == w {
+			v.Close()
+		}
+	}
+
+	// Closing the controls will blur any focused control.
+	if w.child != nil {
+		w.child.Close()
+		w.child = nil
+	}
+	w.root.Close()
+	w.root = nil
+	loop.AddLockCount(-1)
+}
+
+func (w *windowImpl) onSize() {
+	w.root.SetBounds(image.Rect(0, 0, w.clientWidth, w.clientHeight))
+	if w.child == nil {
+		return
+	}
+
+	// Update the global DPI
+	w.setDPI()
+
+	clientSize := w.clientSize()
+	size := w.layoutChild(clientSize)
+	w.horizontalScrollVisible = w.horizontalScroll && size.Width > clientSize.Width
+	w.verticalScrollVisible = w.verticalScroll && size.Height > clientSize.Height
+	w.child.SetBounds(base.Rectangle{
+		Min: base.Point{},
+		Max: base.Point{size.Width, size.Height},
+	})
+
+	// Keep any toast positioned along the bottom of the window.
+	if w.toast != nil {
+		w.toast.relayout()
+	}
+
+	if w.onResize != nil {
+		w.onResize(w.clientWidth, w.clientHeight)
+	}
+	w.sizeChanged(clientSize)
+}
+
+func (w *windowImpl) message(m *dialog.Message) {
+	m.WithTitle(w.titleText)
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) question(m *dialog.Question) {
+	m.WithTitle(w.titleText)
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) prompt(m *dialog.Prompt) {
+	m.WithTitle(w.titleText)
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) colorchooser(m *dialog.ColorChooser) {
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) fontchooser(m *dialog.FontChooser) {
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) progress(m *dialog.Progress) {
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) openfiledialog(m *dialog.OpenFile) {
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) openfolderdialog(m *dialog.OpenFolder) {
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+func (w *windowImpl) savefiledialog(m *dialog.SaveFile) {
+	m.WithOwner(dialog.Owner{Node: w.root})
+}
+
+// screenshot returns an image of the window's client area.  The image is
+// rendered in software, and is only a rough approximation of how the window
+// would appear on a display.
+func (w *windowImpl) screenshot() (image.Image, image.Point, error) {
+	img := image.NewRGBA(image.Rect(0, 0, w.clientWidth, w.clientHeight))
+	w.root.Render(img, image.Point{})
+	return img, image.Point{}, nil
+}
+
+// setDPI updates the global DPI.
+func (*windowImpl) setDPI() {
+	base.DPI.X, base.DPI.Y = 96, 96
+}
+
+func (w *windowImpl) isClosed() bool {
+	return w.root == nil
+}
+
+func (w *windowImpl) setDialog(owner *Window, onCancel func()) {
+	w.owner = owner
+	w.onClosing = func() bool {
+		onCancel()
+		return true
+	}
+}
+
+func (w *windowImpl) showModal(owner *Window) {
+	// Fit the dialog to its contents.
+	if w.child != nil {
+		size := w.MinSize()
+		w.clientWidth, w.clientHeight = size.Width.PixelsX(), size.Height.PixelsY()
+	}
+	w.show()
+}
+
+func (w *windowImpl) endModal(owner *Window) {
+	// Do nothing.  There is no native window to restore.
+}
+
+func (w *windowImpl) setChildPost() {
+	// Constrain window size
+	w.updateWindowMinSize()
+	// Properties may have changed sizes, so we need to do layout.
+	w.onSize()
+}
+
+func (w *windowImpl) setScroll(horz, vert bool) {
+	// If either scrollbar is being disabled, make sure that it is hidden.
+	if !horz {
+		w.horizontalScrollVisible = false
+	}
+	if !vert {
+		w.verticalScrollVisible = false
+	}
+
+	// Redo layout to account for new box constraints, and show
+	// scrollbars if necessary
+	w.onSize()
+}
+
+func (w *windowImpl) show() {
+	w.onSize()
+}
+
+func (w *windowImpl) setIcon(img image.Image) error {
+	w.icon = img
+	return nil
+}
+
+func (w *windowImpl) setOnClosing(callback func() bool) {
+	w.onClosing = callback
+}
+
+func (w *windowImpl) setOnDPIChange(callback func(image.Point)) {
+	// The DPI is fixed on this platform, so the callback will never be
+	// called.
+	w.onDPIChange = callback
+}
+
+func (w *windowImpl) setOnResize(callback func(int, int) bool) {
+	w.onResize = callback
+}
+
+func (w *windowImpl) getSize() (int, int) {
+	return w.clientWidth, w.clientHeight
+}
+
+func (w *windowImpl) setTitle(value string) error {
+	w.titleText = value
+	return nil
+}
+
+func (w *windowImpl) title() string {
+	return w.titleText
+}
+
+func (w *windowImpl) updateWindowMinSize() {
+	if w.child == nil {
+		return
+	}
+
+	// The client area is grown, if necessary, to fit the contents, and then
+	// shrunk to respect the maximum size.
+	size := w.limitMinSize(w.MinSize())
+	if dx := size.Width.PixelsX(); w.clientWidth < dx {
+		w.clientWidth = dx
+	}
+	if dy := size.Height.PixelsY(); w.clientHeight < dy {
+		w.clientHeight = dy
+	}
+	limit := w.maxPixels(size)
+	if limit.X > 0 && w.clientWidth > limit.X {
+		w.clientWidth = limit.X
+	}
+	if limit.Y > 0 && w.clientHeight > limit.Y {
+		w.clientHeight = limit.Y
+	}
+}
+
+func (w *windowImpl) updateSizeLimits() {
+	w.updateWindowMinSize()
+	w.onSize()
+}
+
+func (w *windowImpl) setSize(width, height int) {
+	w.clientWidth, w.clientHeight = width, height
+	w.updateWindowMinSize()
+	w.onSize()
+}
+
+func (w *windowImpl) position() image.Point {
+	return w.origin
+}
+
+// isOnScreen returns true if the position is on a monitor.  There are no
+// monitors to check, so any position is accepted.
+func (w *windowImpl) isOnScreen(x, y int) bool {
+	return true
+}
+
+func (w *windowImpl) setPosition(x, y int) {
+	w.origin = image.Point{X: x, Y: y}
+	w.moved(w.origin)
+}
+
+func (w *windowImpl) setState(state WindowState) {
+	if state == w.windowState {
+		return
+	}
+
+	// Save the normal position and size, so that they can be restored.
+	if w.windowState == StateNormal {
+		w.restored = image.Rectangle{
+			Min: w.origin,
+			Max: w.origin.Add(image.Point{X: w.clientWidth, Y: w.clientHeight}),
+		}
+	}
+	w.windowState = state
+
+	switch state {
+	case StateNormal:
+		w.setPosition(w.restored.Min.X, w.restored.Min.Y)
+		w.setSize(w.restored.Dx(), w.restored.Dy())
+	case StateMaximized, StateFullscreen:
+		w.setPosition(0, 0)
+		w.setSize(screenSize.X, screenSize.Y)
+	}
+	w.minimizedChanged(state == StateMinimized)
+}
+
+func (w *windowImpl) state() WindowState {
+	return w.windowState
+}
+
+// containsTarget returns true if the focus target is within the window.
+func (w *windowImpl) containsTarget(target FocusTarget) bool {
+	node := targetNode(target)
+	return node != nil && node.IsWithin(w.root)
+}
+
+func (w *windowImpl) updateTabOrder() {
+	for _, v := range focusTargets {
+		if w.containsTarget(v.target) {
+			targetNode(v.target).TabIndex = v.tabIndex
+		}
+	}
+}
+
+func (w *windowImpl) updateCursor() {
+	// Do nothing.  There is no mouse cursor to update.
+}
+
+func (w *windowImpl) setBusy(busy bool) {
+	// Do nothing.  Input is only simulated.
+}
@@ -1,5 +1,5 @@
-//go:build go1.12
-// +build go1.12
+//go:build go1.12 && !headless
+// +build go1.12,!headless
 
 package windows
 
@@ -22,8 +22,39 @@ type windowImpl struct {
 	verticalScroll          bool
 	verticalScrollVisible   bool
 	onClosing               func() bool
+	onDPIChange             func(image.Point)
+	onResize                func(int, int) bool
+	toast                   *toast
+	layered                 bool
+	backdrop                js.Value
+	sizeLimits
+	persistence
+	windowEvents
+	cursorState
+	windowState WindowState // Only used by layered windows.
+	restored    [5]string   // Saved styles for the normal state.
+	pointer     image.Point // Last position of the mouse in the viewport.
+	busyOverlay js.Value    // Element blocking input while busy.
+	tabIndexed  []js.Value  // Elements with a tab index set by updateTabOrder.
+	listeners   []listener  // Event listeners to remove when closed.
 }
 
+// listener is an event listener added to an object outside of the window,
+// which must be removed when the window is closed.
+type listener struct {
+	target js.Value
+	event  string
+	fn     js.Func
+}
+
+// Styles saved for layered windows when they leave the normal state.
+var restoredStyles = [5]string{"left", "top", "width", "height", "transform"}
+
+var (
+	// Stacking order for the next layered window.
+	layeredZIndex = 100
+)
+
 func init() {
 	document := js.Global().Get("document")
 	head := document.Call("getElementsByTagName", "head").Index(0)
@@ -36,6 +67,29 @@ func init() {
 		position:absolute; visibility:hidden;
 		width:auto; height:auto;
 	}
+	.goey-window {
+		position:fixed; left:50%; top:50%; transform:translate(-50%,-50%);
+		max-width:100%; max-height:100%; overflow:hidden;
+		background-color:white; border:solid 1px rgb(222,226,230);
+		box-shadow:0 8px 24px rgba(0,0,0,0.3);
+	}
+	.goey-tool-window {
+		box-shadow:0 2px 8px rgba(0,0,0,0.3);
+	}
+	.goey-backdrop {
+		position:fixed; left:0; top:0; right:0; bottom:0;
+		background-color:rgba(0,0,0,0.3);
+	}
+	.goey-toast {
+		position:fixed; box-sizing:border-box; z-index:1000;
+		display:flex; align-items:center; justify-content:space-between;
+		padding:6px 16px; border-radius:4px;
+		color:white; background-color:rgb(50,50,50);
+	}
+	.goey-busy {
+		position:fixed; left:0; top:0; right:0; bottom:0;
+		z-index:2000; cursor:wait;
+	}
 	.goey-tabs-panel {
 		border-left: solid 1px rgb(222,226,230);
 		border-right: solid 1px rgb(222,226,230);
@@ -43,9 +97,60 @@ func init() {
 	}`)
 
 	head.Call("appendChild", style)
+
+	// Report changes in the keyboard focus to the window that contains the
+	// focused element.
+	document.Call("addEventListener", "focusin", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		focused := args[0].Get("target")
+		if owner := ownerWindow(focused); owner != nil {
+			owner.focusChanged(focusKey(func(target FocusTarget) bool {
+				handle := targetHandle(target)
+				return handle.Truthy() && handle.Call("contains", focused).Bool()
+			}))
+		}
+		return nil
+	}))
+
+	// Update the cursor as the mouse moves over regions of the window.
+	document.Call("addEventListener", "mousemove", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if owner := ownerWindow(args[0].Get("target")); owner != nil {
+			owner.pointer = image.Pt(args[0].Get("clientX").Int(), args[0].Get("clientY").Int())
+			owner.updateCursor()
+		}
+		return nil
+	}))
 }
 
-func newWindow(title string) (*Window, error) {
+// ownerWindow returns the window that contains the DOM element, if any.
+func ownerWindow(elem js.Value) *Window {
+	// Layered windows are contained within the page, so prefer them to the
+	// main window.
+	var owner *Window
+	for _, v := range All() {
+		if v.handle.Call("contains", elem).Bool() {
+			owner = v
+			if v.layered {
+				break
+			}
+		}
+	}
+	return owner
+}
+
+// targetHandle returns the DOM element for a focus target, if any.
+func targetHandle(target FocusTarget) js.Value {
+	if t, ok := target.(interface{ Handle() js.Value }); ok {
+		return t.Handle()
+	}
+	return js.Null()
+}
+
+func newWindow(title string, opts *windowOptions) (*Window, error) {
+	// Owned windows are shown as layers above the page.
+	if opts.owner != nil {
+		return newLayeredWindow(title, opts)
+	}
+
 	document := js.Global().Get("document")
 	document.Set("title", title)
 	handle := document.Call("getElementsByTagName", "body").Index(0)
@@ -68,22 +173,107 @@ func newWindow(title string) (*Window, error) {
 		return nil
 	}))
 
+	// The main window is active while the page has focus, and is treated as
+	// minimized while the page is hidden.
+	retval.addEventListener(js.Global().Get("window"), "focus", func() {
+		retval.activeChanged(true)
+	})
+	retval.addEventListener(js.Global().Get("window"), "blur", func() {
+		retval.activeChanged(false)
+	})
+	retval.addEventListener(document, "visibilitychange", func() {
+		retval.minimizedChanged(document.Get("hidden").Bool())
+	})
+
 	return retval, nil
 }
 
+func newLayeredWindow(title string, opts *windowOptions) (*Window, error) {
+	body := js.Global().Get("document").Call("getElementsByTagName", "body").Index(0)
+
+	zIndex := layeredZIndex
+	if opts.alwaysOnTop {
+		zIndex += 10000
+	}
+	layeredZIndex += 2
+
+	retval := &Window{windowImpl{
+		layered:  true,
+		backdrop: js.Null(),
+	}}
+
+	// Modal windows cover the page with a backdrop, which blocks any
+	// interaction with the owner.
+	if opts.modal {
+		retval.backdrop = goeyjs.CreateElement("div", "goey-backdrop")
+		retval.backdrop.Get("style").Set("zIndex", zIndex)
+		body.Call("appendChild", retval.backdrop)
+	}
+
+	className := "goey-window"
+	if opts.toolWindow {
+		className += " goey-tool-window"
+	}
+	handle := goeyjs.CreateElement("div", className)
+	handle.Call("setAttribute", "role", "dialog")
+	handle.Call("setAttribute", "aria-label", title)
+	style := handle.Get("style")
+	style.Set("zIndex", zIndex+1)
+	w, h := sizeDefaults()
+	style.Set("width", fmt.Sprintf("%dpx", w))
+	style.Set("height", fmt.Sprintf("%dpx", h))
+	body.Call("appendChild", handle)
+	retval.handle = handle
+
+	loop.AddLockCount(1)
+	return retval, nil
+}
+
+func (w *windowImpl) clientSize() base.Size {
+	if w.layered {
+		return base.Size{
+			base.FromPixelsX(w.handle.Get("clientWidth").Int()),
+			base.FromPixelsY(w.handle.Get("clientHeight").Int()),
+		}
+	}
+
+	return base.Size{
+		base.FromPixelsX(js.Global().Get("window").Get("innerWidth").Int()),
+		base.FromPixelsY(js.Global().Get("window").Get("innerHeight").Int()),
+	}
+}
+
 func (w *windowImpl) OnDeleteEvent() bool {
-	if w.onClosing == nil {
-		return false
+	if w.onClosing != nil && w.onClosing() {
+		return true
 	}
-	return w.onClosing()
+	w.saveState()
+	return false
 }
 
 func (w *windowImpl) control() base.Control {
 	return base.Control{w.handle}
 }
 
+// addEventListener adds an event listener to an object outside of the
+// window.  The listener is removed when the window is closed.
+func (w *windowImpl) addEventListener(target js.Value, event string, callback func()) {
+	fn := js.FuncOf(func(js.Value, []js.Value) interface{} {
+		callback()
+		return nil
+	})
+	target.Call("addEventListener", event, fn)
+	w.listeners = append(w.listeners, listener{target, event, fn})
+}
+
 func (w *windowImpl) close() {
 	if !w.handle.IsNull() {
+		for _, v := range w.listeners {
+			v.target.Call("removeEventListener", v.event, v.fn)
+			v.fn.Release()
+		}
+		w.listeners = nil
+
 		if w.child != nil {
 			document := js.Global().Get("document")
 			if ae := document.Get("activeElement"); ae.Truthy() {
@@ -93,6 +283,13 @@ func (w *windowImpl) close() {
 			w.child = nil
 		}
 
+		if w.layered {
+			w.handle.Call("remove")
+			if !w.backdrop.IsNull() {
+				w.backdrop.Call("remove")
+				w.backdrop = js.Null()
+			}
+		}
 		w.handle = js.Null()
 		loop.AddLockCount(-1)
 	}
@@ -105,10 +302,7 @@ func (w *windowImpl) onSize() {
 
 	// Get the client area size.
 	w.setDPI()
-	clientSize := base.Size{
-		base.FromPixelsX(js.Global().Get("window").Get("innerWidth").Int()),
-		base.FromPixelsY(js.Global().Get("window").Get("innerHeight").Int()),
-	}
+	clientSize := w.clientSize()
 
 	// Perform layout
 	size := w.layoutChild(clientSize)
@@ -116,6 +310,20 @@ func (w *windowImpl) onSize() {
 		base.Point{}, base.Point{size.Width, size.Height},
 	}
 	w.child.SetBounds(bounds)
+
+	// Keep any toast positioned along the bottom of the window.
+	if w.toast != nil {
+		w.toast.relayout()
+	}
+
+	if w.onResize != nil {
+		w.onResize(w.getSize())
+	}
+	w.sizeChanged(clientSize)
+}
+
+func (w *windowImpl) isClosed() bool {
+	return w.handle.IsNull()
 }
 
 func (w *windowImpl) setChildPost() {
@@ -180,6 +388,12 @@ func (w *windowImpl) showScrollV(height base.Length, clientHeight base.Length) b
 	return false
 }
 
+// screenshot is not supported, since browsers do not allow pages to read back
+// how they have been rendered.
+func (*windowImpl) screenshot() (image.Image, image.Point, error) {
+	return nil, image.Point{}, ErrNotSupported
+}
+
 func (_ *windowImpl) setDPI() {
 	base.DPI.X, base.DPI.Y = 96, 96
 }
@@ -208,17 +422,45 @@ func (w *windowImpl) setOnClosing(callback func() bool) {
 	w.onClosing = callback
 }
 
+func (w *windowImpl) setOnDPIChange(callback func(image.Point)) {
+	// The DPI is fixed on this platform, so the callback will never be
+	// called.
+	w.onDPIChange = callback
+}
+
+func (w *windowImpl) setOnResize(callback func(int, int) bool) {
+	w.onResize = callback
+}
+
+func (w *windowImpl) getSize() (int, int) {
+	if w.layered {
+		return w.handle.Get("clientWidth").Int(), w.handle.Get("clientHeight").Int()
+	}
+
+	window := js.Global().Get("window")
+	return window.Get("innerWidth").Int(), window.Get("innerHeight").Int()
+}
+
 func (w *windowImpl) setTitle(value string) error {
+	if w.layered {
+		w.handle.Call("setAttribute", "aria-label", value)
+		return nil
+	}
+
 	js.Global().Get("document").Set("title", value)
 	return nil
 }
 
 func (w *windowImpl) title() string {
+	if w.layered {
+		return w.handle.Call("getAttribute", "aria-label").String()
+	}
+
 	return js.Global().Get("document").Get("title").String()
 }
 
 func (w *windowImpl) updateWindowMinSize() {
-	size := w.MinSize()
+	size := w.limitMinSize(w.MinSize())
 
 	dx := size.Width.PixelsX()
 	dy := size.Height.PixelsY()
@@ -234,4 +476,176 @@ func (w *windowImpl) updateWindowMinSize() {
 	style := js.Global().Get("document").Call("getElementsByTagName", "body").Index(0).Get("style")
 	style.Set("minWidth", fmt.Sprintf("%dpx", dx))
 	style.Set("minHeight", fmt.Sprintf("%dpx", dy))
+
+	// Only layered windows have a size that can be limited.  Clearing the
+	// style restores the limit from the stylesheet.
+	if w.layered {
+		limit := w.maxPixels(size)
+		style := w.handle.Get("style")
+		style.Set("maxWidth", pixelsOrDefault(limit.X))
+		style.Set("maxHeight", pixelsOrDefault(limit.Y))
+	}
+}
+
+func pixelsOrDefault(value int) string {
+	if value <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%dpx", value)
+}
+
+func (w *windowImpl) updateSizeLimits() {
+	w.updateWindowMinSize()
+	w.onSize()
+}
+
+func (w *windowImpl) setSize(width, height int) {
+	// The main window fills the page, and cannot be resized.
+	if !w.layered {
+		return
+	}
+
+	style := w.handle.Get("style")
+	style.Set("width", fmt.Sprintf("%dpx", width))
+	style.Set("height", fmt.Sprintf("%dpx", height))
+	w.onSize()
+}
+
+func (w *windowImpl) position() image.Point {
+	if !w.layered {
+		return image.Point{}
+	}
+
+	rect := w.handle.Call("getBoundingClientRect")
+	return image.Point{X: rect.Get("left").Int(), Y: rect.Get("top").Int()}
+}
+
+// isOnScreen returns true if the position is within the page's viewport.
+func (w *windowImpl) isOnScreen(x, y int) bool {
+	window := js.Global().Get("window")
+	return x >= 0 && y >= 0 && x < window.Get("innerWidth").Int() && y < window.Get("innerHeight").Int()
+}
+
+func (w *windowImpl) setPosition(x, y int) {
+	// The main window fills the page, and cannot be moved.
+	if !w.layered {
+		return
+	}
+
+	style := w.handle.Get("style")
+	style.Set("left", fmt.Sprintf("%dpx", x))
+	style.Set("top", fmt.Sprintf("%dpx", y))
+	style.Set("transform", "none")
+	w.moved(w.position())
+}
+
+func (w *windowImpl) setState(state WindowState) {
+	if !w.layered {
+		// The page can only be made fullscreen.
+		document := js.Global().Get("document")
+		if state == StateFullscreen {
+			document.Get("documentElement").Call("requestFullscreen")
+		} else if document.Get("fullscreenElement").Truthy() {
+			document.Call("exitFullscreen")
+		}
+		return
+	}
+
+	if state == w.windowState {
+		return
+	}
+
+	// Save the normal position and size, so that they can be restored.
+	style := w.handle.Get("style")
+	if w.windowState == StateNormal {
+		for i, v := range restoredStyles {
+			w.restored[i] = style.Get(v).String()
+		}
+	}
+	w.windowState = state
+
+	style.Set("display", "")
+	switch state {
+	case StateNormal:
+		for i, v := range restoredStyles {
+			style.Set(v, w.restored[i])
+		}
+	case StateMinimized:
+		style.Set("display", "none")
+	case StateMaximized, StateFullscreen:
+		style.Set("left", "0")
+		style.Set("top", "0")
+		style.Set("width", "100%")
+		style.Set("height", "100%")
+		style.Set("transform", "none")
+	}
+	w.onSize()
+	w.minimizedChanged(state == StateMinimized)
+	if state != StateMinimized {
+		w.moved(w.position())
+	}
+}
+
+func (w *windowImpl) state() WindowState {
+	if !w.layered {
+		if js.Global().Get("document").Get("fullscreenElement").Truthy() {
+			return StateFullscreen
+		}
+		return StateNormal
+	}
+
+	return w.windowState
+}
+
+// containsTarget returns true if the focus target is within the window.
+func (w *windowImpl) containsTarget(target FocusTarget) bool {
+	handle := targetHandle(target)
+	return handle.Truthy() && w.handle.Call("contains", handle).Bool()
+}
+
+func (w *windowImpl) updateTabOrder() {
+	// Clear any overrides from a previous update, in case the tab index has
+	// been removed, or the target has been unregistered.
+	for _, v := range w.tabIndexed {
+		v.Call("removeAttribute", "tabindex")
+	}
+	w.tabIndexed = w.tabIndexed[:0]
+
+	for _, v := range focusTargets {
+		if v.tabIndex != 0 && w.containsTarget(v.target) {
+			handle := targetHandle(v.target)
+			handle.Set("tabIndex", v.tabIndex)
+			w.tabIndexed = append(w.tabIndexed, handle)
+		}
+	}
+}
+
+func (w *windowImpl) updateCursor() {
+	cursor := w.cursorAt(func(parent base.Control, bounds base.Rectangle) bool {
+		if !w.handle.Call("contains", parent.Handle).Bool() {
+			return false
+		}
+		rect := parent.Handle.Call("getBoundingClientRect")
+		origin := image.Pt(rect.Get("left").Int(), rect.Get("top").Int())
+		return w.pointer.Sub(origin).In(bounds.Pixels())
+	})
+
+	if cursor == CursorDefault {
+		w.handle.Get("style").Set("cursor", "")
+	} else {
+		w.handle.Get("style").Set("cursor", cursor.String())
+	}
+}
+
+func (w *windowImpl) setBusy(busy bool) {
+	// An overlay covering the window blocks mouse input, and shows the
+	// wait cursor.
+	if busy {
+		w.busyOverlay = js.Global().Get("document").Call("createElement", "div")
+		w.busyOverlay.Set("className", "goey-busy")
+		w.handle.Call("appendChild", w.busyOverlay)
+	} else if w.busyOverlay.Truthy() {
+		w.handle.Call("removeChild", w.busyOverlay)
+		w.busyOverlay = js.Undefined()
+	}
 }
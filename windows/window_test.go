@@ -72,7 +72,7 @@ func TestNewWindow(t *testing.T) {
 	}{
 		{nil, nil},
 		{&mock.Widget{}, nil},
-		{&mock.Widget{Size: base.Size{base.DIP.Scale(16*1024, 96), base.DIP.Scale(16*1024, 96)}}, nil}, // 16k pixels by 16k pixels
+		{&mock.Widget{Size: base.Size{Width: base.DIP.Scale(16*1024, 96), Height: base.DIP.Scale(16*1024, 96)}}, nil}, // 16k pixels by 16k pixels
 		{&mock.Widget{Err: errSentinel}, errSentinel},
 	}
 
@@ -141,6 +141,67 @@ func TestNewWindow(t *testing.T) {
 	})
 }
 
+func TestNewWindow_Options(t *testing.T) {
+	createWindow := func() error {
+		owner, err := windows.NewWindow(t.Name(), nil)
+		if err != nil {
+			return err
+		}
+		closed, err := windows.NewWindow(t.Name(), nil)
+		if err != nil {
+			return err
+		}
+		closed.Close()
+
+		cases := []struct {
+			options []windows.WindowOption
+			ok      bool
+		}{
+			{nil, true},
+			{[]windows.WindowOption{windows.WithOwner(owner)}, true},
+			{[]windows.WindowOption{windows.WithOwner(owner), windows.WithModal()}, true},
+			{[]windows.WindowOption{windows.WithToolWindow()}, true},
+			{[]windows.WindowOption{windows.WithAlwaysOnTop()}, true},
+			{[]windows.WindowOption{windows.WithOwner(owner), windows.WithToolWindow(), windows.WithAlwaysOnTop()}, true},
+			{[]windows.WindowOption{windows.WithModal()}, false},
+			{[]windows.WindowOption{windows.WithOwner(closed)}, false},
+		}
+
+		for i, v := range cases {
+			mw, err := windows.NewWindow(t.Name(), &mock.Widget{}, v.options...)
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d, want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+			if mw == nil {
+				continue
+			}
+
+			if all := windows.All(); len(all) != 2 || all[0] != owner || all[1] != mw {
+				t.Errorf("Case %d, unexpected list of windows, %v", i, all)
+			}
+			mw.Close()
+		}
+
+		if all := windows.All(); len(all) != 1 || all[0] != owner {
+			t.Errorf("Unexpected list of windows, %v", all)
+		}
+		owner.Close()
+		if all := windows.All(); len(all) != 0 {
+			t.Errorf("Unexpected list of windows, %v", all)
+		}
+
+		return nil
+	}
+
+	err := loop.Run(createWindow)
+	if err != nil {
+		t.Fatalf("Failed to run event loop, %s", err)
+	}
+}
+
 func testingWindow(t *testing.T, action func(*testing.T, *windows.Window)) {
 	createWindow := func() error {
 		// Create the window.  Some of the tests here are not expected in
@@ -190,18 +251,18 @@ func TestWindow_MinSize(t *testing.T) {
 		hscroll, vscroll bool
 		minSize          base.Size
 	}{
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10 * base.DIP}}, false, false, base.Size{10 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10 * base.DIP}}, false, true, base.Size{10 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10 * base.DIP}}, true, false, base.Size{10 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10 * base.DIP}}, true, true, base.Size{10 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10000 * base.DIP, 10 * base.DIP}}, false, false, base.Size{10000 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10000 * base.DIP, 10 * base.DIP}}, false, true, base.Size{10000 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10000 * base.DIP, 10 * base.DIP}}, true, false, base.Size{120 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10000 * base.DIP, 10 * base.DIP}}, true, true, base.Size{120 * base.DIP, 10 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10000 * base.DIP}}, false, false, base.Size{10 * base.DIP, 10000 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10000 * base.DIP}}, false, true, base.Size{10 * base.DIP, 120 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10000 * base.DIP}}, true, false, base.Size{10 * base.DIP, 10000 * base.DIP}},
-		{&mock.Widget{Size: base.Size{10 * base.DIP, 10000 * base.DIP}}, true, true, base.Size{10 * base.DIP, 120 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}}, false, false, base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}}, false, true, base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}}, true, false, base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}}, true, true, base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10000 * base.DIP, Height: 10 * base.DIP}}, false, false, base.Size{Width: 10000 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10000 * base.DIP, Height: 10 * base.DIP}}, false, true, base.Size{Width: 10000 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10000 * base.DIP, Height: 10 * base.DIP}}, true, false, base.Size{Width: 120 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10000 * base.DIP, Height: 10 * base.DIP}}, true, true, base.Size{Width: 120 * base.DIP, Height: 10 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10000 * base.DIP}}, false, false, base.Size{Width: 10 * base.DIP, Height: 10000 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10000 * base.DIP}}, false, true, base.Size{Width: 10 * base.DIP, Height: 120 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10000 * base.DIP}}, true, false, base.Size{Width: 10 * base.DIP, Height: 10000 * base.DIP}},
+		{&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10000 * base.DIP}}, true, true, base.Size{Width: 10 * base.DIP, Height: 120 * base.DIP}},
 	}
 
 	for i, v := range cases {
@@ -228,6 +289,106 @@ func TestWindow_MinSize(t *testing.T) {
 	}
 }
 
+func TestWindow_Geometry(t *testing.T) {
+	testingWindow(t, func(t *testing.T, mw *windows.Window) {
+		err := loop.Do(func() error {
+			if err := mw.SetChild(&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}}); err != nil {
+				t.Errorf("failed to set child: %s", err)
+				return nil
+			}
+
+			mw.SetSize(320, 240)
+			if w, h := mw.GetSize(); w != 320 || h != 240 {
+				t.Errorf("incorrect size, want 320x240, got %dx%d", w, h)
+			}
+
+			mw.SetPosition(40, 30)
+			if got := mw.Position(); got != image.Pt(40, 30) {
+				t.Errorf("incorrect position, want %v, got %v", image.Pt(40, 30), got)
+			}
+
+			mw.SetMinSize(base.Size{Width: 400 * base.DIP, Height: 0})
+			if w, _ := mw.GetSize(); w < (400 * base.DIP).PixelsX() {
+				t.Errorf("size does not respect minimum, got width %d", w)
+			}
+			mw.SetMinSize(base.Size{})
+
+			mw.SetMaxSize(base.Size{Width: 200 * base.DIP, Height: 200 * base.DIP})
+			mw.SetSize(640, 480)
+			if w, h := mw.GetSize(); w > (200*base.DIP).PixelsX() || h > (200*base.DIP).PixelsY() {
+				t.Errorf("size does not respect maximum, got %dx%d", w, h)
+			}
+			mw.SetMaxSize(base.Size{})
+
+			mw.Maximize()
+			if got := mw.State(); got != windows.StateMaximized {
+				t.Errorf("incorrect state, want %s, got %s", windows.StateMaximized, got)
+			}
+			mw.Restore()
+			if got := mw.State(); got != windows.StateNormal {
+				t.Errorf("incorrect state, want %s, got %s", windows.StateNormal, got)
+			}
+			mw.SetFullscreen(true)
+			if got := mw.State(); got != windows.StateFullscreen {
+				t.Errorf("incorrect state, want %s, got %s", windows.StateFullscreen, got)
+			}
+			mw.SetFullscreen(false)
+			if got := mw.State(); got != windows.StateNormal {
+				t.Errorf("incorrect state, want %s, got %s", windows.StateNormal, got)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Errorf("failed loop.Do: %s", err)
+		}
+	})
+}
+
+func TestWindow_Events(t *testing.T) {
+	testingWindow(t, func(t *testing.T, mw *windows.Window) {
+		err := loop.Do(func() error {
+			if err := mw.SetChild(&mock.Widget{Size: base.Size{Width: 10 * base.DIP, Height: 10 * base.DIP}}); err != nil {
+				t.Errorf("failed to set child: %s", err)
+				return nil
+			}
+
+			log := []string(nil)
+			mw.SetOnMinimize(func() {
+				log = append(log, "minimize")
+			})
+			mw.SetOnRestore(func() {
+				log = append(log, "restore")
+			})
+			mw.SetOnMove(func(pt image.Point) {
+				log = append(log, fmt.Sprintf("move %d,%d", pt.X, pt.Y))
+			})
+			sizes := 0
+			mw.SetOnSizeChange(func(size base.Size) {
+				sizes++
+			})
+
+			mw.SetPosition(40, 30)
+			mw.SetSize(320, 240)
+			mw.Minimize()
+			mw.Restore()
+
+			want := []string{"move 40,30", "minimize", "restore"}
+			if fmt.Sprint(log) != fmt.Sprint(want) {
+				t.Errorf("incorrect events, want %v, got %v", want, log)
+			}
+			if sizes == 0 {
+				t.Errorf("size change not reported")
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Errorf("failed loop.Do: %s", err)
+		}
+	})
+}
+
 func makeImage(t *testing.T, index int) image.Image {
 	colors := [3]color.RGBA{
 		{255, 0, 0, 255},
@@ -242,6 +403,32 @@ func makeImage(t *testing.T, index int) image.Image {
 	return img
 }
 
+func TestWindow_SetCursor(t *testing.T) {
+	testingWindow(t, func(t *testing.T, mw *windows.Window) {
+		err := loop.Do(func() error {
+			for _, v := range []windows.Cursor{windows.CursorText, windows.CursorResizeVertical, windows.CursorDefault} {
+				mw.SetCursor(v)
+				if got := mw.Cursor(); got != v {
+					t.Errorf("incorrect cursor, want %s, got %s", v, got)
+				}
+			}
+
+			mw.SetBusy(true)
+			if !mw.IsBusy() {
+				t.Errorf("window not busy")
+			}
+			mw.SetBusy(false)
+			if mw.IsBusy() {
+				t.Errorf("window still busy")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("failed loop.Do: %s", err)
+		}
+	})
+}
+
 func TestWindow_SetIcon(t *testing.T) {
 	testingWindow(t, func(t *testing.T, mw *windows.Window) {
 		for i := 0; i < 6; i++ {
@@ -258,6 +445,56 @@ func TestWindow_SetIcon(t *testing.T) {
 	})
 }
 
+func TestWindow_Screenshot(t *testing.T) {
+	testingWindow(t, func(t *testing.T, mw *windows.Window) {
+		err := loop.Do(func() error {
+			if err := mw.SetChild(&mock.Widget{Size: base.Size{Width: 40 * base.DIP, Height: 30 * base.DIP}}); err != nil {
+				t.Errorf("failed to set child: %s", err)
+				return nil
+			}
+
+			img, err := mw.Screenshot()
+			if err == windows.ErrNotSupported {
+				// Can't call t.Skip from the GUI thread.
+				t.Log("Screenshots are not supported")
+				return nil
+			} else if err != nil {
+				t.Errorf("failed to take screenshot: %s", err)
+				return nil
+			}
+			if img.Bounds().Empty() {
+				t.Errorf("empty screenshot")
+			}
+
+			// Crop the screenshot to part of the client area.
+			bounds := base.Rect(0, 0, 10*base.DIP, 10*base.DIP)
+			img, err = mw.ScreenshotRect(bounds)
+			if err != nil {
+				t.Errorf("failed to take screenshot: %s", err)
+			} else if got, want := img.Bounds(), image.Rect(0, 0, bounds.Dx().PixelsX(), bounds.Dy().PixelsY()); got != want {
+				t.Errorf("incorrect bounds, want %v, got %v", want, got)
+			}
+
+			// Crop the screenshot to the child.
+			child := mw.Child()
+			img, err = mw.SnapshotElement(child)
+			if err != nil {
+				t.Errorf("failed to take snapshot: %s", err)
+			} else if got, want := img.Bounds().Size(), child.(windows.Bounder).Bounds().Pixels().Size(); got != want {
+				t.Errorf("incorrect size, want %v, got %v", want, got)
+			}
+			if _, err := mw.SnapshotElement(struct{ base.Element }{child}); err != windows.ErrNoBounds {
+				t.Errorf("unexpected error, want %v, got %v", windows.ErrNoBounds, err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Errorf("failed loop.Do: %s", err)
+		}
+	})
+}
+
 func TestWindow_SetScroll(t *testing.T) {
 	testingWindow(t, func(t *testing.T, mw *windows.Window) {
 		cases := []struct {
@@ -307,3 +544,41 @@ func TestWindow_SetTitle(t *testing.T) {
 		}
 	})
 }
+
+func TestWindow_ShowToast(t *testing.T) {
+	testingWindow(t, func(t *testing.T, mw *windows.Window) {
+		cases := []struct {
+			text   string
+			action *windows.ToastAction
+			ok     bool
+		}{
+			{"Saved", nil, true},
+			{"Connection lost, retrying", &windows.ToastAction{Label: "Retry", OnAction: func() {}}, true},
+			{"", nil, false},
+			{"  ", nil, false},
+			{"Saved", &windows.ToastAction{}, false},
+		}
+
+		for i, v := range cases {
+			err := loop.Do(func() error {
+				return mw.ShowToast(v.text, 100*time.Millisecond, v.action)
+			})
+			if got := err == nil; got != v.ok {
+				t.Errorf("Case %d, want %v, got %v", i, v.ok, got)
+				if err != nil {
+					t.Logf("Error: %s", err)
+				}
+			}
+			// Allow some time for the toast to animate.
+			time.Sleep(50 * time.Millisecond)
+		}
+
+		// Closing the window with a visible toast should release the toast.
+		err := loop.Do(func() error {
+			return mw.ShowToast("Closing", 0, nil)
+		})
+		if err != nil {
+			t.Errorf("Error calling ShowToast, %s", err)
+		}
+	})
+}
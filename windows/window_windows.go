@@ -1,3 +1,6 @@
+//go:build !headless
+// +build !headless
+
 package windows
 
 import (
@@ -15,6 +18,7 @@ import (
 var (
 	className = []uint16{'G', 'o', 'e', 'y', 'M', 'a', 'i', 'n', 'W', 'i', 'n', 'd', 'o', 'w', 0}
 	classAtom win.ATOM
+	focusHook win.HWINEVENTHOOK
 )
 
 type windowImpl struct {
@@ -22,17 +26,29 @@ type windowImpl struct {
 	dpi                     image.Point
 	windowRectDelta         image.Point
 	windowMinSize           image.Point
+	windowMaxSize           image.Point
 	hicon                   win.HICON
 	child                   base.Element
 	childSize               base.Size
 	onClosing               func() bool
+	onDPIChange             func(image.Point)
 	onResize                func(int, int) bool
+	onCancel                func()
 	horizontalScroll        bool
 	horizontalScrollVisible bool
 	horizontalScrollPos     base.Length
 	verticalScroll          bool
 	verticalScrollVisible   bool
 	verticalScrollPos       base.Length
+	toast                   *toast
+	modalOwner              win.HWND
+	sizeLimits
+	persistence
+	windowEvents
+	cursorState
+	fullscreen     bool
+	savedStyle     int32
+	savedPlacement win.WINDOWPLACEMENT
 }
 
 func registerMainWindowClass() (win.ATOM, error) {
@@ -118,15 +134,22 @@ func (w *windowImpl) onSize(hwnd win.HWND) {
 		Max: base.Point{size.Width - w.horizontalScrollPos, size.Height - w.verticalScrollPos},
 	})
 
+	// Keep any toast positioned along the bottom of the window, and above
+	// the child.
+	if w.toast != nil {
+		w.toast.relayout()
+	}
+
 	// Update the position of all of the children
 	win.InvalidateRect(hwnd, &rect, true)
 
 	if w.onResize != nil {
 		w.onResize(int(rect.Right-rect.Left), int(rect.Bottom-rect.Top))
 	}
+	w.sizeChanged(clientSize)
 }
 
-func newWindow(title string) (*Window, error) {
+func newWindow(title string, opts *windowOptions) (*Window, error) {
 	//GetStartupInfo(&info);
 	if win.OleInitialize() != win.S_OK {
 		return nil, syscall.GetLastError()
@@ -144,6 +167,16 @@ func newWindow(title string) (*Window, error) {
 		classAtom = atom
 	}
 
+	// Ensure that changes in the keyboard focus are reported.
+	if focusHook == 0 {
+		hook, err := win.SetWinEventHook(win.EVENT_OBJECT_FOCUS, win.EVENT_OBJECT_FOCUS, 0,
+			focusEventProc, 0, win.GetCurrentThreadId(), win.WINEVENT_OUTOFCONTEXT)
+		if err != nil {
+			return nil, err
+		}
+		focusHook = hook
+	}
+
 	style := uint32(win.WS_OVERLAPPEDWINDOW | win.WS_CLIPCHILDREN)
 	//if !settings.Resizable {
 	//	style = win.WS_OVERLAPPED | win.WS_CAPTION | win.WS_MINIMIZEBOX | win.WS_SYSMENU
@@ -171,9 +204,21 @@ func newWindow(title string) (*Window, error) {
 	if err != nil {
 		return nil, err
 	}
-	hwnd := win.CreateWindowEx(win.WS_EX_CONTROLPARENT|win2.WS_EX_COMPOSITED, &className[0], windowName, style,
+	exStyle := uint32(win.WS_EX_CONTROLPARENT | win2.WS_EX_COMPOSITED)
+	if opts.toolWindow {
+		exStyle |= win.WS_EX_TOOLWINDOW
+	}
+	if opts.alwaysOnTop {
+		exStyle |= win.WS_EX_TOPMOST
+	}
+	// For overlapped windows, the parent is the owner.
+	owner := win.HWND(win.HWND_DESKTOP)
+	if opts.owner != nil {
+		owner = opts.owner.Hwnd
+	}
+	hwnd := win.CreateWindowEx(exStyle, &className[0], windowName, style,
 		rect.Left, rect.Top, rect.Right-rect.Left, rect.Bottom-rect.Top,
-		win.HWND_DESKTOP, 0, 0, nil)
+		owner, 0, 0, nil)
 	if hwnd == 0 {
 		win.OleUninitialize()
 		return nil, syscall.GetLastError()
@@ -200,6 +245,12 @@ func newWindow(title string) (*Window, error) {
 	retval.windowRectDelta.X = int((windowRect.Right - windowRect.Left) - (clientRect.Right - clientRect.Left))
 	retval.windowRectDelta.Y = int((windowRect.Bottom - windowRect.Top) - (clientRect.Bottom - clientRect.Top))
 
+	// Modal windows disable their owner until they are closed.
+	if opts.modal {
+		retval.modalOwner = owner
+		win.EnableWindow(owner, false)
+	}
+
 	return retval, nil
 }
 
@@ -211,6 +262,8 @@ func (w *windowImpl) close() {
 	// Want to be able to close windows in Go, even if they have already been
 	// destroyed in the Win32 system
 	if w.Hwnd != 0 {
+		w.enableModalOwner()
+
 		// There is a heseinbug with the kill focus message when destroying
 		// windows.  To get consistent behavior, we can remove focus before
 		// destroying the window.
@@ -242,18 +295,48 @@ func (w *windowImpl) message(m *dialog.Message) {
 	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
 }
 
+func (w *windowImpl) question(m *dialog.Question) {
+	m.WithTitle(win2.GetWindowText(w.Hwnd))
+	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
+}
+
+func (w *windowImpl) prompt(m *dialog.Prompt) {
+	m.WithTitle(win2.GetWindowText(w.Hwnd))
+	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
+}
+
+func (w *windowImpl) colorchooser(m *dialog.ColorChooser) {
+	m.WithTitle(win2.GetWindowText(w.Hwnd))
+	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
+}
+
+func (w *windowImpl) fontchooser(m *dialog.FontChooser) {
+	m.WithTitle(win2.GetWindowText(w.Hwnd))
+	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
+}
+
+func (w *windowImpl) progress(m *dialog.Progress) {
+	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
+}
+
 func (w *windowImpl) openfiledialog(m *dialog.OpenFile) {
 	m.WithTitle(win2.GetWindowText(w.Hwnd))
 	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
 }
 
+func (w *windowImpl) openfolderdialog(m *dialog.OpenFolder) {
+	m.WithTitle(win2.GetWindowText(w.Hwnd))
+	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
+}
+
 func (w *windowImpl) savefiledialog(m *dialog.SaveFile) {
 	m.WithTitle(win2.GetWindowText(w.Hwnd))
 	m.WithOwner(dialog.Owner{HWnd: w.Hwnd})
 }
 
-// Screenshot returns an image of the window, as displayed on screen.
-func (w *windowImpl) Screenshot() (image.Image, error) {
+// screenshot returns an image of the window, as displayed on screen, and the
+// position of the client area within the image.
+func (w *windowImpl) screenshot() (image.Image, image.Point, error) {
 	// Need the client rect for the window.
 	region := win.RECT{}
 	win.GetWindowRect(w.Hwnd, &region)
@@ -280,12 +363,70 @@ func (w *windowImpl) Screenshot() (image.Image, error) {
 		if err == nil {
 			err = syscall.EINVAL
 		}
-		return nil, err
+		return nil, image.Point{}, err
 	}
 
+	// Find the offset of the client area from the window's frame.
+	origin := win.POINT{}
+	win.ClientToScreen(w.Hwnd, &origin)
+
 	// Convert the bitmap to a image.Image.
 	img := win2.BitmapToImage(hdc, bitmap)
-	return img, nil
+	return img, image.Pt(int(origin.X-region.Left), int(origin.Y-region.Top)), nil
+}
+
+// isClosed returns true if the window has been destroyed.
+func (w *windowImpl) isClosed() bool {
+	return w.Hwnd == 0
+}
+
+func (w *windowImpl) setDialog(owner *Window, onCancel func()) {
+	w.onCancel = onCancel
+
+	// Dialogs cannot be minimized or maximized.
+	style := win.GetWindowLong(w.Hwnd, win.GWL_STYLE)
+	win.SetWindowLong(w.Hwnd, win.GWL_STYLE, style&^(win.WS_MINIMIZEBOX|win.WS_MAXIMIZEBOX))
+	if owner != nil {
+		win.SetWindowLongPtr(w.Hwnd, win.GWLP_HWNDPARENT, uintptr(owner.Hwnd))
+	}
+}
+
+func (w *windowImpl) showModal(owner *Window) {
+	// Size the dialog to fit its contents, and then center the dialog over
+	// its owner.
+	w.updateWindowMinSize()
+	rect := win.RECT{}
+	if owner != nil {
+		win.GetWindowRect(owner.Hwnd, &rect)
+	} else {
+		win.GetClientRect(win2.GetDesktopWindow(), &rect)
+	}
+	dx, dy := int32(w.windowMinSize.X), int32(w.windowMinSize.Y)
+	win.SetWindowPos(w.Hwnd, 0, (rect.Left+rect.Right-dx)/2, (rect.Top+rect.Bottom-dy)/2, dx, dy,
+		win.SWP_NOZORDER|win.SWP_NOACTIVATE)
+
+	if owner != nil {
+		win.EnableWindow(owner.Hwnd, false)
+	}
+	w.show()
+}
+
+func (w *windowImpl) endModal(owner *Window) {
+	// The owner needs to be enabled before the dialog is destroyed.
+	// Otherwise, windows will activate a different application.
+	if owner != nil && owner.Hwnd != 0 {
+		win.EnableWindow(owner.Hwnd, true)
+	}
+}
+
+// enableModalOwner re-enables the owner of a modal window.  The owner needs to
+// be enabled before the window is destroyed.  Otherwise, windows will
+// activate a different application.
+func (w *windowImpl) enableModalOwner() {
+	if w.modalOwner != 0 {
+		win.EnableWindow(w.modalOwner, true)
+		w.modalOwner = 0
+	}
 }
 
 // setChild updates the child element of the window.  It also updates any
@@ -490,6 +631,12 @@ func (w *windowImpl) setOnClosing(callback func() bool) {
 	w.onClosing = callback
 }
 
+func (w *windowImpl) setOnDPIChange(callback func(image.Point)) {
+	// The DPI is not tracked after the window is created, so the callback
+	// will never be called.
+	w.onDPIChange = callback
+}
+
 func (w *windowImpl) setTitle(value string) error {
 	_, err := win2.SetWindowText(w.Hwnd, value)
 	return err
@@ -501,31 +648,157 @@ func (w *windowImpl) title() string {
 
 func (w *windowImpl) updateWindowMinSize() {
 	// Determine the minimum client area size.
-	size := w.MinSize()
+	size := w.limitMinSize(w.MinSize())
 
 	dx := size.Width.PixelsX()
 	dy := size.Height.PixelsY()
 
 	// Adjust the size to include space for scrollbars.
+	extra := w.windowRectDelta
 	if w.verticalScroll {
 		// Want to include space for the scroll bar in the minimum width.
 		// If the scrollbar is already visible, it will already be part
 		// of the calculation through the difference in the window and client rectangles.
-		dx += int(win.GetSystemMetrics(win.SM_CXVSCROLL))
+		extra.X += int(win.GetSystemMetrics(win.SM_CXVSCROLL))
 	}
 	if w.horizontalScroll {
-		dy += int(win.GetSystemMetrics(win.SM_CYHSCROLL))
+		extra.Y += int(win.GetSystemMetrics(win.SM_CYHSCROLL))
 	}
 
 	// Determine the extra width and height required for borders, title bar,
 	// and scrollbars
-	dx += w.windowRectDelta.X
-	dy += w.windowRectDelta.Y
+	w.windowMinSize.X = dx + extra.X
+	w.windowMinSize.Y = dy + extra.Y
+
+	// The maximum size, if any, also needs to include the borders.
+	w.windowMaxSize = w.maxPixels(size)
+	if w.windowMaxSize.X > 0 {
+		w.windowMaxSize.X += extra.X
+	}
+	if w.windowMaxSize.Y > 0 {
+		w.windowMaxSize.Y += extra.Y
+	}
+}
+
+func (w *windowImpl) updateSizeLimits() {
+	w.updateWindowMinSize()
+
+	// Resize the window, if necessary, to respect the new limits.
+	width, height := w.getSize()
+	w.setSize(width, height)
+}
 
-	w.windowMinSize.X = dx
-	w.windowMinSize.Y = dy
+func (w *windowImpl) setSize(width, height int) {
+	if w.windowMinSize.X == 0 {
+		w.updateWindowMinSize()
+	}
+
+	// Convert the size of the client area to the size of the window.
+	dx := width + w.windowRectDelta.X
+	dy := height + w.windowRectDelta.Y
+	if dx < w.windowMinSize.X {
+		dx = w.windowMinSize.X
+	}
+	if dy < w.windowMinSize.Y {
+		dy = w.windowMinSize.Y
+	}
+	if limit := w.windowMaxSize.X; limit > 0 && dx > limit {
+		dx = limit
+	}
+	if limit := w.windowMaxSize.Y; limit > 0 && dy > limit {
+		dy = limit
+	}
+
+	win.SetWindowPos(w.Hwnd, 0, 0, 0, int32(dx), int32(dy),
+		win.SWP_NOMOVE|win.SWP_NOZORDER|win.SWP_NOACTIVATE)
 }
 
+func (w *windowImpl) position() image.Point {
+	rect := win.RECT{}
+	win.GetWindowRect(w.Hwnd, &rect)
+	return image.Point{X: int(rect.Left), Y: int(rect.Top)}
+}
+
+// isOnScreen returns true if a window at the position would be visible on a
+// monitor.  An area at the top-left of the window is checked, since the
+// position includes invisible borders that may lie off the monitor.
+func (w *windowImpl) isOnScreen(x, y int) bool {
+	rect := win.RECT{Left: int32(x), Top: int32(y), Right: int32(x) + 64, Bottom: int32(y) + 16}
+	return win2.MonitorFromRect(&rect, win.MONITOR_DEFAULTTONULL) != 0
+}
+
+func (w *windowImpl) setPosition(x, y int) {
+	win.SetWindowPos(w.Hwnd, 0, int32(x), int32(y), 0, 0,
+		win.SWP_NOSIZE|win.SWP_NOZORDER|win.SWP_NOACTIVATE)
+}
+
+func (w *windowImpl) setState(state WindowState) {
+	if w.fullscreen && state != StateFullscreen {
+		w.leaveFullscreen()
+	}
+
+	switch state {
+	case StateNormal:
+		if win.IsIconic(w.Hwnd) || win.IsZoomed(w.Hwnd) {
+			win.ShowWindow(w.Hwnd, win.SW_RESTORE)
+		}
+	case StateMinimized:
+		win.ShowWindow(w.Hwnd, win.SW_MINIMIZE)
+	case StateMaximized:
+		win.ShowWindow(w.Hwnd, win.SW_MAXIMIZE)
+	case StateFullscreen:
+		w.enterFullscreen()
+	}
+}
+
+// enterFullscreen removes the window's borders and title bar, and resizes the
+// window to cover its monitor.  The previous style and placement are saved so
+// that they can be restored.
+func (w *windowImpl) enterFullscreen() {
+	if w.fullscreen {
+		return
+	}
+
+	mi := win.MONITORINFO{CbSize: uint32(unsafe.Sizeof(win.MONITORINFO{}))}
+	if !win.GetMonitorInfo(win.MonitorFromWindow(w.Hwnd, win.MONITOR_DEFAULTTONEAREST), &mi) {
+		return
+	}
+	w.savedPlacement = win.WINDOWPLACEMENT{Length: uint32(unsafe.Sizeof(win.WINDOWPLACEMENT{}))}
+	if !win.GetWindowPlacement(w.Hwnd, &w.savedPlacement) {
+		return
+	}
+	w.savedStyle = win.GetWindowLong(w.Hwnd, win.GWL_STYLE)
+	w.fullscreen = true
+
+	r := mi.RcMonitor
+	win.SetWindowLong(w.Hwnd, win.GWL_STYLE, w.savedStyle&^win.WS_OVERLAPPEDWINDOW)
+	win.SetWindowPos(w.Hwnd, win.HWND_TOP, r.Left, r.Top, r.Right-r.Left, r.Bottom-r.Top,
+		win.SWP_NOOWNERZORDER|win.SWP_FRAMECHANGED)
+}
+
+func (w *windowImpl) leaveFullscreen() {
+	w.fullscreen = false
+
+	win.SetWindowLong(w.Hwnd, win.GWL_STYLE, w.savedStyle)
+	win.SetWindowPlacement(w.Hwnd, &w.savedPlacement)
+	win.SetWindowPos(w.Hwnd, 0, 0, 0, 0, 0,
+		win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOZORDER|win.SWP_NOOWNERZORDER|win.SWP_FRAMECHANGED)
+}
+
+func (w *windowImpl) state() WindowState {
+	if w.fullscreen {
+		return StateFullscreen
+	} else if win.IsIconic(w.Hwnd) {
+		return StateMinimized
+	} else if win.IsZoomed(w.Hwnd) {
+		return StateMaximized
+	}
+	return StateNormal
+}
+
+// Value of wParam for WM_SIZE when the window has been minimized.
+const sizeMinimized = 1
+
 func windowWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr) uintptr {
 
 	switch msg {
@@ -548,17 +821,28 @@ func windowWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr)
 		// Defer to the default window proc
 
 	case win.WM_CLOSE:
-		if cb := windowGetPtr(hwnd).onClosing; cb != nil {
+		w := windowGetPtr(hwnd)
+		if cb := w.onClosing; cb != nil {
 			if block := cb(); block {
 				return 0
 			}
 		}
+		w.saveState()
+		// Dialogs are destroyed once the modal loop has completed.
+		if w.onCancel != nil {
+			w.onCancel()
+			return 0
+		}
+		w.enableModalOwner()
 		// Defer to the default window proc
 
 	case win.WM_ACTIVATE:
 		if wParam != 0 {
 			loop.SetActiveWindow(hwnd)
 		}
+		if w := windowGetPtr(hwnd); w != nil {
+			w.activeChanged(win.LOWORD(uint32(wParam)) != win.WA_INACTIVE)
+		}
 		// Defer to the default window proc
 
 	case win.WM_SETFOCUS:
@@ -576,8 +860,31 @@ func windowWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr)
 		// Defer to the default window proc
 
 	case win.WM_SIZE:
-		windowGetPtr(hwnd).onSize(hwnd)
+		w := windowGetPtr(hwnd)
+		w.minimizedChanged(wParam == sizeMinimized)
+		w.onSize(hwnd)
+
+		// Defer to the default window proc
+
+	case win.WM_MOVE:
+		// Minimized windows are moved off-screen, which should not be
+		// reported.
+		if w := windowGetPtr(hwnd); w != nil && !win.IsIconic(hwnd) {
+			w.moved(w.position())
+		}
+		// Defer to the default window proc
 
+	case win.WM_SETCURSOR:
+		// Controls pass this message to their parent before setting their
+		// own cursor, so the default cursor is left to the control.  A busy
+		// window is disabled, so the hit test will not report the client
+		// area.
+		if w := windowGetPtr(hwnd); w != nil && (w.busy || win.LOWORD(uint32(lParam)) == win.HTCLIENT) {
+			if cursor := w.currentCursor(); cursor != CursorDefault {
+				win.SetCursor(loadCursor(cursor))
+				return win.TRUE
+			}
+		}
 		// Defer to the default window proc
 
 	case win.WM_GETMINMAXINFO:
@@ -593,6 +900,18 @@ func windowWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr)
 			if limit := int32(w.windowMinSize.Y); mmi.PtMinTrackSize.Y < limit {
 				mmi.PtMinTrackSize.Y = limit
 			}
+			// A fullscreen window needs to cover the monitor, whatever the
+			// maximum size.
+			if !w.fullscreen {
+				if limit := int32(w.windowMaxSize.X); limit > 0 {
+					mmi.PtMaxTrackSize.X = limit
+					mmi.PtMaxSize.X = limit
+				}
+				if limit := int32(w.windowMaxSize.Y); limit > 0 {
+					mmi.PtMaxTrackSize.Y = limit
+					mmi.PtMaxSize.Y = limit
+				}
+			}
 			return 0
 		}
 		// Defer to the default window proc
@@ -619,6 +938,13 @@ func windowWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr)
 		return uintptr(win.GetSysColorBrush(win.COLOR_3DFACE))
 
 	case win.WM_COMMAND:
+		// When the user presses escape, the dialog manager sends IDCANCEL.
+		if win.LOWORD(uint32(wParam)) == win.IDCANCEL && lParam == 0 {
+			if w := windowGetPtr(hwnd); w.onCancel != nil {
+				w.onCancel()
+			}
+			return 0
+		}
 		return WindowprocWmCommand(wParam, lParam)
 
 	case win.WM_NOTIFY:
@@ -632,9 +958,12 @@ func windowWindowProc(hwnd win.HWND, msg uint32, wParam uintptr, lParam uintptr)
 
 func WindowprocWmCommand(wParam uintptr, lParam uintptr) uintptr {
 	// These are the notifications that the controls needs to receive.
-	if n := win.HIWORD(uint32(wParam)); n == win.BN_CLICKED || n == win.EN_UPDATE || n == win.CBN_SELCHANGE {
-		// For BN_CLICKED, EN_UPDATE, and CBN_SELCHANGE, lParam is the window
-		// handle of the control.  We don't need to use the control identifier
+	if n := win.HIWORD(uint32(wParam)); n == win.BN_CLICKED || n == win.EN_UPDATE || n == win.CBN_SELCHANGE ||
+		n == win.CBN_EDITCHANGE || n == win.CBN_SETFOCUS || n == win.CBN_KILLFOCUS ||
+		n == win.LBN_DBLCLK {
+		// For BN_CLICKED, EN_UPDATE, and the CBN_* and LBN_* notifications,
+		// lParam is the window handle of the control.  Note that
+		// LBN_SELCHANGE has the same value as CBN_SELCHANGE.  We don't need to use the control identifier
 		// from wParam, we can dispatch directly to the control.
 		return win.SendMessage(win.HWND(lParam), win.WM_COMMAND, wParam, lParam)
 	}
@@ -667,3 +996,98 @@ func (w *windowImpl) getSize() (int, int) {
 	win.GetClientRect(w.Hwnd, &rect)
 	return int(rect.Right - rect.Left), int(rect.Bottom - rect.Top)
 }
+
+// focusEventProc is called when the keyboard focus moves to a new control.
+func focusEventProc(hook win.HWINEVENTHOOK, event uint32, hwnd win.HWND, idObject int32, idChild int32, idEventThread uint32, dwmsEventTime uint32) uintptr {
+	root := win.GetAncestor(hwnd, win.GA_ROOT)
+	for _, v := range All() {
+		if v.Hwnd == root {
+			v.focusChanged(focusKey(func(target FocusTarget) bool {
+				h := targetHwnd(target)
+				return h != 0 && (h == hwnd || win.IsChild(h, hwnd))
+			}))
+		}
+	}
+	return 0
+}
+
+// targetHwnd returns the window handle for a focus target, if any.
+func targetHwnd(target FocusTarget) win.HWND {
+	if t, ok := target.(interface{ Handle() win.HWND }); ok {
+		return t.Handle()
+	}
+	return 0
+}
+
+// containsTarget returns true if the focus target is within the window.
+func (w *windowImpl) containsTarget(target FocusTarget) bool {
+	hwnd := targetHwnd(target)
+	return hwnd != 0 && win.GetAncestor(hwnd, win.GA_ROOT) == w.Hwnd
+}
+
+func (w *windowImpl) updateTabOrder() {
+	// Tab navigation follows the z-order of the controls.  Move controls with
+	// a tab index to the top of the z-order, so that they are visited first.
+	previous := win.HWND_TOP
+	for _, v := range tabOrder() {
+		if !w.containsTarget(v.target) {
+			continue
+		}
+		hwnd := targetHwnd(v.target)
+		win.SetWindowPos(hwnd, previous, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOACTIVATE)
+		previous = hwnd
+	}
+}
+
+// currentCursor returns the cursor for the current position of the mouse.
+func (w *windowImpl) currentCursor() Cursor {
+	pt := win.POINT{}
+	win.GetCursorPos(&pt)
+
+	w.setDPI()
+	return w.cursorAt(func(parent base.Control, bounds base.Rectangle) bool {
+		if parent.HWnd != w.Hwnd && !win.IsChild(w.Hwnd, parent.HWnd) {
+			return false
+		}
+		local := pt
+		win.ScreenToClient(parent.HWnd, &local)
+		return image.Pt(int(local.X), int(local.Y)).In(bounds.Pixels())
+	})
+}
+
+// loadCursor returns the system cursor for the shape.
+func loadCursor(cursor Cursor) win.HCURSOR {
+	id := win.IDC_ARROW
+	switch cursor {
+	case CursorText:
+		id = win.IDC_IBEAM
+	case CursorPointer:
+		id = win.IDC_HAND
+	case CursorBusy:
+		id = win.IDC_WAIT
+	case CursorCrosshair:
+		id = win.IDC_CROSS
+	case CursorResizeHorizontal:
+		id = win.IDC_SIZEWE
+	case CursorResizeVertical:
+		id = win.IDC_SIZENS
+	case CursorMove:
+		id = win.IDC_SIZEALL
+	}
+	return win.LoadCursor(0, (*uint16)(unsafe.Pointer(uintptr(id))))
+}
+
+func (w *windowImpl) updateCursor() {
+	// The cursor is normally updated in response to WM_SETCURSOR, which is
+	// only sent when the mouse moves.  Update the cursor now if the mouse is
+	// over the window, since the event loop may be blocked.
+	pt := win.POINT{}
+	win.GetCursorPos(&pt)
+	if hwnd := win.WindowFromPoint(pt); hwnd == w.Hwnd || win.IsChild(w.Hwnd, hwnd) {
+		win.SetCursor(loadCursor(w.currentCursor()))
+	}
+}
+
+func (w *windowImpl) setBusy(busy bool) {
+	win.EnableWindow(w.Hwnd, !busy)
+}